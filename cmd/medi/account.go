@@ -0,0 +1,323 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/crypto/signature/algorithm"
+	"github.com/medibloc/go-medibloc/crypto/signature/secp256k1"
+	"github.com/medibloc/go-medibloc/keystore"
+	"github.com/medibloc/go-medibloc/medlet"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/urfave/cli"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// Error types of account commands
+var (
+	ErrKeydirNotConfigured = errors.New("keydir is not configured. use --keydir or --config with chain.keydir")
+	ErrConfigNotFound      = errors.New("config file not found")
+	ErrPassphraseMismatch  = errors.New("passphrases do not match")
+	ErrInvalidAddress      = errors.New("invalid address")
+	ErrInvalidPrivateKey   = errors.New("invalid private key")
+	ErrNotTerminal         = errors.New("passphrase cannot be read from non-terminal stdin. use a passphrase file")
+)
+
+var (
+	configFlag = cli.StringFlag{
+		Name:  "config, c",
+		Usage: "config file whose chain.keydir is used",
+	}
+	keydirFlag = cli.StringFlag{
+		Name:  "keydir",
+		Usage: "key directory. It overrides chain.keydir of the config",
+	}
+	passphraseFileFlag = cli.StringFlag{
+		Name:  "passphrase-file",
+		Usage: "file containing the passphrase in the first line",
+	}
+	newPassphraseFileFlag = cli.StringFlag{
+		Name:  "new-passphrase-file",
+		Usage: "file containing the new passphrase in the first line",
+	}
+	lightKDFFlag = cli.BoolFlag{
+		Name:  "lightkdf",
+		Usage: "use less memory and CPU to encrypt the key at the expense of security",
+	}
+
+	accountFlags = []cli.Flag{configFlag, keydirFlag}
+)
+
+var accountCommand = cli.Command{
+	Name:  "account",
+	Usage: "manage accounts in the key directory",
+	Subcommands: []cli.Command{
+		{
+			Name:   "new",
+			Usage:  "create a new account",
+			Flags:  append(accountFlags, passphraseFileFlag, lightKDFFlag),
+			Action: accountNew,
+		},
+		{
+			Name:   "list",
+			Usage:  "print addresses of accounts",
+			Flags:  accountFlags,
+			Action: accountList,
+		},
+		{
+			Name:      "import",
+			Usage:     "import a private key from a file containing the hex encoded private key",
+			ArgsUsage: "<keyfile>",
+			Flags:     append(accountFlags, passphraseFileFlag, lightKDFFlag),
+			Action:    accountImport,
+		},
+		{
+			Name:      "export",
+			Usage:     "print the hex encoded private key of an account",
+			ArgsUsage: "<address>",
+			Flags:     append(accountFlags, passphraseFileFlag),
+			Action:    accountExport,
+		},
+		{
+			Name:      "update-passphrase",
+			Usage:     "change the passphrase of an account",
+			ArgsUsage: "<address>",
+			Flags:     append(accountFlags, passphraseFileFlag, newPassphraseFileFlag, lightKDFFlag),
+			Action:    accountUpdatePassphrase,
+		},
+	},
+}
+
+func accountNew(ctx *cli.Context) error {
+	dir, err := keydir(ctx)
+	if err != nil {
+		return err
+	}
+	passphrase, err := readPassphrase(ctx.String(passphraseFileFlag.Name), "Passphrase: ", true)
+	if err != nil {
+		return err
+	}
+
+	key, err := crypto.GenerateKey(algorithm.SECP256K1)
+	if err != nil {
+		return err
+	}
+	scryptN, scryptP := scryptParams(ctx)
+	kf, err := keystore.StoreKey(dir, key, passphrase, scryptN, scryptP)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Address: %s\n", kf.Address.Hex())
+	fmt.Printf("Key file: %s\n", kf.Path)
+	return nil
+}
+
+func accountList(ctx *cli.Context) error {
+	dir, err := keydir(ctx)
+	if err != nil {
+		return err
+	}
+	files, err := keystore.KeyFiles(dir)
+	if err != nil {
+		return err
+	}
+	for i, kf := range files {
+		fmt.Printf("Account #%d: %s %s\n", i, kf.Address.Hex(), kf.Path)
+	}
+	return nil
+}
+
+func accountImport(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+	dir, err := keydir(ctx)
+	if err != nil {
+		return err
+	}
+	b, err := ioutil.ReadFile(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	key, err := secp256k1.NewPrivateKeyFromHex(strings.TrimSpace(string(b)))
+	if err != nil {
+		return ErrInvalidPrivateKey
+	}
+	passphrase, err := readPassphrase(ctx.String(passphraseFileFlag.Name), "Passphrase: ", true)
+	if err != nil {
+		return err
+	}
+
+	scryptN, scryptP := scryptParams(ctx)
+	kf, err := keystore.StoreKey(dir, key, passphrase, scryptN, scryptP)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Address: %s\n", kf.Address.Hex())
+	fmt.Printf("Key file: %s\n", kf.Path)
+	return nil
+}
+
+func accountExport(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+	dir, err := keydir(ctx)
+	if err != nil {
+		return err
+	}
+	addr, err := parseAddress(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	kf, err := keystore.FindKeyFile(dir, addr)
+	if err != nil {
+		return err
+	}
+	passphrase, err := readPassphrase(ctx.String(passphraseFileFlag.Name), "Passphrase: ", false)
+	if err != nil {
+		return err
+	}
+
+	key, err := kf.Decrypt(passphrase)
+	if err != nil {
+		return err
+	}
+	b, err := key.Encoded()
+	if err != nil {
+		return err
+	}
+	fmt.Println(byteutils.Bytes2Hex(b))
+	return nil
+}
+
+func accountUpdatePassphrase(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+	dir, err := keydir(ctx)
+	if err != nil {
+		return err
+	}
+	addr, err := parseAddress(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	if _, err := keystore.FindKeyFile(dir, addr); err != nil {
+		return err
+	}
+	passphrase, err := readPassphrase(ctx.String(passphraseFileFlag.Name), "Current passphrase: ", false)
+	if err != nil {
+		return err
+	}
+	newPassphrase, err := readPassphrase(ctx.String(newPassphraseFileFlag.Name), "New passphrase: ", true)
+	if err != nil {
+		return err
+	}
+
+	scryptN, scryptP := scryptParams(ctx)
+	if err := keystore.UpdateKey(dir, addr, passphrase, newPassphrase, scryptN, scryptP); err != nil {
+		return err
+	}
+	fmt.Printf("Passphrase of %s is updated.\n", addr.Hex())
+	return nil
+}
+
+// keydir returns the key directory given by --keydir, or chain.keydir of the config given by --config.
+func keydir(ctx *cli.Context) (string, error) {
+	if dir := ctx.String(keydirFlag.Name); dir != "" {
+		return dir, nil
+	}
+	path := ctx.String("config")
+	if path == "" {
+		return "", ErrKeydirNotConfigured
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return "", ErrConfigNotFound
+	}
+	conf, err := medlet.LoadConfig(path)
+	if err != nil {
+		return "", err
+	}
+	if conf.Chain.GetKeydir() == "" {
+		return "", ErrKeydirNotConfigured
+	}
+	return conf.Chain.Keydir, nil
+}
+
+func scryptParams(ctx *cli.Context) (scryptN, scryptP int) {
+	if ctx.Bool(lightKDFFlag.Name) {
+		return keystore.LightScryptN, keystore.LightScryptP
+	}
+	return keystore.StandardScryptN, keystore.StandardScryptP
+}
+
+func parseAddress(s string) (common.Address, error) {
+	if !common.IsHexAddress(s) {
+		return common.Address{}, ErrInvalidAddress
+	}
+	return common.HexToAddress(s), nil
+}
+
+// readPassphrase reads the passphrase from the first line of the file. If the file is not given, it prompts
+// the passphrase on the terminal.
+func readPassphrase(file string, prompt string, confirm bool) (string, error) {
+	if file != "" {
+		f, err := os.Open(file)
+		if err != nil {
+			return "", err
+		}
+		defer f.Close()
+		line, err := bufio.NewReader(f).ReadString('\n')
+		if err != nil && line == "" {
+			return "", err
+		}
+		return strings.TrimRight(line, "\r\n"), nil
+	}
+
+	fd := int(os.Stdin.Fd())
+	if !terminal.IsTerminal(fd) {
+		return "", ErrNotTerminal
+	}
+	fmt.Print(prompt)
+	passphrase, err := terminal.ReadPassword(fd)
+	fmt.Println()
+	if err != nil {
+		return "", err
+	}
+	if !confirm {
+		return string(passphrase), nil
+	}
+
+	fmt.Print("Repeat passphrase: ")
+	repeated, err := terminal.ReadPassword(fd)
+	fmt.Println()
+	if err != nil {
+		return "", err
+	}
+	if string(passphrase) != string(repeated) {
+		return "", ErrPassphraseMismatch
+	}
+	return string(passphrase), nil
+}
@@ -0,0 +1,812 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/consensus/dpos"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/crypto"
+	cryptorand "github.com/medibloc/go-medibloc/crypto/rand"
+	"github.com/medibloc/go-medibloc/crypto/signature"
+	"github.com/medibloc/go-medibloc/crypto/signature/algorithm"
+	"github.com/medibloc/go-medibloc/crypto/signature/secp256k1"
+	"github.com/medibloc/go-medibloc/medlet"
+	"github.com/medibloc/go-medibloc/util"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	log "github.com/medibloc/go-medibloc/util/logging"
+	"github.com/urfave/cli"
+)
+
+// Error types of bench commands
+var (
+	ErrInvalidTxMix          = errors.New("invalid transaction mix. use {type}={weight} separated by ',' with transfer, add_record or vote")
+	ErrInvalidBenchRate      = errors.New("rate should be positive")
+	ErrInvalidBlockCapacity  = errors.New("block capacity should not be negative")
+	ErrBenchKeysMissing      = errors.New("account keys are missing. use --keys")
+	ErrBenchGenesisMissing   = errors.New("genesis of the benchmark network is missing. use --genesis or --config")
+	ErrBenchAccountsEmpty    = errors.New("no account key in the keys file")
+	ErrInvalidBenchProducers = errors.New("number of producers should be a multiple of three between 3 and 21")
+)
+
+const (
+	benchPollInterval = 200 * time.Millisecond
+	benchProducerKeys = "producers.txt"
+	benchAccountKeys  = "accounts.txt"
+	benchGenesisConf  = "genesis.conf"
+
+	// benchRateTolerance is the ratio of the attempted rate to the target rate below which the report warns.
+	benchRateTolerance = 0.9
+)
+
+var (
+	benchAccountsFlag = cli.IntFlag{
+		Name:  "accounts",
+		Usage: "number of funded accounts",
+		Value: 100,
+	}
+	benchProducersFlag = cli.IntFlag{
+		Name:  "producers",
+		Usage: "number of block producers, which is the dynasty size",
+		Value: 3,
+	}
+	benchBalanceFlag = cli.StringFlag{
+		Name:  "balance",
+		Usage: "balance of each account",
+		Value: "1000000000000000000",
+	}
+	benchVestingFlag = cli.StringFlag{
+		Name:  "vesting",
+		Usage: "vesting of each account, which gives bandwidth to send transactions",
+		Value: "100000000000000000",
+	}
+	benchOutDirFlag = cli.StringFlag{
+		Name:  "out-dir",
+		Usage: "directory of the genesis conf and key files",
+		Value: "bench",
+	}
+	benchGenesisFlag = cli.StringFlag{
+		Name:  "genesis",
+		Usage: "genesis conf of the benchmark network. chain.genesis of the config is used if empty",
+	}
+	benchKeysFlag = cli.StringFlag{
+		Name:  "keys",
+		Usage: "file of hex encoded private keys of funded accounts, one per line",
+	}
+	benchRateFlag = cli.IntFlag{
+		Name:  "rate",
+		Usage: "target number of transactions sent per second",
+		Value: 100,
+	}
+	benchDurationFlag = cli.DurationFlag{
+		Name:  "duration",
+		Usage: "duration of sending transactions",
+		Value: 30 * time.Second,
+	}
+	benchWaitFlag = cli.DurationFlag{
+		Name:  "wait",
+		Usage: "maximum duration of waiting for inclusion after sending",
+		Value: 30 * time.Second,
+	}
+	benchMixFlag = cli.StringFlag{
+		Name:  "mix",
+		Usage: "weights of transaction types",
+		Value: "transfer=8,add_record=1,vote=1",
+	}
+	benchLocalFlag = cli.BoolFlag{
+		Name:  "local",
+		Usage: "run the node of --config in the process and push transactions directly into its TransactionManager",
+	}
+	benchBlockCapacityFlag = cli.IntFlag{
+		Name:  "block-capacity",
+		Usage: "number of transactions a block can hold. block fill ratio is not reported if 0",
+	}
+	benchJSONFlag = cli.BoolFlag{
+		Name:  "json",
+		Usage: "print the report in json",
+	}
+)
+
+var benchCommand = cli.Command{
+	Name:  "bench",
+	Usage: "generate transaction load and measure throughput of a network",
+	Subcommands: []cli.Command{
+		{
+			Name:  "genesis",
+			Usage: "generate a genesis conf with funded accounts for benchmark",
+			Description: `It writes genesis.conf, producers.txt and accounts.txt to --out-dir. Key files are not
+   encrypted, so use them only for test networks. Set chain.privkey of each node to a key of producers.txt.`,
+			Flags:  []cli.Flag{chainIDFlag, benchAccountsFlag, benchProducersFlag, benchBalanceFlag, benchVestingFlag, benchOutDirFlag, forceFlag},
+			Action: benchGenesis,
+		},
+		{
+			Name:  "run",
+			Usage: "send transactions at a target rate and report inclusion",
+			Description: `Transactions are sent round robin from the accounts of --keys through --rpc, or pushed into
+   the TransactionManager of a node in the process with --local. Inclusion is observed in canonical blocks.
+   Transactions are sent one at a time, so the attempted rate falls below --rate if a send takes longer than
+   the send interval. Block fill ratio is the average number of transactions in a block divided by --block-capacity.`,
+			Flags: []cli.Flag{configFlag, benchGenesisFlag, benchKeysFlag, benchRateFlag, benchDurationFlag, benchWaitFlag,
+				benchMixFlag, benchLocalFlag, rpcFlag, apiKeyFlag, tlsCAFileFlag, benchBlockCapacityFlag, benchJSONFlag},
+			Action: benchRun,
+		},
+	},
+}
+
+func benchGenesis(ctx *cli.Context) error {
+	chainID := uint32(ctx.Uint(chainIDFlag.Name))
+	if chainID == 0 {
+		return ErrGenesisChainIDMissing
+	}
+	if n := ctx.Int(benchProducersFlag.Name); n < 3 || n > 21 || n%3 != 0 {
+		return ErrInvalidBenchProducers
+	}
+	dir := ctx.String(benchOutDirFlag.Name)
+	out := filepath.Join(dir, benchGenesisConf)
+	if _, err := os.Stat(out); err == nil && !ctx.Bool(forceFlag.Name) {
+		return ErrGenesisFileExists
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	producers, err := generateKeys(ctx.Int(benchProducersFlag.Name))
+	if err != nil {
+		return err
+	}
+	accounts, err := generateKeys(ctx.Int(benchAccountsFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	var dynasty []string
+	var dist []*corepb.GenesisTokenDistribution
+	for i, keys := range [][]string{producers, accounts} {
+		for _, key := range keys {
+			addr, err := keyAddress(key)
+			if err != nil {
+				return err
+			}
+			if i == 0 {
+				dynasty = append(dynasty, addr.Hex())
+			}
+			dist = append(dist, &corepb.GenesisTokenDistribution{
+				Address: addr.Hex(),
+				Balance: ctx.String(benchBalanceFlag.Name),
+				Vesting: ctx.String(benchVestingFlag.Name),
+				Vote:    []string{},
+			})
+		}
+	}
+
+	conf := &corepb.Genesis{
+		Meta: &corepb.GenesisMeta{
+			ChainId:     chainID,
+			DynastySize: uint32(len(dynasty)),
+		},
+		Consensus: &corepb.GenesisConsensus{
+			Dpos: &corepb.GenesisConsensusDpos{
+				Dynasty: dynasty,
+			},
+		},
+		TokenDistribution: dist,
+	}
+	supply, err := core.ValidateGenesisConf(conf)
+	if err != nil {
+		return err
+	}
+	block, err := newGenesisBlock(conf)
+	if err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(out, []byte(proto.MarshalTextString(conf)), 0644); err != nil {
+		return err
+	}
+	for name, keys := range map[string][]string{benchProducerKeys: producers, benchAccountKeys: accounts} {
+		content := strings.Join(keys, "\n") + "\n"
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("Genesis conf: %s\n", out)
+	fmt.Printf("Producer keys: %s\n", filepath.Join(dir, benchProducerKeys))
+	fmt.Printf("Account keys: %s\n", filepath.Join(dir, benchAccountKeys))
+	fmt.Printf("Chain ID: %d\n", chainID)
+	fmt.Printf("Accounts: %d\n", len(dist))
+	fmt.Printf("Supply: %s\n", supply)
+	printGenesisState(conf, block)
+	return nil
+}
+
+// generateKeys returns n hex encoded private keys.
+func generateKeys(n int) ([]string, error) {
+	keys := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		key, err := crypto.GenerateKey(algorithm.SECP256K1)
+		if err != nil {
+			return nil, err
+		}
+		b, err := key.Encoded()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, byteutils.Bytes2Hex(b))
+	}
+	return keys, nil
+}
+
+func keyAddress(key string) (common.Address, error) {
+	privKey, err := secp256k1.NewPrivateKeyFromHex(key)
+	if err != nil {
+		return common.Address{}, ErrInvalidPrivateKey
+	}
+	return common.PublicKeyToAddress(privKey.PublicKey())
+}
+
+func benchRun(ctx *cli.Context) error {
+	mix, err := parseTxMix(ctx.String(benchMixFlag.Name))
+	if err != nil {
+		return err
+	}
+	rate := ctx.Int(benchRateFlag.Name)
+	if rate <= 0 {
+		return ErrInvalidBenchRate
+	}
+	capacity := ctx.Int(benchBlockCapacityFlag.Name)
+	if capacity < 0 {
+		return ErrInvalidBlockCapacity
+	}
+	if ctx.String(benchKeysFlag.Name) == "" {
+		return ErrBenchKeysMissing
+	}
+	accounts, err := readBenchKeys(ctx.String(benchKeysFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	var genesis *corepb.Genesis
+	var target benchTarget
+	if ctx.Bool(benchLocalFlag.Name) {
+		conf, g, err := nodeConfig(ctx)
+		if err != nil {
+			return err
+		}
+		genesis = g
+		if err := medlet.ValidateConfig(conf); err != nil {
+			return err
+		}
+		log.Init(conf.App.LogFile, conf.App.LogLevel, conf.App.LogAge)
+		med, err := medlet.New(conf)
+		if err != nil {
+			return err
+		}
+		if err := med.Setup(); err != nil {
+			return err
+		}
+		if err := med.Start(); err != nil {
+			return err
+		}
+		target = newLocalTarget(med)
+	} else {
+		if path := ctx.String(benchGenesisFlag.Name); path != "" {
+			genesis, err = core.LoadGenesisConf(path)
+		} else if ctx.String("config") != "" {
+			_, genesis, err = nodeConfig(ctx)
+		} else {
+			err = ErrBenchGenesisMissing
+		}
+		if err != nil {
+			return err
+		}
+		if target, err = newRPCTarget(ctx); err != nil {
+			return err
+		}
+	}
+	defer target.close()
+
+	var candidates []common.Address
+	for _, d := range genesis.Consensus.Dpos.Dynasty {
+		candidates = append(candidates, common.HexToAddress(d))
+	}
+	for _, acc := range accounts {
+		if acc.nonce, err = target.nonce(acc.addr); err != nil {
+			return err
+		}
+	}
+
+	b := newBenchmark(target, accounts, mix, candidates, genesis.Meta.ChainId, rate, capacity)
+	report, err := b.run(ctx.Duration(benchDurationFlag.Name), ctx.Duration(benchWaitFlag.Name))
+	if err != nil {
+		return err
+	}
+	if ctx.Bool(benchJSONFlag.Name) {
+		return printJSON(report)
+	}
+	report.print()
+	return nil
+}
+
+// benchTxWeight is a transaction type and its weight in the mix.
+type benchTxWeight struct {
+	txType string
+	weight int
+}
+
+// parseTxMix parses weights of transaction types. e.g. transfer=8,add_record=1,vote=1
+func parseTxMix(s string) ([]*benchTxWeight, error) {
+	var mix []*benchTxWeight
+	for _, item := range strings.Split(s, ",") {
+		kv := strings.Split(strings.TrimSpace(item), "=")
+		if len(kv) != 2 {
+			return nil, ErrInvalidTxMix
+		}
+		switch kv[0] {
+		case core.TxOpTransfer, core.TxOpAddRecord, dpos.TxOpVote:
+		default:
+			return nil, ErrInvalidTxMix
+		}
+		weight, err := strconv.Atoi(kv[1])
+		if err != nil || weight < 0 {
+			return nil, ErrInvalidTxMix
+		}
+		if weight > 0 {
+			mix = append(mix, &benchTxWeight{txType: kv[0], weight: weight})
+		}
+	}
+	if len(mix) == 0 {
+		return nil, ErrInvalidTxMix
+	}
+	return mix, nil
+}
+
+// benchAccount is a funded account which sends benchmark transactions.
+type benchAccount struct {
+	addr   common.Address
+	signer signature.Signature
+	nonce  uint64
+
+	// inflight is the number of submitted transactions which are not included yet.
+	inflight int
+	// stale is set when it is unknown whether the node accepted the last transaction of the account.
+	stale bool
+}
+
+// readBenchKeys reads hex encoded private keys. Empty lines and lines starting with '#' are skipped.
+func readBenchKeys(path string) ([]*benchAccount, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var accounts []*benchAccount
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, err := secp256k1.NewPrivateKeyFromHex(line)
+		if err != nil {
+			return nil, ErrInvalidPrivateKey
+		}
+		addr, err := common.PublicKeyToAddress(key.PublicKey())
+		if err != nil {
+			return nil, err
+		}
+		signer, err := crypto.NewSignature(algorithm.SECP256K1)
+		if err != nil {
+			return nil, err
+		}
+		signer.InitSign(key)
+		accounts = append(accounts, &benchAccount{addr: addr, signer: signer})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(accounts) == 0 {
+		return nil, ErrBenchAccountsEmpty
+	}
+	return accounts, nil
+}
+
+// benchBlock is a canonical block observed during benchmark.
+type benchBlock struct {
+	height   uint64
+	txs      int
+	benchTxs int
+}
+
+// benchPending is a submitted transaction which is not included yet.
+type benchPending struct {
+	acc    *benchAccount
+	sentAt time.Time
+}
+
+// benchmark sends transactions to the target and observes their inclusion.
+type benchmark struct {
+	target     benchTarget
+	accounts   []*benchAccount
+	mix        []*benchTxWeight
+	candidates []common.Address
+	chainID    uint32
+	rate       int
+	capacity   int
+
+	mu        sync.Mutex
+	attempted int
+	submitted int
+	pending   map[string]*benchPending
+	rejected  map[string]int
+	latencies []time.Duration
+	blocks    []*benchBlock
+}
+
+func newBenchmark(target benchTarget, accounts []*benchAccount, mix []*benchTxWeight, candidates []common.Address,
+	chainID uint32, rate int, capacity int) *benchmark {
+	return &benchmark{
+		target:     target,
+		accounts:   accounts,
+		mix:        mix,
+		candidates: candidates,
+		chainID:    chainID,
+		rate:       rate,
+		capacity:   capacity,
+		pending:    make(map[string]*benchPending),
+		rejected:   make(map[string]int),
+	}
+}
+
+func (b *benchmark) run(duration, wait time.Duration) (*benchReport, error) {
+	from, err := b.target.tailHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	quitCh := make(chan struct{})
+	doneCh := make(chan struct{})
+	go func() {
+		b.watch(from, quitCh)
+		close(doneCh)
+	}()
+
+	start := time.Now()
+	b.send(duration)
+	sent := time.Since(start)
+
+	deadline := time.Now().Add(wait)
+	for time.Now().Before(deadline) {
+		b.mu.Lock()
+		n := len(b.pending)
+		b.mu.Unlock()
+		if n == 0 {
+			break
+		}
+		time.Sleep(benchPollInterval)
+	}
+	close(quitCh)
+	<-doneCh
+
+	return b.report(sent), nil
+}
+
+// send sends transactions at the target rate for the duration. Accounts send in turn.
+func (b *benchmark) send(duration time.Duration) {
+	ticker := time.NewTicker(time.Second / time.Duration(b.rate))
+	defer ticker.Stop()
+	timeout := time.After(duration)
+
+	for i := 0; ; i++ {
+		select {
+		case <-timeout:
+			return
+		case <-ticker.C:
+		}
+		b.sendOne(i)
+	}
+}
+
+// sendOne sends a transaction of the i-th turn.
+func (b *benchmark) sendOne(i int) {
+	acc := b.nextAccount(i)
+	if acc == nil {
+		return
+	}
+	tx, err := b.newTx(acc, b.pickTxType(), i)
+	if err == nil {
+		err = b.target.send(tx)
+	}
+	sentAt := time.Now()
+
+	b.mu.Lock()
+	b.attempted++
+	switch err.(type) {
+	case nil:
+		b.submitted++
+		acc.inflight++
+		b.pending[byteutils.Bytes2Hex(tx.Hash())] = &benchPending{acc: acc, sentAt: sentAt}
+	case *benchRejection:
+		// the nonce is not used by the rejected transaction.
+		acc.nonce--
+		b.rejected[err.Error()]++
+	default:
+		// the node may have accepted the transaction, so the nonce is synced from the target later.
+		acc.stale = true
+		b.rejected[err.Error()]++
+	}
+	b.mu.Unlock()
+}
+
+// nextAccount returns the account of the i-th turn, or the first one after it which can send. A stale account syncs
+// its nonce from the target once none of its transactions are in flight. It returns nil if no account can send.
+func (b *benchmark) nextAccount(i int) *benchAccount {
+	for j := 0; j < len(b.accounts); j++ {
+		acc := b.accounts[(i+j)%len(b.accounts)]
+		b.mu.Lock()
+		stale, inflight := acc.stale, acc.inflight
+		b.mu.Unlock()
+		if !stale {
+			return acc
+		}
+		if inflight > 0 {
+			continue
+		}
+		nonce, err := b.target.nonce(acc.addr)
+		if err != nil {
+			continue
+		}
+		acc.nonce = nonce
+		b.mu.Lock()
+		acc.stale = false
+		b.mu.Unlock()
+		return acc
+	}
+	return nil
+}
+
+func (b *benchmark) pickTxType() string {
+	total := 0
+	for _, m := range b.mix {
+		total += m.weight
+	}
+	r := rand.Intn(total)
+	for _, m := range b.mix {
+		if r < m.weight {
+			return m.txType
+		}
+		r -= m.weight
+	}
+	return b.mix[0].txType
+}
+
+// newTx returns a signed transaction of the type from the account. The i-th transaction transfers to the next
+// account and votes a candidate in turn.
+func (b *benchmark) newTx(acc *benchAccount, txType string, i int) (*core.Transaction, error) {
+	acc.nonce++
+
+	tx := &core.Transaction{}
+	tx.SetTxType(txType)
+	tx.SetFrom(acc.addr)
+	tx.SetValue(util.NewUint128())
+	tx.SetTimestamp(time.Now().Unix())
+	tx.SetNonce(acc.nonce)
+	tx.SetChainID(b.chainID)
+	tx.SetAlg(algorithm.SECP256K1)
+
+	var payload core.TransactionPayload
+	switch txType {
+	case core.TxOpTransfer:
+		tx.SetTo(b.accounts[(i+1)%len(b.accounts)].addr)
+		tx.SetValue(util.NewUint128FromUint(1))
+	case core.TxOpAddRecord:
+		payload = &core.AddRecordPayload{RecordHash: cryptorand.GetEntropyCSPRNG(32)}
+	case dpos.TxOpVote:
+		payload = &dpos.VotePayload{Candidates: []common.Address{b.candidates[i%len(b.candidates)]}}
+	}
+	if payload != nil {
+		p, err := payload.ToBytes()
+		if err != nil {
+			return nil, err
+		}
+		tx.SetPayload(p)
+	}
+
+	hash, err := tx.CalcHash()
+	if err != nil {
+		return nil, err
+	}
+	tx.SetHash(hash)
+	if err := tx.SignThis(acc.signer); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// watch observes canonical blocks above the height of from until quitCh is closed.
+func (b *benchmark) watch(from uint64, quitCh chan struct{}) {
+	ticker := time.NewTicker(benchPollInterval)
+	defer ticker.Stop()
+
+	next := from + 1
+	for {
+		select {
+		case <-quitCh:
+			return
+		case <-ticker.C:
+		}
+		tail, err := b.target.tailHeight()
+		if err != nil {
+			continue
+		}
+		for ; next <= tail; next++ {
+			hashes, err := b.target.blockTxs(next)
+			if err != nil {
+				break
+			}
+			b.include(next, hashes, time.Now())
+		}
+	}
+}
+
+func (b *benchmark) include(height uint64, hashes []string, seenAt time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	block := &benchBlock{height: height, txs: len(hashes)}
+	for _, hash := range hashes {
+		p, ok := b.pending[hash]
+		if !ok {
+			continue
+		}
+		delete(b.pending, hash)
+		p.acc.inflight--
+		block.benchTxs++
+		b.latencies = append(b.latencies, seenAt.Sub(p.sentAt))
+	}
+	b.blocks = append(b.blocks, block)
+}
+
+// benchReport is the result of a benchmark.
+type benchReport struct {
+	Duration       string         `json:"duration"`
+	TargetRate     int            `json:"target_rate"`
+	Attempted      int            `json:"attempted"`
+	AttemptRate    float64        `json:"attempt_rate"`
+	Submitted      int            `json:"submitted"`
+	SendRate       float64        `json:"send_rate"`
+	Included       int            `json:"included"`
+	IncludedRate   float64        `json:"included_rate"`
+	Pending        int            `json:"pending"`
+	Rejected       map[string]int `json:"rejected"`
+	LatencyP50     string         `json:"latency_p50"`
+	LatencyP90     string         `json:"latency_p90"`
+	LatencyP99     string         `json:"latency_p99"`
+	LatencyMax     string         `json:"latency_max"`
+	Blocks         int            `json:"blocks"`
+	AvgBlockTxs    float64        `json:"avg_block_txs"`
+	MaxBlockTxs    int            `json:"max_block_txs"`
+	BlockCapacity  int            `json:"block_capacity,omitempty"`
+	BlockFillRatio float64        `json:"block_fill_ratio,omitempty"`
+}
+
+func (b *benchmark) report(sent time.Duration) *benchReport {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	r := &benchReport{
+		Duration:      sent.String(),
+		TargetRate:    b.rate,
+		Attempted:     b.attempted,
+		AttemptRate:   float64(b.attempted) / sent.Seconds(),
+		Submitted:     b.submitted,
+		SendRate:      float64(b.submitted) / sent.Seconds(),
+		Included:      len(b.latencies),
+		Pending:       len(b.pending),
+		Rejected:      b.rejected,
+		BlockCapacity: b.capacity,
+	}
+	r.IncludedRate = float64(r.Included) / sent.Seconds()
+
+	latencies := append([]time.Duration{}, b.latencies...)
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	r.LatencyP50 = percentile(latencies, 0.5).String()
+	r.LatencyP90 = percentile(latencies, 0.9).String()
+	r.LatencyP99 = percentile(latencies, 0.99).String()
+	r.LatencyMax = percentile(latencies, 1).String()
+
+	// blocks after the last one including benchmark transactions are not counted.
+	last := -1
+	for i, block := range b.blocks {
+		if block.benchTxs > 0 {
+			last = i
+		}
+	}
+	txs := 0
+	for _, block := range b.blocks[:last+1] {
+		txs += block.txs
+		if block.txs > r.MaxBlockTxs {
+			r.MaxBlockTxs = block.txs
+		}
+	}
+	r.Blocks = last + 1
+	if r.Blocks > 0 {
+		r.AvgBlockTxs = float64(txs) / float64(r.Blocks)
+	}
+	if r.Blocks > 0 && b.capacity > 0 {
+		r.BlockFillRatio = r.AvgBlockTxs / float64(b.capacity)
+	}
+	return r
+}
+
+// percentile returns the p-th percentile of the sorted durations.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if i < 0 {
+		i = 0
+	}
+	return sorted[i]
+}
+
+func (r *benchReport) print() {
+	fmt.Printf("Duration: %s\n", r.Duration)
+	fmt.Printf("Attempted: %d (%.1f tx/s, target %d tx/s)\n", r.Attempted, r.AttemptRate, r.TargetRate)
+	if r.AttemptRate < benchRateTolerance*float64(r.TargetRate) {
+		fmt.Println("  sending fell behind the target rate. sends may take longer than the send interval")
+	}
+	fmt.Printf("Submitted: %d (%.1f tx/s)\n", r.Submitted, r.SendRate)
+	fmt.Printf("Included: %d (%.1f tx/s)\n", r.Included, r.IncludedRate)
+	fmt.Printf("Pending: %d\n", r.Pending)
+
+	rejected := 0
+	reasons := make([]string, 0, len(r.Rejected))
+	for reason, n := range r.Rejected {
+		rejected += n
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+	fmt.Printf("Rejected: %d\n", rejected)
+	for _, reason := range reasons {
+		fmt.Printf("  %s: %d\n", reason, r.Rejected[reason])
+	}
+
+	fmt.Printf("Inclusion latency: p50 %s, p90 %s, p99 %s, max %s\n",
+		r.LatencyP50, r.LatencyP90, r.LatencyP99, r.LatencyMax)
+	fmt.Printf("Blocks: %d, %.1f txs per block, max %d\n", r.Blocks, r.AvgBlockTxs, r.MaxBlockTxs)
+	if r.BlockCapacity > 0 {
+		fmt.Printf("Block fill ratio: %.2f of %d txs\n", r.BlockFillRatio, r.BlockCapacity)
+	}
+}
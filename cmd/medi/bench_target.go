@@ -0,0 +1,185 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package main
+
+import (
+	"time"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/medlet"
+	"github.com/medibloc/go-medibloc/rpc"
+	"github.com/medibloc/go-medibloc/rpc/pb"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/urfave/cli"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const benchRPCTimeout = 5 * time.Second
+
+// benchTarget is a node which benchmark transactions are sent to.
+type benchTarget interface {
+	// nonce returns the nonce of the address in the tail block.
+	nonce(addr common.Address) (uint64, error)
+	// send submits the transaction. It returns a *benchRejection if the node refused the transaction. After other
+	// errors, it is unknown whether the node accepted it. The error message is used as the rejected reason.
+	send(tx *core.Transaction) error
+	// tailHeight returns the height of the main tail.
+	tailHeight() (uint64, error)
+	// blockTxs returns the hex encoded hashes of transactions in the canonical block of the height.
+	blockTxs(height uint64) ([]string, error)
+	close()
+}
+
+// benchRejection is an error of a transaction which the node refused to accept, so its nonce is not used.
+type benchRejection struct {
+	reason string
+}
+
+func (e *benchRejection) Error() string {
+	return e.reason
+}
+
+// rpcTarget sends transactions through the rpc server given by --rpc.
+type rpcTarget struct {
+	ctx    *cli.Context
+	conn   *grpc.ClientConn
+	client rpcpb.ApiServiceClient
+}
+
+func newRPCTarget(ctx *cli.Context) (*rpcTarget, error) {
+	conn, err := dialRPC(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcTarget{
+		ctx:    ctx,
+		conn:   conn,
+		client: rpcpb.NewApiServiceClient(conn),
+	}, nil
+}
+
+func (t *rpcTarget) nonce(addr common.Address) (uint64, error) {
+	c, cancel := rpcContext(t.ctx, benchRPCTimeout)
+	defer cancel()
+	resp, err := t.client.GetAccount(c, &rpcpb.GetAccountRequest{
+		Address: addr.Hex(),
+		Type:    rpc.TAIL,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.Nonce, nil
+}
+
+func (t *rpcTarget) send(tx *core.Transaction) error {
+	c, cancel := rpcContext(t.ctx, benchRPCTimeout)
+	defer cancel()
+	_, err := t.client.SendTransaction(c, txToRequest(tx))
+	if err == nil {
+		return nil
+	}
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	switch s.Code() {
+	case codes.DeadlineExceeded, codes.Canceled, codes.Unavailable, codes.Unknown:
+		// the request may have reached the node.
+		return err
+	}
+	return &benchRejection{reason: s.Message()}
+}
+
+func (t *rpcTarget) tailHeight() (uint64, error) {
+	c, cancel := rpcContext(t.ctx, benchRPCTimeout)
+	defer cancel()
+	resp, err := t.client.GetMedState(c, &rpcpb.NonParamRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return resp.Height, nil
+}
+
+func (t *rpcTarget) blockTxs(height uint64) ([]string, error) {
+	c, cancel := rpcContext(t.ctx, benchRPCTimeout)
+	defer cancel()
+	resp, err := t.client.GetBlock(c, &rpcpb.GetBlockRequest{Height: height})
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([]string, 0, len(resp.Transactions))
+	for _, tx := range resp.Transactions {
+		hashes = append(hashes, tx.Hash)
+	}
+	return hashes, nil
+}
+
+func (t *rpcTarget) close() {
+	t.conn.Close()
+}
+
+// localTarget runs a node in the process and pushes transactions directly into its TransactionManager.
+type localTarget struct {
+	med *medlet.Medlet
+	bm  *core.BlockManager
+	tm  *core.TransactionManager
+}
+
+func newLocalTarget(med *medlet.Medlet) *localTarget {
+	return &localTarget{
+		med: med,
+		bm:  med.BlockManager(),
+		tm:  med.TransactionManager(),
+	}
+}
+
+func (t *localTarget) nonce(addr common.Address) (uint64, error) {
+	acc, err := t.bm.TailBlock().State().GetAccount(addr)
+	if err != nil {
+		return 0, err
+	}
+	return acc.Nonce, nil
+}
+
+func (t *localTarget) send(tx *core.Transaction) error {
+	if err := t.tm.PushAndRelay(tx); err != nil {
+		return &benchRejection{reason: err.Error()}
+	}
+	return nil
+}
+
+func (t *localTarget) tailHeight() (uint64, error) {
+	return t.bm.TailBlock().Height(), nil
+}
+
+func (t *localTarget) blockTxs(height uint64) ([]string, error) {
+	block, err := t.bm.BlockByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([]string, 0, len(block.Transactions()))
+	for _, tx := range block.Transactions() {
+		hashes = append(hashes, byteutils.Bytes2Hex(tx.Hash()))
+	}
+	return hashes, nil
+}
+
+func (t *localTarget) close() {
+	t.med.Stop()
+}
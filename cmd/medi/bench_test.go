@@ -0,0 +1,203 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package main
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/crypto/signature/algorithm"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBenchTarget accepts transactions and includes all of them in a new block whenever the tail is polled.
+type fakeBenchTarget struct {
+	mu     sync.Mutex
+	nonces map[common.Address]uint64
+	errs   []error
+	sent   []*core.Transaction
+	queued []string
+	blocks [][]string
+}
+
+func newFakeBenchTarget() *fakeBenchTarget {
+	return &fakeBenchTarget{nonces: make(map[common.Address]uint64)}
+}
+
+func (t *fakeBenchTarget) nonce(addr common.Address) (uint64, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.nonces[addr], nil
+}
+
+// send returns the queued errors in order, and accepts the transaction after they run out.
+func (t *fakeBenchTarget) send(tx *core.Transaction) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.sent = append(t.sent, tx)
+	if len(t.errs) > 0 {
+		err := t.errs[0]
+		t.errs = t.errs[1:]
+		if err != nil {
+			return err
+		}
+	}
+	t.queued = append(t.queued, byteutils.Bytes2Hex(tx.Hash()))
+	return nil
+}
+
+func (t *fakeBenchTarget) tailHeight() (uint64, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.queued) > 0 {
+		t.blocks = append(t.blocks, t.queued)
+		t.queued = nil
+	}
+	return uint64(len(t.blocks)), nil
+}
+
+func (t *fakeBenchTarget) blockTxs(height uint64) ([]string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if height == 0 || height > uint64(len(t.blocks)) {
+		return nil, core.ErrNotFound
+	}
+	return t.blocks[height-1], nil
+}
+
+func (t *fakeBenchTarget) close() {}
+
+func (t *fakeBenchTarget) sentNonces() []uint64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var nonces []uint64
+	for _, tx := range t.sent {
+		nonces = append(nonces, tx.Nonce())
+	}
+	return nonces
+}
+
+func newTestBenchAccount(t *testing.T) *benchAccount {
+	key, err := crypto.GenerateKey(algorithm.SECP256K1)
+	require.NoError(t, err)
+	addr, err := common.PublicKeyToAddress(key.PublicKey())
+	require.NoError(t, err)
+	signer, err := crypto.NewSignature(algorithm.SECP256K1)
+	require.NoError(t, err)
+	signer.InitSign(key)
+	return &benchAccount{addr: addr, signer: signer}
+}
+
+func newTestBenchmark(t *testing.T, target benchTarget, n int, capacity int) *benchmark {
+	var accounts []*benchAccount
+	for i := 0; i < n; i++ {
+		accounts = append(accounts, newTestBenchAccount(t))
+	}
+	mix := []*benchTxWeight{{txType: core.TxOpTransfer, weight: 1}}
+	return newBenchmark(target, accounts, mix, nil, 1, 100, capacity)
+}
+
+func TestBenchRejectionReusesNonce(t *testing.T) {
+	target := newFakeBenchTarget()
+	target.errs = []error{nil, &benchRejection{reason: "rejected"}}
+	b := newTestBenchmark(t, target, 1, 0)
+
+	for i := 0; i < 3; i++ {
+		b.sendOne(i)
+	}
+	assert.Equal(t, []uint64{1, 2, 2}, target.sentNonces())
+	assert.Equal(t, 3, b.attempted)
+	assert.Equal(t, 2, b.submitted)
+	assert.Equal(t, map[string]int{"rejected": 1}, b.rejected)
+	assert.False(t, b.accounts[0].stale)
+}
+
+func TestBenchAmbiguousErrorResyncsNonce(t *testing.T) {
+	target := newFakeBenchTarget()
+	target.errs = []error{nil, errors.New("deadline exceeded")}
+	b := newTestBenchmark(t, target, 2, 0)
+	acc := b.accounts[0]
+
+	b.sendOne(0)
+	b.sendOne(0)
+	assert.True(t, acc.stale)
+	assert.Equal(t, 1, acc.inflight)
+
+	// the stale account waits for its transactions in flight, and the next account sends instead.
+	b.sendOne(0)
+	assert.Equal(t, []uint64{1, 2, 1}, target.sentNonces())
+	assert.True(t, acc.stale)
+
+	// the node accepted the ambiguous transaction, so the account continues after it.
+	height, err := target.tailHeight()
+	require.NoError(t, err)
+	hashes, err := target.blockTxs(height)
+	require.NoError(t, err)
+	b.include(height, hashes, time.Now())
+	assert.Equal(t, 0, acc.inflight)
+	target.nonces[acc.addr] = 2
+
+	b.sendOne(0)
+	assert.Equal(t, []uint64{1, 2, 1, 3}, target.sentNonces())
+	assert.False(t, acc.stale)
+	assert.Equal(t, map[string]int{"deadline exceeded": 1}, b.rejected)
+}
+
+func TestBenchReport(t *testing.T) {
+	target := newFakeBenchTarget()
+	b := newTestBenchmark(t, target, 1, 10)
+	for i := 0; i < 6; i++ {
+		b.sendOne(i)
+	}
+	b.include(1, []string{"other"}, time.Now())
+	height, err := target.tailHeight()
+	require.NoError(t, err)
+	hashes, err := target.blockTxs(height)
+	require.NoError(t, err)
+	b.include(2, append(hashes, "other", "other"), time.Now())
+	b.include(3, []string{"other"}, time.Now())
+
+	r := b.report(2 * time.Second)
+	assert.Equal(t, 100, r.TargetRate)
+	assert.Equal(t, 3.0, r.AttemptRate)
+	assert.Equal(t, 6, r.Included)
+	assert.Equal(t, 0, r.Pending)
+	assert.Equal(t, 2, r.Blocks)
+	assert.Equal(t, 4.5, r.AvgBlockTxs)
+	assert.Equal(t, 8, r.MaxBlockTxs)
+	assert.Equal(t, 0.45, r.BlockFillRatio)
+
+	b.capacity = 0
+	assert.Equal(t, 0.0, b.report(2*time.Second).BlockFillRatio)
+}
+
+func TestBenchRun(t *testing.T) {
+	target := newFakeBenchTarget()
+	b := newTestBenchmark(t, target, 3, 0)
+
+	r, err := b.run(100*time.Millisecond, 5*time.Second)
+	require.NoError(t, err)
+	assert.True(t, r.Submitted > 0)
+	assert.Equal(t, r.Submitted, r.Included)
+	assert.Equal(t, 0, r.Pending)
+}
@@ -0,0 +1,155 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/medibloc/go-medibloc/medlet"
+	"github.com/medibloc/go-medibloc/medlet/pb"
+	"github.com/urfave/cli"
+)
+
+// Error types of config commands
+var (
+	ErrInvalidConfig    = errors.New("config is invalid")
+	ErrConfigFileExists = errors.New("config file already exists. use --force to overwrite")
+)
+
+var (
+	configForceFlag = cli.BoolFlag{
+		Name:  "force",
+		Usage: "overwrite the existing config file",
+	}
+)
+
+var configCommand = cli.Command{
+	Name:  "config",
+	Usage: "validate and generate node configs",
+	Description: `Fields of the config can be overridden by MEDI_* environment variables, and by global flags which
+   take precedence over the environment variables. e.g. MEDI_DATADIR=data.db or medi --node-datadir data.db.
+   chain.privkey is overridden only by MEDI_PRIVKEY.`,
+	Subcommands: []cli.Command{
+		{
+			Name:      "validate",
+			Usage:     "check every section of the config and print invalid fields",
+			ArgsUsage: "<config file>",
+			Flags:     []cli.Flag{configFlag},
+			Action:    configValidate,
+		},
+		{
+			Name:   "default",
+			Usage:  "print the default config with overrides except chain.privkey",
+			Flags:  []cli.Flag{outFlag, configForceFlag},
+			Action: configDefault,
+		},
+	},
+}
+
+func configValidate(ctx *cli.Context) error {
+	path := ctx.Args().First()
+	if path == "" {
+		path = ctx.String("config")
+	}
+	if path == "" {
+		return ErrConfigMissing
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return ErrConfigNotFound
+	}
+	conf, err := medlet.LoadConfig(path)
+	if err != nil {
+		return err
+	}
+	if err := applyConfigOverrides(ctx, conf); err != nil {
+		return err
+	}
+
+	err = medlet.ValidateConfig(conf)
+	if errs, ok := err.(medlet.ConfigErrors); ok {
+		for _, e := range errs {
+			fmt.Println(e)
+		}
+		return ErrInvalidConfig
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Println("Config is valid.")
+	return nil
+}
+
+func configDefault(ctx *cli.Context) error {
+	conf := medlet.DefaultConfig()
+	// secrets are not applied, so they are not printed or written to the file.
+	if err := medlet.ApplyPublicEnv(conf); err != nil {
+		return err
+	}
+	if err := applyFlagOverrides(ctx, conf); err != nil {
+		return err
+	}
+	content := proto.MarshalTextString(conf)
+
+	out := ctx.String("out")
+	if out == "" {
+		fmt.Print(content)
+		return nil
+	}
+	if _, err := os.Stat(out); err == nil && !ctx.Bool(configForceFlag.Name) {
+		return ErrConfigFileExists
+	}
+	return ioutil.WriteFile(out, []byte(content), 0644)
+}
+
+// configOverrideFlags returns global flags overriding fields of the config.
+func configOverrideFlags() []cli.Flag {
+	flags := make([]cli.Flag, 0, len(medlet.ConfigOverrides))
+	for _, o := range medlet.ConfigOverrides {
+		if o.Flag == "" {
+			continue
+		}
+		flags = append(flags, cli.StringFlag{
+			Name:  o.Flag,
+			Usage: fmt.Sprintf("%s. It overrides %s of the config and $%s", o.Usage, o.Field, o.Env),
+		})
+	}
+	return flags
+}
+
+// applyConfigOverrides applies MEDI_* environment variables, and then global flags to the config.
+func applyConfigOverrides(ctx *cli.Context, conf *medletpb.Config) error {
+	if err := medlet.ApplyEnv(conf); err != nil {
+		return err
+	}
+	return applyFlagOverrides(ctx, conf)
+}
+
+// applyFlagOverrides applies global flags to the config.
+func applyFlagOverrides(ctx *cli.Context, conf *medletpb.Config) error {
+	for _, o := range medlet.ConfigOverrides {
+		if o.Flag == "" || !ctx.GlobalIsSet(o.Flag) {
+			continue
+		}
+		if err := o.Apply(conf, ctx.GlobalString(o.Flag)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
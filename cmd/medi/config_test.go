@@ -0,0 +1,57 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/crypto/signature/algorithm"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+func TestConfigDefaultHidesSecrets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "medi_config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	key, err := crypto.GenerateKey(algorithm.SECP256K1)
+	require.NoError(t, err)
+	encoded, err := key.Encoded()
+	require.NoError(t, err)
+	privkey := byteutils.Bytes2Hex(encoded)
+
+	os.Setenv("MEDI_PRIVKEY", privkey)
+	defer os.Unsetenv("MEDI_PRIVKEY")
+	os.Setenv("MEDI_DATADIR", "env.db")
+	defer os.Unsetenv("MEDI_DATADIR")
+
+	out := filepath.Join(dir, "default.conf")
+	ctx, err := newTestContext([]cli.Flag{outFlag, configForceFlag}, "--out", out)
+	require.NoError(t, err)
+	require.NoError(t, configDefault(ctx))
+
+	content, err := ioutil.ReadFile(out)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), privkey)
+	assert.Contains(t, string(content), "env.db")
+}
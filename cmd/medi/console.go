@@ -0,0 +1,502 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/crypto/signature"
+	"github.com/medibloc/go-medibloc/rpc"
+	"github.com/medibloc/go-medibloc/rpc/pb"
+	"github.com/urfave/cli"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// Error types of console commands
+var (
+	ErrUnknownConsoleCommand = errors.New("unknown command. use help to list commands")
+	ErrInvalidConsoleArg     = errors.New("invalid argument. use {field}={value} or a json object of the request")
+	ErrUnknownRequestField   = errors.New("unknown request field. use help {method} to list fields")
+	ErrUnsupportedField      = errors.New("field cannot be set by {field}={value}. use a json object of the request")
+	ErrStreamNotSupported    = errors.New("streaming method is not supported in console")
+)
+
+const consoleTimeout = 10 * time.Second
+
+// errConsoleExit is returned by exit command to quit the console.
+var errConsoleExit = errors.New("exit")
+
+var (
+	consoleScriptFlag = cli.StringFlag{
+		Name:  "script, s",
+		Usage: "file of commands run in batch mode. Stdin is used if '-'",
+	}
+
+	consoleSendFlags = append([]cli.Flag{txTypeFlag, fromFlag, toFlag, valueFlag, nonceFlag, chainIDFlag, timestampFlag},
+		payloadFlags...)
+)
+
+var consoleCommand = cli.Command{
+	Name:  "console",
+	Usage: "interactive console calling ApiService methods over rpc",
+	Description: `Methods of ApiService are called with {field}={value} arguments or a json object of the request.
+   e.g. GetAccount address=02fc...056c type=tail
+   Press tab to complete methods and fields. Commands are read from --script, or from stdin if stdin is not
+   a terminal, in batch mode which stops at the first error.
+   send signs a transaction with the key in the key directory and sends it. It takes the flags of tx build.
+   --value may be given in MED. e.g. 1.5MED
+   Chain id and nonce are fetched from the node if they are not given.`,
+	Flags:  []cli.Flag{rpcFlag, apiKeyFlag, tlsCAFileFlag, configFlag, keydirFlag, passphraseFileFlag, consoleScriptFlag},
+	Action: runConsole,
+}
+
+func runConsole(ctx *cli.Context) error {
+	conn, err := dialRPC(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	c := newConsole(ctx, rpcpb.NewApiServiceClient(conn))
+	script := ctx.String("script")
+	switch {
+	case script == "-":
+		return c.batch(os.Stdin)
+	case script != "":
+		f, err := os.Open(script)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		return c.batch(f)
+	case !terminal.IsTerminal(int(os.Stdin.Fd())):
+		return c.batch(os.Stdin)
+	}
+	return c.interactive()
+}
+
+// consoleMethod is a unary method of ApiService.
+type consoleMethod struct {
+	name    string
+	reqType reflect.Type
+	stream  bool
+}
+
+// apiMethods returns methods of ApiService by lower case names.
+func apiMethods() map[string]*consoleMethod {
+	methods := make(map[string]*consoleMethod)
+	t := reflect.TypeOf((*rpcpb.ApiServiceClient)(nil)).Elem()
+	for i := 0; i < t.NumMethod(); i++ {
+		m := t.Method(i)
+		// func(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error)
+		methods[strings.ToLower(m.Name)] = &consoleMethod{
+			name:    m.Name,
+			reqType: m.Type.In(1).Elem(),
+			stream:  m.Type.Out(0).Kind() == reflect.Interface,
+		}
+	}
+	return methods
+}
+
+// requestFields returns json names of the request fields.
+func requestFields(t reflect.Type) []string {
+	var fields []string
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		fields = append(fields, name)
+	}
+	return fields
+}
+
+// newRequest returns a request of the type from {field}={value} arguments, or a json object.
+func newRequest(t reflect.Type, args []string) (reflect.Value, error) {
+	req := reflect.New(t)
+	if len(args) > 0 && strings.HasPrefix(args[0], "{") {
+		if err := json.Unmarshal([]byte(strings.Join(args, " ")), req.Interface()); err != nil {
+			return reflect.Value{}, err
+		}
+		return req, nil
+	}
+
+	for _, arg := range args {
+		kv := strings.SplitN(arg, "=", 2)
+		if len(kv) != 2 {
+			return reflect.Value{}, ErrInvalidConsoleArg
+		}
+		field, ok := requestField(t, kv[0])
+		if !ok {
+			return reflect.Value{}, ErrUnknownRequestField
+		}
+		if err := setRequestField(req.Elem().FieldByIndex(field.Index), kv[1]); err != nil {
+			return reflect.Value{}, err
+		}
+	}
+	return req, nil
+}
+
+func requestField(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if strings.Split(f.Tag.Get("json"), ",")[0] == name || strings.EqualFold(f.Name, name) {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+func setRequestField(v reflect.Value, s string) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return ErrInvalidConsoleArg
+		}
+		v.SetBool(b)
+	case reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return ErrInvalidConsoleArg
+		}
+		v.SetUint(n)
+	case reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return ErrInvalidConsoleArg
+		}
+		v.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return ErrInvalidConsoleArg
+		}
+		v.SetFloat(n)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.String {
+			return ErrUnsupportedField
+		}
+		for _, item := range strings.Split(s, ",") {
+			v.Set(reflect.Append(v, reflect.ValueOf(item)))
+		}
+	default:
+		return ErrUnsupportedField
+	}
+	return nil
+}
+
+// console runs commands calling ApiService methods.
+type console struct {
+	ctx     *cli.Context
+	client  rpcpb.ApiServiceClient
+	methods map[string]*consoleMethod
+
+	out  io.Writer
+	term *terminal.Terminal
+}
+
+func newConsole(ctx *cli.Context, client rpcpb.ApiServiceClient) *console {
+	return &console{
+		ctx:     ctx,
+		client:  client,
+		methods: apiMethods(),
+		out:     os.Stdout,
+	}
+}
+
+func (c *console) interactive() error {
+	fd := int(os.Stdin.Fd())
+	state, err := terminal.MakeRaw(fd)
+	if err != nil {
+		return err
+	}
+	defer terminal.Restore(fd, state)
+
+	c.term = terminal.NewTerminal(struct {
+		io.Reader
+		io.Writer
+	}{os.Stdin, os.Stdout}, "> ")
+	c.term.AutoCompleteCallback = c.complete
+	c.out = c.term
+
+	fmt.Fprintf(c.out, "Connected to %s. Type help to list commands, exit or Ctrl-D to quit.\n",
+		c.ctx.String(rpcFlag.Name))
+	for {
+		line, err := c.term.ReadLine()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		err = c.exec(line)
+		if err == errConsoleExit {
+			return nil
+		}
+		if err != nil {
+			fmt.Fprintf(c.out, "Error: %v\n", err)
+		}
+	}
+}
+
+// batch runs commands of the reader line by line. It stops at the first error.
+func (c *console) batch(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fmt.Fprintf(c.out, "> %s\n", line)
+		err := c.exec(line)
+		if err == errConsoleExit {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+func (c *console) exec(line string) error {
+	args := strings.Fields(line)
+	if len(args) == 0 {
+		return nil
+	}
+	switch args[0] {
+	case "help":
+		return c.help(args[1:])
+	case "send":
+		return c.send(args[1:])
+	case "exit", "quit":
+		return errConsoleExit
+	}
+	m, ok := c.methods[strings.ToLower(args[0])]
+	if !ok {
+		return ErrUnknownConsoleCommand
+	}
+	return c.call(m, args[1:])
+}
+
+func (c *console) call(m *consoleMethod, args []string) error {
+	if m.stream {
+		return ErrStreamNotSupported
+	}
+	req, err := newRequest(m.reqType, args)
+	if err != nil {
+		return err
+	}
+
+	rctx, cancel := rpcContext(c.ctx, consoleTimeout)
+	defer cancel()
+	out := reflect.ValueOf(c.client).MethodByName(m.name).Call([]reflect.Value{reflect.ValueOf(rctx), req})
+	if err, _ := out[1].Interface().(error); err != nil {
+		return err
+	}
+	return printResponse(c.out, out[0].Interface())
+}
+
+func (c *console) help(args []string) error {
+	w := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	if len(args) > 0 {
+		m, ok := c.methods[strings.ToLower(args[0])]
+		if !ok {
+			return ErrUnknownConsoleCommand
+		}
+		for _, field := range requestFields(m.reqType) {
+			fmt.Fprintf(w, "  %s=\n", field)
+		}
+		return w.Flush()
+	}
+
+	fmt.Fprintln(w, "Methods:")
+	for _, name := range c.methodNames() {
+		m := c.methods[strings.ToLower(name)]
+		if m.stream {
+			continue
+		}
+		fmt.Fprintf(w, "  %s\t%s\n", name, strings.Join(requestFields(m.reqType), " "))
+	}
+	fmt.Fprintln(w, "Commands:")
+	fmt.Fprintln(w, "  send\tsign a transaction with the key in the key directory and send it. e.g. send --type transfer --from {address} --to {address} --value 1MED")
+	fmt.Fprintln(w, "  help [method]\tprint commands, or fields of the method")
+	fmt.Fprintln(w, "  exit\tquit the console")
+	return w.Flush()
+}
+
+func (c *console) methodNames() []string {
+	names := make([]string, 0, len(c.methods))
+	for _, m := range c.methods {
+		names = append(names, m.name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// send builds a transaction with the flags of tx build, signs it and sends it to the node.
+func (c *console) send(args []string) error {
+	set := flag.NewFlagSet("send", flag.ContinueOnError)
+	set.SetOutput(c.out)
+	for _, f := range consoleSendFlags {
+		f.Apply(set)
+	}
+	if err := set.Parse(args); err != nil {
+		return err
+	}
+	given := make(map[string]bool)
+	set.Visit(func(f *flag.Flag) {
+		given[f.Name] = true
+	})
+
+	if value := set.Lookup(valueFlag.Name).Value.String(); strings.HasSuffix(value, medSymbol) {
+		amount, err := parseMED(value)
+		if err != nil {
+			return err
+		}
+		set.Set(valueFlag.Name, amount)
+	}
+	if !given[chainIDFlag.Name] {
+		rctx, cancel := rpcContext(c.ctx, consoleTimeout)
+		defer cancel()
+		state, err := c.client.GetMedState(rctx, &rpcpb.NonParamRequest{})
+		if err != nil {
+			return err
+		}
+		set.Set(chainIDFlag.Name, strconv.FormatUint(uint64(state.ChainId), 10))
+	}
+	if !given[nonceFlag.Name] {
+		from, err := parseAddress(set.Lookup(fromFlag.Name).Value.String())
+		if err != nil {
+			return err
+		}
+		rctx, cancel := rpcContext(c.ctx, consoleTimeout)
+		defer cancel()
+		acc, err := c.client.GetAccount(rctx, &rpcpb.GetAccountRequest{Address: from.Hex(), Type: rpc.TAIL})
+		if err != nil {
+			return err
+		}
+		set.Set(nonceFlag.Name, strconv.FormatUint(acc.Nonce+1, 10))
+	}
+
+	tx, err := buildTx(cli.NewContext(c.ctx.App, set, c.ctx))
+	if err != nil {
+		return err
+	}
+	signer, err := c.signer(tx.From())
+	if err != nil {
+		return err
+	}
+	if err := tx.SignThis(signer); err != nil {
+		return err
+	}
+
+	rctx, cancel := rpcContext(c.ctx, consoleTimeout)
+	defer cancel()
+	resp, err := c.client.SendTransaction(rctx, txToRequest(tx))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(c.out, resp.Hash)
+	return nil
+}
+
+// signer returns a signer of the key in the key directory. The passphrase is read from --passphrase-file, or
+// prompted on the terminal.
+func (c *console) signer(addr common.Address) (signature.Signature, error) {
+	dir, err := keydir(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return keystoreSigner(dir, addr, func() (string, error) {
+		prompt := fmt.Sprintf("Passphrase of %s: ", addr.Hex())
+		if file := c.ctx.String(passphraseFileFlag.Name); file != "" || c.term == nil {
+			return readPassphrase(file, prompt, false)
+		}
+		return c.term.ReadPassword(prompt)
+	})
+}
+
+// complete completes methods, request fields and flags of send on tab.
+func (c *console) complete(line string, pos int, key rune) (string, int, bool) {
+	if key != '\t' {
+		return "", 0, false
+	}
+	head := line[:pos]
+	start := strings.LastIndex(head, " ") + 1
+	word := head[start:]
+
+	var candidates []string
+	if start == 0 {
+		candidates = append(c.methodNames(), "send", "help", "exit")
+	} else {
+		command := strings.Fields(head)[0]
+		if command == "send" {
+			for _, f := range consoleSendFlags {
+				candidates = append(candidates, "--"+strings.Split(f.GetName(), ",")[0])
+			}
+		} else if m, ok := c.methods[strings.ToLower(command)]; ok {
+			for _, field := range requestFields(m.reqType) {
+				candidates = append(candidates, field+"=")
+			}
+		}
+	}
+
+	var matches []string
+	for _, cand := range candidates {
+		if strings.HasPrefix(strings.ToLower(cand), strings.ToLower(word)) {
+			matches = append(matches, cand)
+		}
+	}
+	if len(matches) == 0 {
+		return "", 0, false
+	}
+	completion := commonPrefix(matches)
+	if len(matches) > 1 && len(completion) <= len(word) {
+		fmt.Fprintln(c.term, strings.Join(matches, "  "))
+		return "", 0, false
+	}
+	if len(matches) == 1 && !strings.HasSuffix(completion, "=") {
+		completion += " "
+	}
+	return head[:start] + completion + line[pos:], start + len(completion), true
+}
+
+func commonPrefix(list []string) string {
+	prefix := list[0]
+	for _, s := range list[1:] {
+		for !strings.HasPrefix(s, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
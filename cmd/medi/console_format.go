@@ -0,0 +1,166 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/medibloc/go-medibloc/rpc/pb"
+)
+
+// ErrInvalidMEDAmount is returned if an amount in MED is invalid.
+var ErrInvalidMEDAmount = errors.New("invalid MED amount. use up to 8 decimal places. e.g. 1.5MED")
+
+const (
+	medSymbol = "MED"
+	// medDecimals is the number of decimal places of MED. Amounts are in unit of 1/(10^8) MED.
+	medDecimals = 8
+)
+
+var medUnit = new(big.Int).Exp(big.NewInt(10), big.NewInt(medDecimals), nil)
+
+// formatMED returns the amount in unit of 1/(10^8) MED as MED. e.g. 150000000 is 1.5 MED
+func formatMED(amount string) string {
+	if amount == "" {
+		amount = "0"
+	}
+	v, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return amount
+	}
+	sign := ""
+	if v.Sign() < 0 {
+		sign = "-"
+	}
+	q, r := new(big.Int).QuoRem(new(big.Int).Abs(v), medUnit, new(big.Int))
+	if r.Sign() == 0 {
+		return fmt.Sprintf("%s%s %s", sign, q, medSymbol)
+	}
+	frac := strings.TrimRight(fmt.Sprintf("%0*s", medDecimals, r.String()), "0")
+	return fmt.Sprintf("%s%s.%s %s", sign, q, frac, medSymbol)
+}
+
+// parseMED returns the amount of MED in unit of 1/(10^8) MED. e.g. 1.5MED is 150000000
+func parseMED(s string) (string, error) {
+	s = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(s), medSymbol))
+	parts := strings.Split(s, ".")
+	if s == "" || len(parts) > 2 {
+		return "", ErrInvalidMEDAmount
+	}
+	frac := ""
+	if len(parts) == 2 {
+		frac = parts[1]
+		if frac == "" {
+			return "", ErrInvalidMEDAmount
+		}
+	}
+	if parts[0] == "" || len(frac) > medDecimals {
+		return "", ErrInvalidMEDAmount
+	}
+	digits := parts[0] + frac + strings.Repeat("0", medDecimals-len(frac))
+	for _, d := range digits {
+		if d < '0' || d > '9' {
+			return "", ErrInvalidMEDAmount
+		}
+	}
+	v, _ := new(big.Int).SetString(digits, 10)
+	return v.String(), nil
+}
+
+func formatTimestamp(ts int64) string {
+	return fmt.Sprintf("%s (%d)", time.Unix(ts, 0).UTC().Format(time.RFC3339), ts)
+}
+
+// printResponse prints accounts, blocks and transactions in MED. Other responses are printed in json.
+func printResponse(w io.Writer, resp interface{}) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	switch r := resp.(type) {
+	case *rpcpb.GetAccountResponse:
+		printAccount(tw, r)
+	case *rpcpb.GetBlockResponse:
+		printBlock(tw, r)
+	case *rpcpb.GetBlocksResponse:
+		for _, b := range r.Blocks {
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%d txs\n", b.Height, b.Hash, formatTimestamp(b.Timestamp), len(b.Transactions))
+		}
+	case *rpcpb.GetTransactionResponse:
+		printTx(tw, r)
+	case *rpcpb.GetTransactionsResponse:
+		for _, tx := range r.Transactions {
+			printTxSummary(tw, tx)
+		}
+	default:
+		b, err := json.MarshalIndent(resp, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(w, string(b))
+		return nil
+	}
+	return tw.Flush()
+}
+
+func printAccount(w io.Writer, acc *rpcpb.GetAccountResponse) {
+	fmt.Fprintf(w, "Address\t%s\n", acc.Address)
+	fmt.Fprintf(w, "Balance\t%s\n", formatMED(acc.Balance))
+	fmt.Fprintf(w, "Vesting\t%s\n", formatMED(acc.Vesting))
+	fmt.Fprintf(w, "Bandwidth\t%s\n", formatMED(acc.Bandwidth))
+	fmt.Fprintf(w, "Unstaking\t%s\n", formatMED(acc.Unstaking))
+	fmt.Fprintf(w, "Nonce\t%d\n", acc.Nonce)
+	fmt.Fprintf(w, "Voted\t%s\n", strings.Join(acc.Voted, ", "))
+}
+
+// printBlock prints the block header, and then transactions which are aligned apart from the header.
+func printBlock(w *tabwriter.Writer, b *rpcpb.GetBlockResponse) {
+	fmt.Fprintf(w, "Height\t%d\n", b.Height)
+	fmt.Fprintf(w, "Hash\t%s\n", b.Hash)
+	fmt.Fprintf(w, "Parent hash\t%s\n", b.ParentHash)
+	fmt.Fprintf(w, "Coinbase\t%s\n", b.Coinbase)
+	fmt.Fprintf(w, "Timestamp\t%s\n", formatTimestamp(b.Timestamp))
+	fmt.Fprintf(w, "Reward\t%s\n", formatMED(b.Reward))
+	fmt.Fprintf(w, "Supply\t%s\n", formatMED(b.Supply))
+	fmt.Fprintf(w, "Chain ID\t%d\n", b.ChainId)
+	fmt.Fprintf(w, "Transactions\t%d\n", len(b.Transactions))
+	w.Flush()
+	for _, tx := range b.Transactions {
+		fmt.Fprint(w, "  ")
+		printTxSummary(w, tx)
+	}
+}
+
+func printTx(w io.Writer, tx *rpcpb.GetTransactionResponse) {
+	fmt.Fprintf(w, "Hash\t%s\n", tx.Hash)
+	fmt.Fprintf(w, "Type\t%s\n", tx.TxType)
+	fmt.Fprintf(w, "From\t%s\n", tx.From)
+	fmt.Fprintf(w, "To\t%s\n", tx.To)
+	fmt.Fprintf(w, "Value\t%s\n", formatMED(tx.Value))
+	fmt.Fprintf(w, "Nonce\t%d\n", tx.Nonce)
+	fmt.Fprintf(w, "Timestamp\t%s\n", formatTimestamp(tx.Timestamp))
+	fmt.Fprintf(w, "Chain ID\t%d\n", tx.ChainId)
+	fmt.Fprintf(w, "Payload\t%s\n", tx.Payload)
+	fmt.Fprintf(w, "Executed\t%t\n", tx.Executed)
+}
+
+func printTxSummary(w io.Writer, tx *rpcpb.GetTransactionResponse) {
+	fmt.Fprintf(w, "%s\t%s\t%s -> %s\t%s\n", tx.Hash, tx.TxType, tx.From, tx.To, formatMED(tx.Value))
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/medibloc/go-medibloc/rpc/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMED(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"1MED", "100000000"},
+		{"1.5MED", "150000000"},
+		{"1.5 MED", "150000000"},
+		{" 2.25 MED ", "225000000"},
+		{"0MED", "0"},
+		{"0.00000001MED", "1"},
+		{"12345678.87654321MED", "1234567887654321"},
+		{"007.10MED", "710000000"},
+		{"340282366920938463463.37460743MED", "34028236692093846346337460743"},
+	}
+	for _, test := range tests {
+		amount, err := parseMED(test.s)
+		require.NoError(t, err, test.s)
+		assert.Equal(t, test.want, amount, test.s)
+	}
+
+	for _, s := range []string{
+		"", "MED", " MED", ".", "1.", ".5", "1.2.3", "-1MED", "+1MED", "1e8MED", "1,000MED", "1 000MED",
+		"one MED", "1.5med", "0x10MED",
+		// more decimal places than MED has are rejected instead of rounded.
+		"0.000000001MED", "1.123456789MED", "1.000000000MED",
+	} {
+		_, err := parseMED(s)
+		assert.Equal(t, ErrInvalidMEDAmount, err, s)
+	}
+}
+
+func TestFormatMED(t *testing.T) {
+	tests := []struct {
+		amount string
+		want   string
+	}{
+		{"", "0 MED"},
+		{"0", "0 MED"},
+		{"1", "0.00000001 MED"},
+		{"10", "0.0000001 MED"},
+		{"100000000", "1 MED"},
+		{"150000000", "1.5 MED"},
+		{"1234567887654321", "12345678.87654321 MED"},
+		{"-150000000", "-1.5 MED"},
+		{"-1", "-0.00000001 MED"},
+		// malformed amounts are printed as they are.
+		{"1.5", "1.5"},
+		{"abc", "abc"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, formatMED(test.amount), test.amount)
+	}
+
+	for _, s := range []string{"0.00000001MED", "1.5MED", "100MED", "12345678.87654321MED"} {
+		amount, err := parseMED(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, s[:len(s)-len(medSymbol)]+" "+medSymbol, formatMED(amount), s)
+	}
+}
+
+// consoleTestRequest has fields of kinds which cannot be set from the console.
+type consoleTestRequest struct {
+	Names   []string          `json:"names,omitempty"`
+	Numbers []uint64          `json:"numbers,omitempty"`
+	Nested  *rpcpb.Candidate  `json:"nested,omitempty"`
+	Labels  map[string]string `json:"labels,omitempty"`
+	Ratio   float64           `json:"ratio,omitempty"`
+	Offset  int64             `json:"offset,omitempty"`
+}
+
+func TestNewRequest(t *testing.T) {
+	accType := reflect.TypeOf(rpcpb.GetAccountRequest{})
+	req, err := newRequest(accType, []string{"address=02fc", "type=tail", "height=3"})
+	require.NoError(t, err)
+	assert.Equal(t, &rpcpb.GetAccountRequest{Address: "02fc", Type: "tail", Height: 3}, req.Interface())
+
+	// go field names are matched case-insensitively, and a value may contain '='.
+	req, err = newRequest(accType, []string{"ADDRESS=a=b"})
+	require.NoError(t, err)
+	assert.Equal(t, &rpcpb.GetAccountRequest{Address: "a=b"}, req.Interface())
+
+	req, err = newRequest(accType, []string{`{"address":`, `"02fc", "height": 3}`})
+	require.NoError(t, err)
+	assert.Equal(t, &rpcpb.GetAccountRequest{Address: "02fc", Height: 3}, req.Interface())
+
+	req, err = newRequest(accType, nil)
+	require.NoError(t, err)
+	assert.Equal(t, &rpcpb.GetAccountRequest{}, req.Interface())
+
+	txsType := reflect.TypeOf(rpcpb.GetAccountTransactionsRequest{})
+	req, err = newRequest(txsType, []string{"include_pending=false", "limit=10"})
+	require.NoError(t, err)
+	assert.Equal(t, &rpcpb.GetAccountTransactionsRequest{Limit: 10}, req.Interface())
+
+	testType := reflect.TypeOf(consoleTestRequest{})
+	req, err = newRequest(testType, []string{"names=a,b", "names=c", "ratio=0.5", "offset=-3"})
+	require.NoError(t, err)
+	assert.Equal(t, &consoleTestRequest{Names: []string{"a", "b", "c"}, Ratio: 0.5, Offset: -3}, req.Interface())
+
+	tests := []struct {
+		t    reflect.Type
+		args []string
+		err  error
+	}{
+		{accType, []string{"address"}, ErrInvalidConsoleArg},
+		{accType, []string{"unknown=1"}, ErrUnknownRequestField},
+		{accType, []string{"height=three"}, ErrInvalidConsoleArg},
+		{accType, []string{"height=-1"}, ErrInvalidConsoleArg},
+		{accType, []string{"height=18446744073709551616"}, ErrInvalidConsoleArg},
+		{txsType, []string{"include_pending=maybe"}, ErrInvalidConsoleArg},
+		{testType, []string{"offset=1.5"}, ErrInvalidConsoleArg},
+		{testType, []string{"ratio=half"}, ErrInvalidConsoleArg},
+		{testType, []string{"numbers=1,2"}, ErrUnsupportedField},
+		{testType, []string{"nested=1"}, ErrUnsupportedField},
+		{testType, []string{"labels=a"}, ErrUnsupportedField},
+	}
+	for _, test := range tests {
+		_, err := newRequest(test.t, test.args)
+		assert.Equal(t, test.err, err, "%v", test.args)
+	}
+
+	_, err = newRequest(accType, []string{`{"address": 1}`})
+	assert.Error(t, err)
+	_, err = newRequest(accType, []string{`{"address":`})
+	assert.Error(t, err)
+}
@@ -0,0 +1,667 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/common/trie"
+	"github.com/medibloc/go-medibloc/consensus/dpos"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/medlet"
+	"github.com/medibloc/go-medibloc/medlet/pb"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/urfave/cli"
+)
+
+// Error types of db commands
+var (
+	ErrDatadirNotConfigured = errors.New("datadir is not configured. use --datadir or --config with global.datadir")
+	ErrDatadirNotFound      = errors.New("datadir not found")
+	ErrVerifyFailed         = errors.New("some blocks failed verification")
+	ErrNotWritable          = errors.New("storage is opened in read-only mode. use --write")
+	ErrConfigMissing        = errors.New("config is missing. use --config")
+)
+
+var (
+	datadirFlag = cli.StringFlag{
+		Name:  "datadir",
+		Usage: "data directory of the node. It overrides global.datadir of the config",
+	}
+	writeFlag = cli.BoolFlag{
+		Name:  "write",
+		Usage: "open the storage writable and apply the change. The node must be stopped",
+	}
+	heightFlag = cli.Uint64Flag{
+		Name:  "height",
+		Usage: "height of the block whose state is used. The tail is used if neither height nor block is given",
+	}
+	blockFlag = cli.StringFlag{
+		Name:  "block",
+		Usage: "hash of the block whose state is used",
+	}
+	rootFlag = cli.StringFlag{
+		Name:  "root",
+		Usage: "hex string of the state root. It overrides --height and --block",
+	}
+	prefixFlag = cli.StringFlag{
+		Name:  "prefix",
+		Usage: "hex string of the key prefix",
+	}
+	limitFlag = cli.IntFlag{
+		Name:  "limit",
+		Usage: "maximum number of printed keys. Unlimited if 0",
+	}
+	valuesFlag = cli.BoolFlag{
+		Name:  "values",
+		Usage: "print values with keys",
+	}
+	fromHeightFlag = cli.Uint64Flag{
+		Name:  "from",
+		Usage: "first height to verify",
+		Value: core.GenesisHeight,
+	}
+	toHeightFlag = cli.Uint64Flag{
+		Name:  "to",
+		Usage: "last height to verify. The tail height is used if 0",
+	}
+	textKeyFlag = cli.BoolFlag{
+		Name:  "text-key",
+		Usage: "use the key as it is instead of decoding hex. e.g. blockchain_tail",
+	}
+	fullFlag = cli.BoolFlag{
+		Name:  "full",
+		Usage: "visit every node of the state tries",
+	}
+
+	dbFlags = []cli.Flag{configFlag, datadirFlag}
+)
+
+var dbCommand = cli.Command{
+	Name:  "db",
+	Usage: "inspect and repair the chain storage. The storage is opened read-only unless --write is given",
+	Subcommands: []cli.Command{
+		{
+			Name:   "heads",
+			Usage:  "print tail, LIB and genesis blocks",
+			Flags:  dbFlags,
+			Action: dbHeads,
+		},
+		{
+			Name:      "block",
+			Usage:     "print a block of the height or hash",
+			ArgsUsage: "<height|hash>",
+			Flags:     dbFlags,
+			Action:    dbBlock,
+		},
+		{
+			Name:      "account",
+			Usage:     "print an account in the account state",
+			ArgsUsage: "<address>",
+			Flags:     append(dbFlags, heightFlag, blockFlag, rootFlag),
+			Action:    dbAccount,
+		},
+		{
+			Name:   "dpos",
+			Usage:  "print candidates and dynasty in the dpos state",
+			Flags:  append(dbFlags, heightFlag, blockFlag, rootFlag),
+			Action: dbDpos,
+		},
+		{
+			Name:      "trie",
+			Usage:     "print keys of the trie under the prefix",
+			ArgsUsage: "<root>",
+			Flags:     append(dbFlags, prefixFlag, limitFlag, valuesFlag),
+			Action:    dbTrie,
+		},
+		{
+			Name:   "verify",
+			Usage:  "verify that blocks on the canonical chain are linked and their state roots resolve",
+			Flags:  append(dbFlags, fromHeightFlag, toHeightFlag, fullFlag),
+			Action: dbVerify,
+		},
+		{
+			Name:      "set-tail",
+			Usage:     "set the tail to a block on the canonical chain, removing the blocks above it as rollback does",
+			ArgsUsage: "<height|hash>",
+			Flags:     append(dbFlags, writeFlag),
+			Action:    dbSetTail,
+		},
+		{
+			Name:      "set-lib",
+			Usage:     "set LIB to a block on the canonical chain",
+			ArgsUsage: "<height|hash>",
+			Flags:     append(dbFlags, writeFlag),
+			Action:    dbSetLIB,
+		},
+		{
+			Name:      "get",
+			Usage:     "print the raw value of the key",
+			ArgsUsage: "<key>",
+			Flags:     append(dbFlags, textKeyFlag),
+			Action:    dbGet,
+		},
+		{
+			Name:      "put",
+			Usage:     "put the raw value of the key",
+			ArgsUsage: "<key> <value>",
+			Flags:     append(dbFlags, textKeyFlag, writeFlag),
+			Action:    dbPut,
+		},
+	},
+}
+
+// datadir returns the data directory given by --datadir, or global.datadir of the config given by --config.
+func datadir(ctx *cli.Context) (string, error) {
+	dir := ctx.String(datadirFlag.Name)
+	if dir == "" {
+		path := ctx.String("config")
+		if path == "" {
+			return "", ErrDatadirNotConfigured
+		}
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return "", ErrConfigNotFound
+		}
+		conf, err := medlet.LoadConfig(path)
+		if err != nil {
+			return "", err
+		}
+		if err := applyConfigOverrides(ctx, conf); err != nil {
+			return "", err
+		}
+		dir = conf.Global.Datadir
+	}
+	if dir == "" {
+		return "", ErrDatadirNotConfigured
+	}
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return "", ErrDatadirNotFound
+	}
+	return dir, nil
+}
+
+// nodeConfig returns the config given by --config and the genesis configuration of the config.
+func nodeConfig(ctx *cli.Context) (*medletpb.Config, *corepb.Genesis, error) {
+	path := ctx.String("config")
+	if path == "" {
+		return nil, nil, ErrConfigMissing
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil, nil, ErrConfigNotFound
+	}
+	conf, err := medlet.LoadConfig(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := applyConfigOverrides(ctx, conf); err != nil {
+		return nil, nil, err
+	}
+	genesis, err := core.LoadGenesisConf(conf.Chain.Genesis)
+	if err != nil {
+		return nil, nil, err
+	}
+	return conf, genesis, nil
+}
+
+// openChainStorage opens the storage of the data directory. It is writable only if writable is true.
+func openChainStorage(ctx *cli.Context, writable bool) (*core.ChainStorage, storage.Storage, error) {
+	dir, err := datadir(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	var stor *storage.RocksStorage
+	if writable {
+		stor, err = storage.NewRocksStorage(dir)
+	} else {
+		stor, err = storage.NewRocksStorageReadOnly(dir)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	// dynasty size is not used to load states.
+	return core.NewChainStorage(stor, dpos.New(0)), stor, nil
+}
+
+// blockDataByArg returns the block data of the height or hash.
+func blockDataByArg(cs *core.ChainStorage, arg string) (*core.BlockData, error) {
+	if height, err := strconv.ParseUint(arg, 10, 64); err == nil && len(arg) < 2*common.AddressLength {
+		return cs.BlockDataByHeight(height)
+	}
+	hash, err := decodeHex(arg)
+	if err != nil {
+		return nil, err
+	}
+	return cs.BlockData(hash)
+}
+
+// stateBlockData returns the block data given by --block or --height, or the tail.
+func stateBlockData(ctx *cli.Context, cs *core.ChainStorage) (*core.BlockData, error) {
+	switch {
+	case ctx.String(blockFlag.Name) != "":
+		hash, err := decodeHex(ctx.String(blockFlag.Name))
+		if err != nil {
+			return nil, err
+		}
+		return cs.BlockData(hash)
+	case ctx.IsSet(heightFlag.Name):
+		return cs.BlockDataByHeight(ctx.Uint64(heightFlag.Name))
+	}
+	hash, err := cs.TailHash()
+	if err != nil {
+		return nil, err
+	}
+	return cs.BlockData(hash)
+}
+
+func printJSON(v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(b))
+	return nil
+}
+
+func blockSummary(bd *core.BlockData) map[string]interface{} {
+	txs := make([]string, 0, len(bd.Transactions()))
+	for _, tx := range bd.Transactions() {
+		txs = append(txs, byteutils.Bytes2Hex(tx.Hash()))
+	}
+	summary := map[string]interface{}{
+		"hash":           byteutils.Bytes2Hex(bd.Hash()),
+		"parent_hash":    byteutils.Bytes2Hex(bd.ParentHash()),
+		"height":         bd.Height(),
+		"timestamp":      bd.Timestamp(),
+		"chain_id":       bd.ChainID(),
+		"coinbase":       bd.Coinbase().Hex(),
+		"reward":         bd.Reward().String(),
+		"supply":         bd.Supply().String(),
+		"acc_state_root": byteutils.Bytes2Hex(bd.AccStateRoot()),
+		"tx_state_root":  byteutils.Bytes2Hex(bd.TxStateRoot()),
+		"dpos_root":      byteutils.Bytes2Hex(bd.DposRoot()),
+		"transactions":   txs,
+	}
+	if proposer, err := bd.Proposer(); err == nil {
+		summary["proposer"] = proposer.Hex()
+	}
+	return summary
+}
+
+func dbHeads(ctx *cli.Context) error {
+	cs, stor, err := openChainStorage(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+
+	heads := make(map[string]interface{})
+	for _, head := range []struct {
+		name string
+		hash func() ([]byte, error)
+	}{
+		{"tail", cs.TailHash},
+		{"lib", cs.LIBHash},
+		{"genesis", func() ([]byte, error) { return cs.HashByHeight(core.GenesisHeight) }},
+	} {
+		hash, err := head.hash()
+		if err != nil {
+			heads[head.name] = map[string]string{"error": err.Error()}
+			continue
+		}
+		bd, err := cs.BlockData(hash)
+		if err != nil {
+			heads[head.name] = map[string]string{"hash": byteutils.Bytes2Hex(hash), "error": err.Error()}
+			continue
+		}
+		heads[head.name] = map[string]interface{}{
+			"hash":      byteutils.Bytes2Hex(hash),
+			"height":    bd.Height(),
+			"timestamp": bd.Timestamp(),
+		}
+	}
+	return printJSON(heads)
+}
+
+func dbBlock(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+	cs, stor, err := openChainStorage(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+
+	bd, err := blockDataByArg(cs, ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	return printJSON(blockSummary(bd))
+}
+
+func dbAccount(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+	addr, err := parseAddress(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	cs, stor, err := openChainStorage(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+
+	root, err := decodeHex(ctx.String(rootFlag.Name))
+	if err != nil {
+		return err
+	}
+	if len(root) == 0 {
+		bd, err := stateBlockData(ctx, cs)
+		if err != nil {
+			return err
+		}
+		root = bd.AccStateRoot()
+	}
+	as, err := core.NewAccountState(root, stor)
+	if err != nil {
+		return err
+	}
+	acc, err := as.GetAccount(addr)
+	if err != nil {
+		return err
+	}
+	return printJSON(map[string]interface{}{
+		"address":           acc.Address.Hex(),
+		"balance":           acc.Balance.String(),
+		"nonce":             acc.Nonce,
+		"vesting":           acc.Vesting.String(),
+		"bandwidth":         acc.Bandwidth.String(),
+		"last_bandwidth_ts": acc.LastBandwidthTs,
+		"unstaking":         acc.Unstaking.String(),
+		"last_unstaking_ts": acc.LastUnstakingTs,
+		"collateral":        acc.Collateral.String(),
+		"vote_power":        acc.VotePower.String(),
+		"voted":             byteutils.BytesSlice2HexSlice(acc.VotedSlice()),
+		"voters":            byteutils.BytesSlice2HexSlice(acc.VotersSlice()),
+		"txs_from":          len(acc.TxsFromSlice()),
+		"txs_to":            len(acc.TxsToSlice()),
+	})
+}
+
+func dbDpos(ctx *cli.Context) error {
+	cs, stor, err := openChainStorage(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+
+	root, err := decodeHex(ctx.String(rootFlag.Name))
+	if err != nil {
+		return err
+	}
+	if len(root) == 0 {
+		bd, err := stateBlockData(ctx, cs)
+		if err != nil {
+			return err
+		}
+		root = bd.DposRoot()
+	}
+	ds, err := dpos.New(0).LoadConsensusState(root, stor)
+	if err != nil {
+		return err
+	}
+	candidates, err := ds.Candidates()
+	if err != nil {
+		return err
+	}
+	dynasty, err := ds.Dynasty()
+	if err != nil {
+		return err
+	}
+	return printJSON(map[string]interface{}{
+		"candidates": addressesToHex(candidates),
+		"dynasty":    addressesToHex(dynasty),
+	})
+}
+
+func addressesToHex(addrs []common.Address) []string {
+	list := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		list = append(list, addr.Hex())
+	}
+	return list
+}
+
+func dbTrie(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+	root, err := decodeHex(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	prefix, err := decodeHex(ctx.String(prefixFlag.Name))
+	if err != nil {
+		return err
+	}
+	cs, stor, err := openChainStorage(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+
+	limit := ctx.Int(limitFlag.Name)
+	values := ctx.Bool(valuesFlag.Name)
+	n, err := cs.WalkTrie(root, prefix, func(key, value []byte) bool {
+		if values {
+			fmt.Printf("%s %s\n", byteutils.Bytes2Hex(key), byteutils.Bytes2Hex(value))
+		} else {
+			fmt.Println(byteutils.Bytes2Hex(key))
+		}
+		limit--
+		return limit != 0
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "%d keys\n", n)
+	return nil
+}
+
+func dbVerify(ctx *cli.Context) error {
+	cs, stor, err := openChainStorage(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+
+	to := ctx.Uint64(toHeightFlag.Name)
+	if to == 0 {
+		if to, err = tailHeight(cs); err != nil {
+			return err
+		}
+	}
+
+	full := ctx.Bool(fullFlag.Name)
+	failed := 0
+	var parentHash []byte
+	for height := ctx.Uint64(fromHeightFlag.Name); height <= to; height++ {
+		err := verifyHeight(cs, height, parentHash, full)
+		if err != nil {
+			failed++
+			fmt.Printf("Height %d: %v\n", height, err)
+			parentHash = nil
+			continue
+		}
+		parentHash, _ = cs.HashByHeight(height)
+	}
+	fmt.Printf("Verified %d blocks from %d to %d. %d failed.\n",
+		to-ctx.Uint64(fromHeightFlag.Name)+1, ctx.Uint64(fromHeightFlag.Name), to, failed)
+	if failed > 0 {
+		return ErrVerifyFailed
+	}
+	return nil
+}
+
+// tailHeight returns the height of the main tail.
+func tailHeight(cs *core.ChainStorage) (uint64, error) {
+	hash, err := cs.TailHash()
+	if err != nil {
+		return 0, err
+	}
+	tail, err := cs.BlockData(hash)
+	if err != nil {
+		return 0, err
+	}
+	return tail.Height(), nil
+}
+
+// verifyHeight verifies the block of the height on the canonical chain. The parent hash is not checked if
+// parentHash is nil.
+func verifyHeight(cs *core.ChainStorage, height uint64, parentHash []byte, full bool) error {
+	bd, err := cs.BlockDataByHeight(height)
+	if err != nil {
+		return err
+	}
+	if parentHash != nil && !byteutils.Equal(parentHash, bd.ParentHash()) {
+		return fmt.Errorf("parent hash %s does not match the block of height %d",
+			byteutils.Bytes2Hex(bd.ParentHash()), height-1)
+	}
+	if err := cs.VerifyBlock(bd, full); err != nil {
+		return err
+	}
+	if !full {
+		return nil
+	}
+
+	block, err := cs.Block(bd.Hash())
+	if err != nil {
+		return err
+	}
+	ds, ok := block.State().DposState().(*dpos.State)
+	if !ok {
+		return nil
+	}
+	for _, b := range []*trie.Batch{ds.CandidateState(), ds.DynastyState()} {
+		root, err := b.RootHash()
+		if err != nil {
+			return err
+		}
+		if _, err := cs.WalkTrie(root, nil, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dbSetTail(ctx *cli.Context) error {
+	return setHead(ctx, "tail", (*core.ChainStorage).SetTail)
+}
+
+func dbSetLIB(ctx *cli.Context) error {
+	return setHead(ctx, "LIB", (*core.ChainStorage).SetLIB)
+}
+
+func setHead(ctx *cli.Context, name string, set func(*core.ChainStorage, []byte) error) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+	write := ctx.Bool(writeFlag.Name)
+	cs, stor, err := openChainStorage(ctx, write)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+
+	bd, err := blockDataByArg(cs, ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	if !write {
+		if err := cs.VerifyBlock(bd, false); err != nil {
+			return err
+		}
+		fmt.Printf("%s would be set to %s (height %d). Run with --%s to apply.\n",
+			name, byteutils.Bytes2Hex(bd.Hash()), bd.Height(), writeFlag.Name)
+		return nil
+	}
+	if err := set(cs, bd.Hash()); err != nil {
+		return err
+	}
+	fmt.Printf("%s is set to %s (height %d).\n", name, byteutils.Bytes2Hex(bd.Hash()), bd.Height())
+	return nil
+}
+
+func dbGet(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+	key, err := keyArg(ctx, ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	_, stor, err := openChainStorage(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+
+	value, err := stor.Get(key)
+	if err != nil {
+		return err
+	}
+	fmt.Println(byteutils.Bytes2Hex(value))
+	return nil
+}
+
+func dbPut(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+	if !ctx.Bool(writeFlag.Name) {
+		return ErrNotWritable
+	}
+	key, err := keyArg(ctx, ctx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	value, err := decodeHex(ctx.Args().Get(1))
+	if err != nil {
+		return err
+	}
+	_, stor, err := openChainStorage(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+	return stor.Put(key, value)
+}
+
+func keyArg(ctx *cli.Context, arg string) ([]byte, error) {
+	if ctx.Bool(textKeyFlag.Name) {
+		return []byte(arg), nil
+	}
+	return decodeHex(arg)
+}
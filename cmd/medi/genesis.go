@@ -0,0 +1,241 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/medibloc/go-medibloc/consensus/dpos"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/crypto/hash"
+	"github.com/medibloc/go-medibloc/keystore"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/urfave/cli"
+)
+
+// Error types of genesis commands
+var (
+	ErrGenesisChainIDMissing = errors.New("chain id is missing. use --chain-id")
+	ErrProducerMissing       = errors.New("producer key file is missing. use --producer")
+	ErrAllocMissing          = errors.New("allocation file is missing. use --alloc")
+	ErrInvalidAllocation     = errors.New("invalid allocation. use {address},{balance},{vesting},{votes separated by ';'}")
+	ErrInvalidTotalSupply    = errors.New("invalid total supply")
+	ErrSupplyMismatch        = errors.New("total supply of allocations does not match --total-supply")
+	ErrGenesisFileExists     = errors.New("genesis file already exists. use --force to overwrite")
+)
+
+var (
+	producerFlag = cli.StringSliceFlag{
+		Name:  "producer",
+		Usage: "key file of a dynasty member. It can be repeated in dynasty order, and the dynasty size is the number of producers",
+	}
+	allocFlag = cli.StringFlag{
+		Name:  "alloc",
+		Usage: "csv file of token distribution with columns of address, balance, vesting and votes separated by ';'",
+	}
+	totalSupplyFlag = cli.StringFlag{
+		Name:  "total-supply",
+		Usage: "expected total supply of the token distribution. It is not checked if empty",
+	}
+	genesisOutFlag = cli.StringFlag{
+		Name:  "out, o",
+		Usage: "genesis conf file",
+		Value: "genesis.conf",
+	}
+	forceFlag = cli.BoolFlag{
+		Name:  "force",
+		Usage: "overwrite the existing genesis conf file",
+	}
+)
+
+var genesisCommand = cli.Command{
+	Name:  "genesis",
+	Usage: "manage genesis configuration",
+	Subcommands: []cli.Command{
+		{
+			Name:   "init",
+			Usage:  "generate a genesis conf file and print the resulting genesis block",
+			Flags:  []cli.Flag{chainIDFlag, producerFlag, allocFlag, totalSupplyFlag, genesisOutFlag, forceFlag},
+			Action: genesisInit,
+		},
+	},
+}
+
+func genesisInit(ctx *cli.Context) error {
+	chainID := uint32(ctx.Uint(chainIDFlag.Name))
+	if chainID == 0 {
+		return ErrGenesisChainIDMissing
+	}
+	producers := ctx.StringSlice(producerFlag.Name)
+	if len(producers) == 0 {
+		return ErrProducerMissing
+	}
+	if ctx.String(allocFlag.Name) == "" {
+		return ErrAllocMissing
+	}
+	out := ctx.String("out")
+	if _, err := os.Stat(out); err == nil && !ctx.Bool(forceFlag.Name) {
+		return ErrGenesisFileExists
+	}
+
+	dynasty := make([]string, 0, len(producers))
+	for _, path := range producers {
+		kf, err := keystore.ReadKeyFile(path)
+		if err != nil {
+			return fmt.Errorf("%s: %v", path, err)
+		}
+		dynasty = append(dynasty, kf.Address.Hex())
+	}
+	dynastySize := uint32(len(dynasty))
+	dist, err := readAllocations(ctx.String(allocFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	conf := &corepb.Genesis{
+		Meta: &corepb.GenesisMeta{
+			ChainId:     chainID,
+			DynastySize: dynastySize,
+		},
+		Consensus: &corepb.GenesisConsensus{
+			Dpos: &corepb.GenesisConsensusDpos{
+				Dynasty: dynasty,
+			},
+		},
+		TokenDistribution: dist,
+	}
+	supply, err := core.ValidateGenesisConf(conf)
+	if err != nil {
+		return err
+	}
+	if s := ctx.String(totalSupplyFlag.Name); s != "" {
+		expected, err := util.NewUint128FromString(s)
+		if err != nil {
+			return ErrInvalidTotalSupply
+		}
+		if supply.Cmp(expected) != 0 {
+			return fmt.Errorf("%v: %s != %s", ErrSupplyMismatch, supply, expected)
+		}
+	}
+
+	block, err := newGenesisBlock(conf)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(out, []byte(proto.MarshalTextString(conf)), 0644); err != nil {
+		return err
+	}
+
+	fmt.Printf("Genesis conf: %s\n", out)
+	fmt.Printf("Chain ID: %d\n", chainID)
+	fmt.Printf("Dynasty size: %d\n", dynastySize)
+	fmt.Printf("Accounts: %d\n", len(dist))
+	fmt.Printf("Supply: %s\n", supply)
+	printGenesisState(conf, block)
+	return nil
+}
+
+// printGenesisState prints the state roots of the genesis block and the fingerprint over them. The hash of the
+// genesis block is always core.GenesisHash, so genesis confs should be compared by the fingerprint.
+func printGenesisState(conf *corepb.Genesis, block *core.Block) {
+	fmt.Printf("Genesis fingerprint: %s\n", byteutils.Bytes2Hex(genesisFingerprint(conf, block)))
+	fmt.Printf("Accounts root: %s\n", byteutils.Bytes2Hex(block.AccStateRoot()))
+	fmt.Printf("Txs root: %s\n", byteutils.Bytes2Hex(block.TxStateRoot()))
+	fmt.Printf("Dpos root: %s\n", byteutils.Bytes2Hex(block.DposRoot()))
+}
+
+// genesisFingerprint returns the hash of the chain id, the dynasty size and the state roots of the genesis block.
+func genesisFingerprint(conf *corepb.Genesis, block *core.Block) []byte {
+	return hash.Sha3256(
+		byteutils.FromUint32(conf.Meta.ChainId),
+		byteutils.FromUint32(conf.Meta.DynastySize),
+		block.AccStateRoot(),
+		block.TxStateRoot(),
+		block.DposRoot(),
+	)
+}
+
+// newGenesisBlock generates the genesis block of the configuration on a memory storage.
+func newGenesisBlock(conf *corepb.Genesis) (*core.Block, error) {
+	stor, err := storage.NewMemoryStorage()
+	if err != nil {
+		return nil, err
+	}
+	return core.NewGenesisBlock(conf, dpos.New(int(conf.Meta.DynastySize)), stor)
+}
+
+// readAllocations reads the token distribution from the csv file. Lines starting with '#' and the header line
+// starting with "address" are skipped.
+func readAllocations(path string) ([]*corepb.GenesisTokenDistribution, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.Comment = '#'
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var dist []*corepb.GenesisTokenDistribution
+	for i, record := range records {
+		if i == 0 && strings.EqualFold(strings.TrimSpace(record[0]), "address") {
+			continue
+		}
+		if len(record) < 2 || len(record) > 4 {
+			return nil, fmt.Errorf("%s:%d: %v", path, i+1, ErrInvalidAllocation)
+		}
+		d := &corepb.GenesisTokenDistribution{
+			Address: strings.TrimSpace(record[0]),
+			Balance: amountOrZero(record[1]),
+			Vesting: "0",
+			Vote:    []string{},
+		}
+		if len(record) > 2 {
+			d.Vesting = amountOrZero(record[2])
+		}
+		if len(record) > 3 {
+			for _, v := range strings.Split(record[3], ";") {
+				if v = strings.TrimSpace(v); v != "" {
+					d.Vote = append(d.Vote, v)
+				}
+			}
+		}
+		dist = append(dist, d)
+	}
+	return dist, nil
+}
+
+func amountOrZero(s string) string {
+	if s = strings.TrimSpace(s); s == "" {
+		return "0"
+	}
+	return s
+}
@@ -21,6 +21,7 @@ import (
 	"os/signal"
 
 	"github.com/medibloc/go-medibloc/medlet"
+	"github.com/medibloc/go-medibloc/metrics"
 	log "github.com/medibloc/go-medibloc/util/logging"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
@@ -38,8 +39,30 @@ func main() {
 	app.Name = "medi"
 	app.Usage = "medibloc command line interface"
 	app.Version = versionStr()
+	app.Flags = []cli.Flag{
+		cli.BoolFlag{
+			Name:  metrics.MetricsEnabledFlag,
+			Usage: "collect metrics",
+		},
+	}
+	app.Flags = append(app.Flags, configOverrideFlags()...)
+	app.Commands = []cli.Command{
+		accountCommand,
+		txCommand,
+		genesisCommand,
+		dbCommand,
+		rollbackCommand,
+		verifyCommand,
+		stateCommand,
+		benchCommand,
+		configCommand,
+		consoleCommand,
+	}
 
-	app.Run(os.Args)
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
 }
 func versionStr() string {
 	if version == "" {
@@ -58,6 +81,19 @@ func medi(ctx *cli.Context) error {
 		return err
 	}
 
+	if err := applyConfigOverrides(ctx, conf); err != nil {
+		log.Console().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to override config.")
+		return err
+	}
+	if err := medlet.ValidateConfig(conf); err != nil {
+		log.Console().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Invalid config.")
+		return err
+	}
+
 	log.Init(conf.App.LogFile, conf.App.LogLevel, conf.App.LogAge)
 
 	m, err := medlet.New(conf)
@@ -0,0 +1,97 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/medibloc/go-medibloc/consensus/dpos"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/urfave/cli"
+)
+
+// Error types of rollback command
+var (
+	ErrRollbackHeightMissing = errors.New("rollback height is missing. use --height")
+)
+
+var (
+	rollbackHeightFlag = cli.Uint64Flag{
+		Name:  "height",
+		Usage: "height of the block on the canonical chain which becomes the new tail",
+	}
+	revertLIBFlag = cli.BoolFlag{
+		Name:  "force",
+		Usage: "revert LIB if the height is lower than LIB",
+	}
+)
+
+var rollbackCommand = cli.Command{
+	Name:  "rollback",
+	Usage: "rewind the main tail to the block of the height. The node must be stopped",
+	Description: `Blocks above the height are removed from the storage and the height index is rebuilt.
+   Transactions of the removed blocks are returned to the pending journal, and they are pushed to
+   the transaction pool when the node starts.`,
+	Flags:  []cli.Flag{configFlag, datadirFlag, rollbackHeightFlag, revertLIBFlag},
+	Action: rollback,
+}
+
+func rollback(ctx *cli.Context) error {
+	if !ctx.IsSet(rollbackHeightFlag.Name) {
+		return ErrRollbackHeightMissing
+	}
+	conf, genesis, err := nodeConfig(ctx)
+	if err != nil {
+		return err
+	}
+	dir, err := datadir(ctx)
+	if err != nil {
+		return err
+	}
+
+	stor, err := storage.NewRocksStorage(dir)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+
+	bc, err := core.NewBlockChain(conf)
+	if err != nil {
+		return err
+	}
+	if err := bc.Setup(genesis, dpos.New(int(genesis.Meta.DynastySize)), stor); err != nil {
+		return err
+	}
+
+	blocks, err := bc.Rollback(ctx.Uint64(rollbackHeightFlag.Name), ctx.Bool(revertLIBFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	txs := 0
+	for _, block := range blocks {
+		fmt.Printf("removed block %s (height %d, %d txs)\n",
+			byteutils.Bytes2Hex(block.Hash()), block.Height(), len(block.Transactions()))
+		txs += len(block.Transactions())
+	}
+	fmt.Printf("%d blocks are removed and %d transactions are returned to the pending journal.\n", len(blocks), txs)
+	fmt.Printf("tail: %s (height %d)\n", byteutils.Bytes2Hex(bc.MainTailBlock().Hash()), bc.MainTailBlock().Height())
+	fmt.Printf("LIB:  %s (height %d)\n", byteutils.Bytes2Hex(bc.LIB().Hash()), bc.LIB().Height())
+	return nil
+}
@@ -0,0 +1,223 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/urfave/cli"
+)
+
+// State formats
+const (
+	StateFormatJSON = "json"
+	StateFormatCSV  = "csv"
+)
+
+// Error types of state commands
+var (
+	ErrInvalidStateFormat = errors.New("invalid state format. use json or csv")
+	ErrDiffHeightMissing  = errors.New("heights to compare are missing. use --from and --to")
+)
+
+var (
+	stateFormatFlag = cli.StringFlag{
+		Name:  "format",
+		Usage: "output format. json(an object per line) or csv",
+		Value: StateFormatJSON,
+	}
+	diffFromFlag = cli.Uint64Flag{
+		Name:  "from",
+		Usage: "height of the block whose state is compared from",
+	}
+	diffToFlag = cli.Uint64Flag{
+		Name:  "to",
+		Usage: "height of the block whose state is compared to",
+	}
+)
+
+var stateCommand = cli.Command{
+	Name:  "state",
+	Usage: "dump and compare account states of the storage",
+	Subcommands: []cli.Command{
+		{
+			Name:   "dump",
+			Usage:  "dump every account in the state of a block",
+			Flags:  append(dbFlags, heightFlag, blockFlag, stateFormatFlag, outFlag),
+			Action: stateDump,
+		},
+		{
+			Name:   "diff",
+			Usage:  "list changed accounts and fields between the states of two blocks",
+			Flags:  append(dbFlags, diffFromFlag, diffToFlag, stateFormatFlag, outFlag),
+			Action: stateDiff,
+		},
+	},
+}
+
+var dumpCSVHeader = []string{
+	"address", "balance", "nonce", "vesting", "bandwidth", "last_bandwidth_ts", "unstaking", "last_unstaking_ts",
+	"collateral", "vote_power", "voted", "data",
+}
+
+var diffCSVHeader = []string{"address", "field", "from", "to"}
+
+func stateDump(ctx *cli.Context) error {
+	format := ctx.String(stateFormatFlag.Name)
+	if format != StateFormatJSON && format != StateFormatCSV {
+		return ErrInvalidStateFormat
+	}
+	cs, stor, err := openChainStorage(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+
+	bd, err := stateBlockData(ctx, cs)
+	if err != nil {
+		return err
+	}
+	block, err := cs.Block(bd.Hash())
+	if err != nil {
+		return err
+	}
+
+	return writeState(ctx, func(w io.Writer) error {
+		enc := json.NewEncoder(w)
+		cw := csv.NewWriter(w)
+		if format == StateFormatCSV {
+			if err := cw.Write(dumpCSVHeader); err != nil {
+				return err
+			}
+		}
+		err := block.State().AccState().Dump(func(dump *core.AccountDump) error {
+			if format == StateFormatJSON {
+				return enc.Encode(dump)
+			}
+			return cw.Write(dumpCSVRecord(dump))
+		})
+		if err != nil {
+			return err
+		}
+		cw.Flush()
+		return cw.Error()
+	})
+}
+
+func stateDiff(ctx *cli.Context) error {
+	format := ctx.String(stateFormatFlag.Name)
+	if format != StateFormatJSON && format != StateFormatCSV {
+		return ErrInvalidStateFormat
+	}
+	if !ctx.IsSet(diffFromFlag.Name) || !ctx.IsSet(diffToFlag.Name) {
+		return ErrDiffHeightMissing
+	}
+	cs, stor, err := openChainStorage(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+
+	var states []*core.AccountState
+	for _, height := range []uint64{ctx.Uint64(diffFromFlag.Name), ctx.Uint64(diffToFlag.Name)} {
+		bd, err := cs.BlockDataByHeight(height)
+		if err != nil {
+			return err
+		}
+		block, err := cs.Block(bd.Hash())
+		if err != nil {
+			return err
+		}
+		states = append(states, block.State().AccState())
+	}
+
+	return writeState(ctx, func(w io.Writer) error {
+		enc := json.NewEncoder(w)
+		cw := csv.NewWriter(w)
+		if format == StateFormatCSV {
+			if err := cw.Write(diffCSVHeader); err != nil {
+				return err
+			}
+		}
+		err := core.DiffAccountStates(states[0], states[1], func(diff *core.AccountDiff) error {
+			if format == StateFormatJSON {
+				return enc.Encode(diff)
+			}
+			for _, f := range diff.Fields {
+				if err := cw.Write([]string{diff.Address, f.Field, f.From, f.To}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		cw.Flush()
+		return cw.Error()
+	})
+}
+
+// writeState streams the output of fn to the file given by --out, or stdout if it is empty.
+func writeState(ctx *cli.Context, fn func(w io.Writer) error) error {
+	out := os.Stdout
+	if path := ctx.String("out"); path != "" {
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	w := bufio.NewWriter(out)
+	if err := fn(w); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// dumpCSVRecord returns the CSV record of the dump. Voted addresses are separated by ';', and data entries are
+// {key}={value} separated by ';' in order of key.
+func dumpCSVRecord(dump *core.AccountDump) []string {
+	data := make([]string, 0, len(dump.Data))
+	for k, v := range dump.Data {
+		data = append(data, k+"="+v)
+	}
+	sort.Strings(data)
+	return []string{
+		dump.Address,
+		dump.Balance,
+		strconv.FormatUint(dump.Nonce, 10),
+		dump.Vesting,
+		dump.Bandwidth,
+		strconv.FormatInt(dump.LastBandwidthTs, 10),
+		dump.Unstaking,
+		strconv.FormatInt(dump.LastUnstakingTs, 10),
+		dump.Collateral,
+		dump.VotePower,
+		strings.Join(dump.Voted, ";"),
+		strings.Join(data, ";"),
+	}
+}
@@ -0,0 +1,530 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/crypto/signature"
+	"github.com/medibloc/go-medibloc/crypto/signature/algorithm"
+	"github.com/medibloc/go-medibloc/keystore"
+	"github.com/medibloc/go-medibloc/medlet"
+	"github.com/medibloc/go-medibloc/rpc"
+	"github.com/medibloc/go-medibloc/rpc/pb"
+	"github.com/medibloc/go-medibloc/util"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/urfave/cli"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+// Transaction formats
+const (
+	TxFormatJSON  = "json"
+	TxFormatProto = "proto"
+)
+
+const sendTimeout = 10 * time.Second
+
+// Error types of tx commands
+var (
+	ErrUnknownTxType   = errors.New("unknown transaction type")
+	ErrInvalidTxFormat = errors.New("invalid transaction format. use json or proto")
+	ErrInvalidTxValue  = errors.New("invalid transaction value")
+	ErrChainIDMissing  = errors.New("chain id is missing. use --chain-id or --config")
+	ErrTxNotSigned     = errors.New("transaction is not signed")
+	ErrPayerMissing    = errors.New("payer address is missing. use --payer")
+)
+
+var (
+	txTypeFlag = cli.StringFlag{
+		Name:  "type",
+		Usage: "transaction type. e.g. transfer, add_record, vest, vote",
+	}
+	fromFlag = cli.StringFlag{
+		Name:  "from",
+		Usage: "sender address",
+	}
+	toFlag = cli.StringFlag{
+		Name:  "to",
+		Usage: "receiver address",
+	}
+	valueFlag = cli.StringFlag{
+		Name:  "value",
+		Usage: "amount of value",
+		Value: "0",
+	}
+	nonceFlag = cli.Uint64Flag{
+		Name:  "nonce",
+		Usage: "nonce of the sender",
+	}
+	chainIDFlag = cli.UintFlag{
+		Name:  "chain-id",
+		Usage: "chain id. It overrides global.chain_id of the config",
+	}
+	timestampFlag = cli.Int64Flag{
+		Name:  "timestamp",
+		Usage: "timestamp in unix seconds. Current time is used if 0",
+	}
+	formatFlag = cli.StringFlag{
+		Name:  "format",
+		Usage: "output format. json or proto(hex encoded protobuf)",
+		Value: TxFormatJSON,
+	}
+	outFlag = cli.StringFlag{
+		Name:  "out, o",
+		Usage: "output file. Stdout is used if empty",
+	}
+	payerFlag = cli.StringFlag{
+		Name:  "payer",
+		Usage: "payer address",
+	}
+	rpcFlag = cli.StringFlag{
+		Name:  "rpc",
+		Usage: "rpc address of the node",
+		Value: "127.0.0.1:9920",
+	}
+	apiKeyFlag = cli.StringFlag{
+		Name:  "api-key",
+		Usage: "api key of the rpc server",
+	}
+	tlsCAFileFlag = cli.StringFlag{
+		Name:  "tls-ca-file",
+		Usage: "CA certificate file to verify the rpc server. TLS is disabled if empty",
+	}
+)
+
+var txCommand = cli.Command{
+	Name:  "tx",
+	Usage: "build, sign and send transactions",
+	Description: "Transactions are read from the file argument, or stdin if the argument is empty or '-'. " +
+		"A transaction file is the json of SendTransactionRequest, or the hex encoded protobuf of the transaction.",
+	Subcommands: []cli.Command{
+		{
+			Name:   "build",
+			Usage:  "build an unsigned transaction",
+			Flags:  append([]cli.Flag{configFlag, txTypeFlag, fromFlag, toFlag, valueFlag, nonceFlag, chainIDFlag, timestampFlag, formatFlag, outFlag}, payloadFlags...),
+			Action: txBuild,
+		},
+		{
+			Name:      "sign",
+			Usage:     "sign a transaction with the key of the sender",
+			ArgsUsage: "[txfile]",
+			Flags:     append(accountFlags, passphraseFileFlag, formatFlag, outFlag),
+			Action:    txSign,
+		},
+		{
+			Name:      "payer-sign",
+			Usage:     "sign a signed transaction with the key of the payer",
+			ArgsUsage: "[txfile]",
+			Flags:     append(accountFlags, payerFlag, passphraseFileFlag, formatFlag, outFlag),
+			Action:    txPayerSign,
+		},
+		{
+			Name:      "send",
+			Usage:     "send a signed transaction to the node",
+			ArgsUsage: "[txfile]",
+			Flags:     []cli.Flag{rpcFlag, apiKeyFlag, tlsCAFileFlag},
+			Action:    txSend,
+		},
+		{
+			Name:      "decode",
+			Usage:     "print a transaction with the decoded payload",
+			ArgsUsage: "[txfile]",
+			Action:    txDecode,
+		},
+	},
+}
+
+func txBuild(ctx *cli.Context) error {
+	tx, err := buildTx(ctx)
+	if err != nil {
+		return err
+	}
+	return writeTx(ctx, tx)
+}
+
+// buildTx returns an unsigned transaction given by the flags of tx build.
+func buildTx(ctx *cli.Context) (*core.Transaction, error) {
+	txType := ctx.String(txTypeFlag.Name)
+	newTx, ok := medlet.DefaultTxMap[txType]
+	if !ok {
+		return nil, ErrUnknownTxType
+	}
+	from, err := parseAddress(ctx.String(fromFlag.Name))
+	if err != nil {
+		return nil, err
+	}
+	var to common.Address
+	if ctx.String(toFlag.Name) != "" {
+		if to, err = parseAddress(ctx.String(toFlag.Name)); err != nil {
+			return nil, err
+		}
+	}
+	value, err := util.NewUint128FromString(ctx.String(valueFlag.Name))
+	if err != nil {
+		return nil, ErrInvalidTxValue
+	}
+	chainID, err := chainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	timestamp := ctx.Int64(timestampFlag.Name)
+	if timestamp == 0 {
+		timestamp = time.Now().Unix()
+	}
+	payload, err := buildPayload(ctx, txType)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &core.Transaction{}
+	tx.SetTxType(txType)
+	tx.SetFrom(from)
+	tx.SetTo(to)
+	tx.SetValue(value)
+	tx.SetTimestamp(timestamp)
+	tx.SetNonce(ctx.Uint64(nonceFlag.Name))
+	tx.SetChainID(chainID)
+	tx.SetPayload(payload)
+	tx.SetAlg(algorithm.SECP256K1)
+
+	// check the transaction is executable as its type.
+	if _, err := newTx(tx); err != nil {
+		return nil, err
+	}
+	hash, err := tx.CalcHash()
+	if err != nil {
+		return nil, err
+	}
+	tx.SetHash(hash)
+	return tx, nil
+}
+
+func txSign(ctx *cli.Context) error {
+	tx, err := readTx(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	signer, err := loadSigner(ctx, tx.From())
+	if err != nil {
+		return err
+	}
+	if err := tx.SignThis(signer); err != nil {
+		return err
+	}
+	// payer's sign is invalidated by the new sign.
+	tx.SetPayerSign(nil)
+	return writeTx(ctx, tx)
+}
+
+func txPayerSign(ctx *cli.Context) error {
+	if ctx.String(payerFlag.Name) == "" {
+		return ErrPayerMissing
+	}
+	payer, err := parseAddress(ctx.String(payerFlag.Name))
+	if err != nil {
+		return err
+	}
+	tx, err := readTx(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	if len(tx.Sign()) == 0 {
+		return ErrTxNotSigned
+	}
+	if err := tx.VerifyIntegrity(tx.ChainID()); err != nil {
+		return err
+	}
+	signer, err := loadSigner(ctx, payer)
+	if err != nil {
+		return err
+	}
+	if err := tx.SignByPayer(signer); err != nil {
+		return err
+	}
+	return writeTx(ctx, tx)
+}
+
+func txSend(ctx *cli.Context) error {
+	tx, err := readTx(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	if len(tx.Sign()) == 0 {
+		return ErrTxNotSigned
+	}
+
+	conn, err := dialRPC(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	c, cancel := rpcContext(ctx, sendTimeout)
+	defer cancel()
+	resp, err := rpcpb.NewApiServiceClient(conn).SendTransaction(c, txToRequest(tx))
+	if err != nil {
+		return err
+	}
+	fmt.Println(resp.Hash)
+	return nil
+}
+
+func txDecode(ctx *cli.Context) error {
+	tx, err := readTx(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	payload, err := decodePayload(tx.TxType(), tx.Payload())
+	if err != nil {
+		return err
+	}
+
+	integrity := "valid"
+	if len(tx.Sign()) == 0 {
+		integrity = ErrTxNotSigned.Error()
+	} else if err := tx.VerifyIntegrity(tx.ChainID()); err != nil {
+		integrity = err.Error()
+	}
+
+	return printJSON(struct {
+		*rpcpb.SendTransactionRequest
+		DecodedPayload interface{} `json:"decoded_payload,omitempty"`
+		Integrity      string      `json:"integrity"`
+	}{
+		SendTransactionRequest: txToRequest(tx),
+		DecodedPayload:         payload,
+		Integrity:              integrity,
+	})
+}
+
+// chainID returns the chain id given by --chain-id, or global.chain_id of the config given by --config.
+func chainID(ctx *cli.Context) (uint32, error) {
+	if ctx.IsSet(chainIDFlag.Name) {
+		return uint32(ctx.Uint(chainIDFlag.Name)), nil
+	}
+	path := ctx.String("config")
+	if path == "" {
+		return 0, ErrChainIDMissing
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return 0, ErrConfigNotFound
+	}
+	conf, err := medlet.LoadConfig(path)
+	if err != nil {
+		return 0, err
+	}
+	return conf.Global.ChainId, nil
+}
+
+// loadSigner decrypts the key of the address in the key directory, and returns a signer of the key.
+func loadSigner(ctx *cli.Context, addr common.Address) (signature.Signature, error) {
+	dir, err := keydir(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return keystoreSigner(dir, addr, func() (string, error) {
+		return readPassphrase(ctx.String(passphraseFileFlag.Name),
+			fmt.Sprintf("Passphrase of %s: ", addr.Hex()), false)
+	})
+}
+
+// keystoreSigner decrypts the key of the address in the key directory with the passphrase returned by
+// passphrase, and returns a signer of the key.
+func keystoreSigner(dir string, addr common.Address, passphrase func() (string, error)) (signature.Signature, error) {
+	kf, err := keystore.FindKeyFile(dir, addr)
+	if err != nil {
+		return nil, err
+	}
+	p, err := passphrase()
+	if err != nil {
+		return nil, err
+	}
+	key, err := kf.Decrypt(p)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := crypto.NewSignature(algorithm.SECP256K1)
+	if err != nil {
+		return nil, err
+	}
+	signer.InitSign(key)
+	return signer, nil
+}
+
+// readTx reads a transaction from the file. Stdin is used if the path is empty or "-".
+func readTx(path string) (*core.Transaction, error) {
+	var b []byte
+	var err error
+	if path == "" || path == "-" {
+		b, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		b, err = ioutil.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	s := strings.TrimSpace(string(b))
+
+	if strings.HasPrefix(s, "{") {
+		req := new(rpcpb.SendTransactionRequest)
+		if err := json.Unmarshal([]byte(s), req); err != nil {
+			return nil, err
+		}
+		return requestToTx(req)
+	}
+
+	buf, err := decodeHex(s)
+	if err != nil {
+		return nil, err
+	}
+	pbTx := new(corepb.Transaction)
+	if err := proto.Unmarshal(buf, pbTx); err != nil {
+		return nil, err
+	}
+	tx := new(core.Transaction)
+	if err := tx.FromProto(pbTx); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// writeTx writes the transaction in the format given by --format to the file given by --out.
+func writeTx(ctx *cli.Context, tx *core.Transaction) error {
+	var out []byte
+	switch ctx.String(formatFlag.Name) {
+	case TxFormatJSON:
+		b, err := json.MarshalIndent(txToRequest(tx), "", "  ")
+		if err != nil {
+			return err
+		}
+		out = b
+	case TxFormatProto:
+		pbTx, err := tx.ToProto()
+		if err != nil {
+			return err
+		}
+		b, err := proto.Marshal(pbTx)
+		if err != nil {
+			return err
+		}
+		out = []byte(byteutils.Bytes2Hex(b))
+	default:
+		return ErrInvalidTxFormat
+	}
+	out = append(out, '\n')
+
+	if path := ctx.String("out"); path != "" {
+		return ioutil.WriteFile(path, out, 0600)
+	}
+	_, err := os.Stdout.Write(out)
+	return err
+}
+
+// dialRPC dials the rpc server given by --rpc. TLS is used if --tls-ca-file is given.
+func dialRPC(ctx *cli.Context) (*grpc.ClientConn, error) {
+	var opts []grpc.DialOption
+	if file := ctx.String(tlsCAFileFlag.Name); file != "" {
+		creds, err := credentials.NewClientTLSFromFile(file, "")
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+	}
+	return rpc.Dial(ctx.String(rpcFlag.Name), opts...)
+}
+
+// rpcContext returns a context of a rpc call with the api key given by --api-key.
+func rpcContext(ctx *cli.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	c, cancel := context.WithTimeout(context.Background(), timeout)
+	if key := ctx.String(apiKeyFlag.Name); key != "" {
+		c = metadata.NewOutgoingContext(c, metadata.Pairs("x-api-key", key))
+	}
+	return c, cancel
+}
+
+func txToRequest(tx *core.Transaction) *rpcpb.SendTransactionRequest {
+	return &rpcpb.SendTransactionRequest{
+		Hash:      byteutils.Bytes2Hex(tx.Hash()),
+		From:      tx.From().Hex(),
+		To:        tx.To().Hex(),
+		Value:     tx.Value().String(),
+		Timestamp: tx.Timestamp(),
+		TxType:    tx.TxType(),
+		Nonce:     tx.Nonce(),
+		ChainId:   tx.ChainID(),
+		Payload:   hex.EncodeToString(tx.Payload()),
+		Alg:       uint32(tx.Alg()),
+		Sign:      byteutils.Bytes2Hex(tx.Sign()),
+		PayerSign: byteutils.Bytes2Hex(tx.PayerSign()),
+	}
+}
+
+func requestToTx(req *rpcpb.SendTransactionRequest) (*core.Transaction, error) {
+	value, err := util.NewUint128FromString(req.Value)
+	if err != nil {
+		return nil, ErrInvalidTxValue
+	}
+	hash, err := decodeHex(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := decodeHex(req.Payload)
+	if err != nil {
+		return nil, err
+	}
+	sign, err := decodeHex(req.Sign)
+	if err != nil {
+		return nil, err
+	}
+	payerSign, err := decodeHex(req.PayerSign)
+	if err != nil {
+		return nil, err
+	}
+	if !common.IsHexAddress(req.From) || (req.To != "" && !common.IsHexAddress(req.To)) {
+		return nil, ErrInvalidAddress
+	}
+
+	tx := &core.Transaction{}
+	tx.SetTxType(req.TxType)
+	tx.SetHash(hash)
+	tx.SetFrom(common.HexToAddress(req.From))
+	tx.SetTo(common.HexToAddress(req.To))
+	tx.SetValue(value)
+	tx.SetTimestamp(req.Timestamp)
+	tx.SetNonce(req.Nonce)
+	tx.SetChainID(req.ChainId)
+	tx.SetPayload(payload)
+	tx.SetAlg(algorithm.Algorithm(req.Alg))
+	tx.SetSign(sign)
+	tx.SetPayerSign(payerSign)
+	return tx, nil
+}
@@ -0,0 +1,313 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/consensus/dpos"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/util"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/urfave/cli"
+)
+
+// Error types of payload encoding
+var (
+	ErrMissingPayloadField = errors.New("payload field is missing")
+	ErrInvalidHex          = errors.New("invalid hex string")
+	ErrInvalidOutput       = errors.New("invalid output. use {address}:{value}")
+)
+
+var (
+	messageFlag = cli.StringFlag{
+		Name:  "message",
+		Usage: "message of transfer",
+	}
+	recordHashFlag = cli.StringFlag{
+		Name:  "record-hash",
+		Usage: "hex string of the record hash (add_record, amend_record)",
+	}
+	prevRecordHashFlag = cli.StringFlag{
+		Name:  "prev-record-hash",
+		Usage: "hex string of the amended record hash (amend_record)",
+	}
+	merkleRootFlag = cli.StringFlag{
+		Name:  "merkle-root",
+		Usage: "hex string of the merkle root of records (add_record_batch)",
+	}
+	recordCountFlag = cli.UintFlag{
+		Name:  "record-count",
+		Usage: "number of records (add_record_batch)",
+	}
+	certHashFlag = cli.StringFlag{
+		Name:  "cert-hash",
+		Usage: "hex string of the certificate hash (add_certification, revoke_certification)",
+	}
+	certTypeFlag = cli.StringFlag{
+		Name:  "cert-type",
+		Usage: "certificate type (add_certification, register_issuer)",
+	}
+	issueTimeFlag = cli.Int64Flag{
+		Name:  "issue-time",
+		Usage: "issue time of the certificate in unix seconds (add_certification)",
+	}
+	expirationTimeFlag = cli.Int64Flag{
+		Name:  "expiration-time",
+		Usage: "expiration time of the certificate in unix seconds (add_certification)",
+	}
+	schemaIDFlag = cli.StringFlag{
+		Name:  "schema-id",
+		Usage: "schema id of the certificate (register_issuer)",
+	}
+	candidatesFlag = cli.StringSliceFlag{
+		Name:  "candidate",
+		Usage: "address of the voted candidate. It can be repeated (vote)",
+	}
+	outputsFlag = cli.StringSliceFlag{
+		Name:  "output",
+		Usage: "recipient and value as {address}:{value}. It can be repeated (batch_transfer)",
+	}
+	payloadFlag = cli.StringFlag{
+		Name:  "payload",
+		Usage: "hex string of the encoded payload. It overrides other payload flags",
+	}
+
+	payloadFlags = []cli.Flag{
+		messageFlag, recordHashFlag, prevRecordHashFlag, merkleRootFlag, recordCountFlag, certHashFlag,
+		certTypeFlag, issueTimeFlag, expirationTimeFlag, schemaIDFlag, candidatesFlag, outputsFlag, payloadFlag,
+	}
+)
+
+// buildPayload returns the encoded payload of the transaction type from the payload flags.
+func buildPayload(ctx *cli.Context, txType string) ([]byte, error) {
+	if ctx.IsSet(payloadFlag.Name) {
+		return hexFlag(ctx, payloadFlag.Name, false)
+	}
+
+	var payload core.TransactionPayload
+	switch txType {
+	case core.TxOpTransfer:
+		if !ctx.IsSet(messageFlag.Name) {
+			return nil, nil
+		}
+		payload = &core.DefaultPayload{Message: ctx.String(messageFlag.Name)}
+	case core.TxOpBatchTransfer:
+		outputs, err := parseOutputs(ctx.StringSlice(outputsFlag.Name))
+		if err != nil {
+			return nil, err
+		}
+		payload = &core.BatchTransferPayload{Outputs: outputs}
+	case core.TxOpAddRecord:
+		hash, err := hexFlag(ctx, recordHashFlag.Name, true)
+		if err != nil {
+			return nil, err
+		}
+		payload = &core.AddRecordPayload{RecordHash: hash}
+	case core.TxOpAmendRecord:
+		prevHash, err := hexFlag(ctx, prevRecordHashFlag.Name, true)
+		if err != nil {
+			return nil, err
+		}
+		hash, err := hexFlag(ctx, recordHashFlag.Name, true)
+		if err != nil {
+			return nil, err
+		}
+		payload = &core.AmendRecordPayload{PrevRecordHash: prevHash, RecordHash: hash}
+	case core.TxOpAddRecordBatch:
+		root, err := hexFlag(ctx, merkleRootFlag.Name, true)
+		if err != nil {
+			return nil, err
+		}
+		payload = &core.AddRecordBatchPayload{
+			MerkleRoot:  root,
+			RecordCount: uint32(ctx.Uint(recordCountFlag.Name)),
+		}
+	case core.TxOpAddCertification:
+		hash, err := hexFlag(ctx, certHashFlag.Name, true)
+		if err != nil {
+			return nil, err
+		}
+		payload = &core.AddCertificationPayload{
+			IssueTime:       ctx.Int64(issueTimeFlag.Name),
+			ExpirationTime:  ctx.Int64(expirationTimeFlag.Name),
+			CertificateHash: hash,
+			CertType:        ctx.String(certTypeFlag.Name),
+		}
+	case core.TxOpRevokeCertification:
+		hash, err := hexFlag(ctx, certHashFlag.Name, true)
+		if err != nil {
+			return nil, err
+		}
+		payload = &core.RevokeCertificationPayload{CertificateHash: hash}
+	case core.TxOpRegisterIssuer:
+		payload = &core.RegisterIssuerPayload{
+			CertType: ctx.String(certTypeFlag.Name),
+			SchemaID: ctx.String(schemaIDFlag.Name),
+		}
+	case dpos.TxOpVote:
+		var candidates []common.Address
+		for _, c := range ctx.StringSlice(candidatesFlag.Name) {
+			addr, err := parseAddress(c)
+			if err != nil {
+				return nil, err
+			}
+			candidates = append(candidates, addr)
+		}
+		payload = &dpos.VotePayload{Candidates: candidates}
+	default:
+		// vest, withdraw_vesting, become_candidate and quit_candidacy have no payload.
+		return nil, nil
+	}
+	return payload.ToBytes()
+}
+
+// hexFlag decodes the hex string of the flag.
+func hexFlag(ctx *cli.Context, name string, required bool) ([]byte, error) {
+	s := ctx.String(name)
+	if s == "" {
+		if required {
+			return nil, ErrMissingPayloadField
+		}
+		return nil, nil
+	}
+	return decodeHex(s)
+}
+
+// decodeHex decodes the hex string with or without 0x prefix.
+func decodeHex(s string) ([]byte, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidHex
+	}
+	return b, nil
+}
+
+func parseOutputs(list []string) ([]*core.TransferOutput, error) {
+	if len(list) == 0 {
+		return nil, ErrMissingPayloadField
+	}
+	outputs := make([]*core.TransferOutput, 0, len(list))
+	for _, s := range list {
+		pair := strings.SplitN(s, ":", 2)
+		if len(pair) != 2 {
+			return nil, ErrInvalidOutput
+		}
+		to, err := parseAddress(pair[0])
+		if err != nil {
+			return nil, err
+		}
+		value, err := util.NewUint128FromString(pair[1])
+		if err != nil {
+			return nil, ErrInvalidOutput
+		}
+		outputs = append(outputs, &core.TransferOutput{To: to, Value: value})
+	}
+	return outputs, nil
+}
+
+// decodePayload returns a readable form of the payload of the transaction type.
+func decodePayload(txType string, b []byte) (interface{}, error) {
+	switch txType {
+	case core.TxOpTransfer:
+		if len(b) == 0 {
+			return nil, nil
+		}
+		payload := new(core.DefaultPayload)
+		if err := core.BytesToTransactionPayload(b, payload); err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"message": payload.Message}, nil
+	case core.TxOpBatchTransfer:
+		payload := new(core.BatchTransferPayload)
+		if err := core.BytesToTransactionPayload(b, payload); err != nil {
+			return nil, err
+		}
+		outputs := make([]map[string]string, 0, len(payload.Outputs))
+		for _, output := range payload.Outputs {
+			outputs = append(outputs, map[string]string{
+				"to":    output.To.Hex(),
+				"value": output.Value.String(),
+			})
+		}
+		return map[string]interface{}{"outputs": outputs}, nil
+	case core.TxOpAddRecord:
+		payload := new(core.AddRecordPayload)
+		if err := core.BytesToTransactionPayload(b, payload); err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"record_hash": byteutils.Bytes2Hex(payload.RecordHash)}, nil
+	case core.TxOpAmendRecord:
+		payload := new(core.AmendRecordPayload)
+		if err := core.BytesToTransactionPayload(b, payload); err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{
+			"prev_record_hash": byteutils.Bytes2Hex(payload.PrevRecordHash),
+			"record_hash":      byteutils.Bytes2Hex(payload.RecordHash),
+		}, nil
+	case core.TxOpAddRecordBatch:
+		payload := new(core.AddRecordBatchPayload)
+		if err := core.BytesToTransactionPayload(b, payload); err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{
+			"merkle_root":  byteutils.Bytes2Hex(payload.MerkleRoot),
+			"record_count": payload.RecordCount,
+		}, nil
+	case core.TxOpAddCertification:
+		payload := new(core.AddCertificationPayload)
+		if err := core.BytesToTransactionPayload(b, payload); err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{
+			"issue_time":      payload.IssueTime,
+			"expiration_time": payload.ExpirationTime,
+			"cert_hash":       byteutils.Bytes2Hex(payload.CertificateHash),
+			"cert_type":       payload.CertType,
+		}, nil
+	case core.TxOpRevokeCertification:
+		payload := new(core.RevokeCertificationPayload)
+		if err := core.BytesToTransactionPayload(b, payload); err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"cert_hash": byteutils.Bytes2Hex(payload.CertificateHash)}, nil
+	case core.TxOpRegisterIssuer:
+		payload := new(core.RegisterIssuerPayload)
+		if err := core.BytesToTransactionPayload(b, payload); err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{
+			"cert_type": payload.CertType,
+			"schema_id": payload.SchemaID,
+		}, nil
+	case dpos.TxOpVote:
+		payload := new(dpos.VotePayload)
+		if err := core.BytesToTransactionPayload(b, payload); err != nil {
+			return nil, err
+		}
+		candidates := make([]string, 0, len(payload.Candidates))
+		for _, c := range payload.Candidates {
+			candidates = append(candidates, c.Hex())
+		}
+		return map[string]interface{}{"candidates": candidates}, nil
+	}
+	return nil, nil
+}
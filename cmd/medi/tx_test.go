@@ -0,0 +1,216 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/consensus/dpos"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/crypto/signature/algorithm"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+func newTestAddress(t *testing.T) string {
+	key, err := crypto.GenerateKey(algorithm.SECP256K1)
+	require.NoError(t, err)
+	addr, err := common.PublicKeyToAddress(key.PublicKey())
+	require.NoError(t, err)
+	return addr.Hex()
+}
+
+// newTestContext returns the context of a command with the flags and the arguments.
+func newTestContext(flags []cli.Flag, args ...string) (*cli.Context, error) {
+	set := flag.NewFlagSet("test", flag.ContinueOnError)
+	set.SetOutput(ioutil.Discard)
+	for _, f := range flags {
+		f.Apply(set)
+	}
+	if err := set.Parse(args); err != nil {
+		return nil, err
+	}
+	return cli.NewContext(nil, set, nil), nil
+}
+
+// newTxBuildContext returns the context of tx build with the arguments.
+func newTxBuildContext(args ...string) (*cli.Context, error) {
+	for _, cmd := range txCommand.Subcommands {
+		if cmd.Name == "build" {
+			return newTestContext(cmd.Flags, args...)
+		}
+	}
+	return nil, nil
+}
+
+func TestTxPayloadRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "medi_tx")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	from, to, other := newTestAddress(t), newTestAddress(t), newTestAddress(t)
+	hash := byteutils.Bytes2Hex(make([]byte, 32))
+	prevHash := byteutils.Bytes2Hex([]byte("01234567890123456789012345678901"))
+	message, err := (&core.DefaultPayload{Message: "hello"}).ToBytes()
+	require.NoError(t, err)
+
+	tests := []struct {
+		txType string
+		args   []string
+		want   interface{}
+	}{
+		{core.TxOpTransfer, []string{"--to", to, "--value", "10"}, nil},
+		{core.TxOpTransfer, []string{"--to", to, "--value", "10", "--message", "hello"},
+			map[string]interface{}{"message": "hello"}},
+		{core.TxOpTransfer, []string{"--to", to, "--value", "10", "--payload", "0x" + byteutils.Bytes2Hex(message)},
+			map[string]interface{}{"message": "hello"}},
+		{core.TxOpBatchTransfer, []string{"--value", "30", "--output", to + ":10", "--output", other + ":20"},
+			map[string]interface{}{"outputs": []map[string]string{
+				{"to": to, "value": "10"},
+				{"to": other, "value": "20"},
+			}}},
+		{core.TxOpAddRecord, []string{"--record-hash", "0x" + hash},
+			map[string]interface{}{"record_hash": hash}},
+		{core.TxOpAmendRecord, []string{"--prev-record-hash", prevHash, "--record-hash", hash},
+			map[string]interface{}{"prev_record_hash": prevHash, "record_hash": hash}},
+		{core.TxOpAddRecordBatch, []string{"--merkle-root", hash, "--record-count", "3"},
+			map[string]interface{}{"merkle_root": hash, "record_count": uint32(3)}},
+		{core.TxOpAddCertification, []string{"--to", to, "--cert-hash", hash, "--cert-type", "degree",
+			"--issue-time", "100", "--expiration-time", "200"},
+			map[string]interface{}{"issue_time": int64(100), "expiration_time": int64(200), "cert_hash": hash,
+				"cert_type": "degree"}},
+		{core.TxOpRevokeCertification, []string{"--cert-hash", hash},
+			map[string]interface{}{"cert_hash": hash}},
+		{core.TxOpRegisterIssuer, []string{"--cert-type", "degree", "--schema-id", "degree-v1"},
+			map[string]interface{}{"cert_type": "degree", "schema_id": "degree-v1"}},
+		{dpos.TxOpVote, []string{"--candidate", to, "--candidate", other},
+			map[string]interface{}{"candidates": []string{to, other}}},
+		{core.TxOpVest, []string{"--value", "10"}, nil},
+		{core.TxOpWithdrawVesting, []string{"--value", "10"}, nil},
+		{dpos.TxOpBecomeCandidate, []string{"--value", "10"}, nil},
+		{dpos.TxOpQuitCandidacy, nil, nil},
+	}
+	for _, format := range []string{TxFormatJSON, TxFormatProto} {
+		for i, test := range tests {
+			out := filepath.Join(dir, test.txType+"."+format)
+			args := append([]string{"--type", test.txType, "--from", from, "--chain-id", "1", "--nonce", "2",
+				"--format", format, "--out", out}, test.args...)
+			ctx, err := newTxBuildContext(args...)
+			require.NoError(t, err, "%s %d", format, i)
+
+			tx, err := buildTx(ctx)
+			require.NoError(t, err, "%s %d", format, i)
+			require.NoError(t, writeTx(ctx, tx), "%s %d", format, i)
+
+			read, err := readTx(out)
+			require.NoError(t, err, "%s %d", format, i)
+			assert.Equal(t, txToRequest(tx), txToRequest(read), "%s %d", format, i)
+
+			decoded, err := decodePayload(read.TxType(), read.Payload())
+			require.NoError(t, err, "%s %d", format, i)
+			assert.Equal(t, test.want, decoded, "%s %d", format, i)
+		}
+	}
+}
+
+func TestTxBuildRejectsMalformedFlags(t *testing.T) {
+	from, to := newTestAddress(t), newTestAddress(t)
+	hash := byteutils.Bytes2Hex(make([]byte, 32))
+
+	tests := []struct {
+		args []string
+		err  error
+	}{
+		{[]string{"--type", "unknown"}, ErrUnknownTxType},
+		{[]string{"--type", core.TxOpTransfer, "--from", "invalid"}, ErrInvalidAddress},
+		{[]string{"--type", core.TxOpTransfer, "--to", "invalid"}, ErrInvalidAddress},
+		{[]string{"--type", core.TxOpTransfer, "--to", to, "--value", "ten"}, ErrInvalidTxValue},
+		{[]string{"--type", core.TxOpTransfer, "--to", to, "--value", "-10"}, ErrInvalidTxValue},
+		{[]string{"--type", core.TxOpTransfer, "--to", to, "--value", "0"}, core.ErrVoidTransaction},
+		{[]string{"--type", core.TxOpTransfer, "--to", to, "--value", "10", "--payload", "zz"}, ErrInvalidHex},
+		{[]string{"--type", core.TxOpBatchTransfer}, ErrMissingPayloadField},
+		{[]string{"--type", core.TxOpBatchTransfer, "--output", to}, ErrInvalidOutput},
+		{[]string{"--type", core.TxOpBatchTransfer, "--output", to + ":ten"}, ErrInvalidOutput},
+		{[]string{"--type", core.TxOpBatchTransfer, "--output", "invalid:10"}, ErrInvalidAddress},
+		{[]string{"--type", core.TxOpBatchTransfer, "--output", to + ":0"}, core.ErrVoidTransaction},
+		{[]string{"--type", core.TxOpBatchTransfer, "--value", "20", "--output", to + ":10"}, core.ErrInvalidAmount},
+		{[]string{"--type", core.TxOpAddRecord}, ErrMissingPayloadField},
+		{[]string{"--type", core.TxOpAddRecord, "--record-hash", "0xzz"}, ErrInvalidHex},
+		{[]string{"--type", core.TxOpAmendRecord, "--record-hash", hash}, ErrMissingPayloadField},
+		{[]string{"--type", core.TxOpAmendRecord, "--prev-record-hash", hash, "--record-hash", hash},
+			core.ErrRecordAlreadyAdded},
+		{[]string{"--type", core.TxOpAddRecordBatch, "--merkle-root", "0102", "--record-count", "1"},
+			core.ErrInvalidMerkleRoot},
+		{[]string{"--type", core.TxOpAddRecordBatch, "--merkle-root", hash}, core.ErrNoRecordsInBatch},
+		{[]string{"--type", core.TxOpAddCertification, "--to", to}, ErrMissingPayloadField},
+		{[]string{"--type", core.TxOpRegisterIssuer, "--cert-type", "degree"}, core.ErrInvalidSchemaID},
+		{[]string{"--type", dpos.TxOpVote, "--candidate", "invalid"}, ErrInvalidAddress},
+		{[]string{"--type", core.TxOpVest}, core.ErrCannotUseZeroValue},
+	}
+	for i, test := range tests {
+		args := append([]string{"--from", from, "--chain-id", "1"}, test.args...)
+		ctx, err := newTxBuildContext(args...)
+		require.NoError(t, err, "%d", i)
+		_, err = buildTx(ctx)
+		assert.Equal(t, test.err, err, "%d", i)
+	}
+
+	ctx, err := newTxBuildContext("--type", core.TxOpVest, "--from", from, "--value", "10")
+	require.NoError(t, err)
+	_, err = buildTx(ctx)
+	assert.Equal(t, ErrChainIDMissing, err)
+
+	_, err = newTxBuildContext("--type", core.TxOpAddRecordBatch, "--record-count", "-1")
+	assert.Error(t, err)
+
+	ctx, err = newTxBuildContext("--type", core.TxOpVest, "--from", from, "--chain-id", "1", "--value", "10",
+		"--format", "xml")
+	require.NoError(t, err)
+	tx, err := buildTx(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, ErrInvalidTxFormat, writeTx(ctx, tx))
+}
+
+func TestReadTxRejectsMalformedFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "medi_tx")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	from := newTestAddress(t)
+	tests := []struct {
+		content string
+		err     error
+	}{
+		{"zz", ErrInvalidHex},
+		{`{"from": "` + from + `", "value": "ten"}`, ErrInvalidTxValue},
+		{`{"from": "invalid", "value": "0"}`, ErrInvalidAddress},
+		{`{"from": "` + from + `", "value": "0", "payload": "zz"}`, ErrInvalidHex},
+	}
+	for i, test := range tests {
+		path := filepath.Join(dir, "tx")
+		require.NoError(t, ioutil.WriteFile(path, []byte(test.content), 0600))
+		_, err := readTx(path)
+		assert.Equal(t, test.err, err, "%d", i)
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/medibloc/go-medibloc/consensus/dpos"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/medlet"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/urfave/cli"
+)
+
+var parallelFlag = cli.IntFlag{
+	Name:  "parallel",
+	Usage: "number of height ranges replayed in parallel. Each range starts from the stored state of its first block",
+	Value: 1,
+}
+
+var verifyCommand = cli.Command{
+	Name:  "verify",
+	Usage: "re-execute the canonical chain and verify every block state. The node must be stopped",
+	Description: `Each block is executed on its parent block, and its account, transaction and dpos roots,
+   reward and supply are compared with the block header. The first height which fails is reported.
+   States made by the execution are kept in memory and the storage is not changed.`,
+	Flags:  []cli.Flag{configFlag, datadirFlag, fromHeightFlag, toHeightFlag, parallelFlag},
+	Action: verify,
+}
+
+// replayRange is a range of heights replayed from the stored state of the block of from.
+type replayRange struct {
+	from uint64
+	to   uint64
+
+	failed uint64
+	err    error
+}
+
+func verify(ctx *cli.Context) error {
+	_, genesis, err := nodeConfig(ctx)
+	if err != nil {
+		return err
+	}
+	dir, err := datadir(ctx)
+	if err != nil {
+		return err
+	}
+	stor, err := storage.NewRocksStorageReadOnly(dir)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+	cs := core.NewChainStorage(stor, dpos.New(int(genesis.Meta.DynastySize)))
+
+	from := ctx.Uint64(fromHeightFlag.Name)
+	to := ctx.Uint64(toHeightFlag.Name)
+	if to == 0 {
+		if to, err = tailHeight(cs); err != nil {
+			return err
+		}
+	}
+	if from < core.GenesisHeight || from > to {
+		return fmt.Errorf("invalid height range from %d to %d", from, to)
+	}
+
+	if from == core.GenesisHeight {
+		hash, err := cs.HashByHeight(core.GenesisHeight)
+		if err != nil {
+			return err
+		}
+		block, err := cs.Block(hash)
+		if err != nil {
+			return err
+		}
+		if !core.CheckGenesisConf(block, genesis) {
+			fmt.Printf("Height %d: %v\n", core.GenesisHeight, core.ErrGenesisNotMatch)
+			return ErrVerifyFailed
+		}
+	}
+
+	ranges := splitRange(from, to, ctx.Int(parallelFlag.Name))
+	wg := new(sync.WaitGroup)
+	for _, r := range ranges {
+		wg.Add(1)
+		go func(r *replayRange) {
+			defer wg.Done()
+			r.failed, r.err = cs.Replay(r.from, r.to, medlet.DefaultTxMap)
+		}(r)
+	}
+	wg.Wait()
+
+	var failed []*replayRange
+	for _, r := range ranges {
+		if r.err != nil {
+			failed = append(failed, r)
+		}
+	}
+	if len(failed) == 0 {
+		fmt.Printf("Verified %d blocks from %d to %d.\n", to-from+1, from, to)
+		return nil
+	}
+
+	sort.Slice(failed, func(i, j int) bool { return failed[i].failed < failed[j].failed })
+	for _, r := range failed {
+		hash, _ := cs.HashByHeight(r.failed)
+		fmt.Printf("Height %d (%s): %v\n", r.failed, byteutils.Bytes2Hex(hash), r.err)
+	}
+	fmt.Printf("First mismatching height is %d.\n", failed[0].failed)
+	return ErrVerifyFailed
+}
+
+// splitRange splits the heights above from up to to into n ranges. Adjacent ranges share their boundary block,
+// whose stored state is the starting state of the upper range.
+func splitRange(from, to uint64, n int) []*replayRange {
+	if n < 1 {
+		n = 1
+	}
+	if size := to - from; uint64(n) > size && size > 0 {
+		n = int(size)
+	}
+	step := (to - from) / uint64(n)
+
+	ranges := make([]*replayRange, 0, n)
+	for i := 0; i < n; i++ {
+		r := &replayRange{
+			from: from + uint64(i)*step,
+			to:   from + uint64(i+1)*step,
+		}
+		if i == n-1 {
+			r.to = to
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package merkle
+
+import (
+	"errors"
+
+	"github.com/medibloc/go-medibloc/crypto/hash"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+)
+
+// Prefixes for domain separation between leaf and inner node hashes.
+var (
+	leafPrefix = []byte{0x00}
+	nodePrefix = []byte{0x01}
+)
+
+// Errors
+var (
+	ErrNoLeaves     = errors.New("merkle tree has no leaves")
+	ErrOutOfRange   = errors.New("leaf index out of range")
+	ErrInvalidProof = errors.New("invalid merkle proof")
+)
+
+func leafHash(leaf []byte) []byte {
+	return hash.Sha3256(leafPrefix, leaf)
+}
+
+func nodeHash(left, right []byte) []byte {
+	return hash.Sha3256(nodePrefix, left, right)
+}
+
+func nextLevel(level [][]byte) [][]byte {
+	next := make([][]byte, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 == len(level) {
+			// odd node is promoted to the next level as it is
+			next = append(next, level[i])
+			continue
+		}
+		next = append(next, nodeHash(level[i], level[i+1]))
+	}
+	return next
+}
+
+func leafLevel(leaves [][]byte) [][]byte {
+	level := make([][]byte, 0, len(leaves))
+	for _, leaf := range leaves {
+		level = append(level, leafHash(leaf))
+	}
+	return level
+}
+
+// Root returns merkle root of leaves.
+func Root(leaves [][]byte) ([]byte, error) {
+	if len(leaves) == 0 {
+		return nil, ErrNoLeaves
+	}
+	level := leafLevel(leaves)
+	for len(level) > 1 {
+		level = nextLevel(level)
+	}
+	return level[0], nil
+}
+
+// Proof returns sibling hashes from the leaf at index up to the root.
+func Proof(leaves [][]byte, index int) ([][]byte, error) {
+	if len(leaves) == 0 {
+		return nil, ErrNoLeaves
+	}
+	if index < 0 || index >= len(leaves) {
+		return nil, ErrOutOfRange
+	}
+	path := make([][]byte, 0)
+	level := leafLevel(leaves)
+	for len(level) > 1 {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			path = append(path, level[sibling])
+		}
+		level = nextLevel(level)
+		index /= 2
+	}
+	return path, nil
+}
+
+// Verify checks that leaf is at index of a tree with count leaves and given root.
+func Verify(leaf []byte, index uint64, count uint64, path [][]byte, root []byte) error {
+	if count == 0 {
+		return ErrNoLeaves
+	}
+	if index >= count {
+		return ErrOutOfRange
+	}
+
+	h := leafHash(leaf)
+	for width := count; width > 1; width = (width + 1) / 2 {
+		if index^1 < width {
+			if len(path) == 0 {
+				return ErrInvalidProof
+			}
+			if index%2 == 0 {
+				h = nodeHash(h, path[0])
+			} else {
+				h = nodeHash(path[0], h)
+			}
+			path = path[1:]
+		}
+		index /= 2
+	}
+	if len(path) != 0 || !byteutils.Equal(h, root) {
+		return ErrInvalidProof
+	}
+	return nil
+}
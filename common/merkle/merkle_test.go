@@ -0,0 +1,69 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package merkle_test
+
+import (
+	"testing"
+
+	"github.com/medibloc/go-medibloc/common/merkle"
+	"github.com/medibloc/go-medibloc/crypto/hash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func leaves(n int) [][]byte {
+	l := make([][]byte, 0, n)
+	for i := 0; i < n; i++ {
+		l = append(l, hash.Sha3256([]byte{byte(i)}))
+	}
+	return l
+}
+
+func TestProofAndVerify(t *testing.T) {
+	for n := 1; n <= 17; n++ {
+		l := leaves(n)
+		root, err := merkle.Root(l)
+		require.NoError(t, err)
+
+		for i := 0; i < n; i++ {
+			path, err := merkle.Proof(l, i)
+			require.NoError(t, err)
+			assert.NoError(t, merkle.Verify(l[i], uint64(i), uint64(n), path, root))
+
+			if n > 1 {
+				assert.Equal(t, merkle.ErrInvalidProof, merkle.Verify(l[(i+1)%n], uint64(i), uint64(n), path, root))
+				assert.Equal(t, merkle.ErrInvalidProof, merkle.Verify(l[i], uint64((i+1)%n), uint64(n), path, root))
+			}
+		}
+	}
+}
+
+func TestInvalidArguments(t *testing.T) {
+	_, err := merkle.Root(nil)
+	assert.Equal(t, merkle.ErrNoLeaves, err)
+
+	l := leaves(3)
+	_, err = merkle.Proof(l, 3)
+	assert.Equal(t, merkle.ErrOutOfRange, err)
+
+	root, err := merkle.Root(l)
+	require.NoError(t, err)
+	path, err := merkle.Proof(l, 0)
+	require.NoError(t, err)
+	assert.Equal(t, merkle.ErrOutOfRange, merkle.Verify(l[0], 3, 3, path, root))
+	assert.Equal(t, merkle.ErrInvalidProof, merkle.Verify(l[0], 0, 3, path[:1], root))
+	assert.Equal(t, merkle.ErrInvalidProof, merkle.Verify(l[0], 0, 3, append(path, l[1]), root))
+}
@@ -16,6 +16,7 @@
 package dpos
 
 import (
+	"sync"
 	"time"
 
 	"bytes"
@@ -39,7 +40,9 @@ import (
 type Dpos struct {
 	dynastySize int
 
+	mu        sync.Mutex
 	startMine bool
+	mining    bool
 	coinbase  common.Address
 	miner     common.Address
 	minerKey  signature.PrivateKey
@@ -89,7 +92,7 @@ func (d *Dpos) LoadConsensusState(dposRootBytes []byte, stor storage.Storage) (c
 func (d *Dpos) Setup(cfg *medletpb.Config, genesis *corepb.Genesis, bm *core.BlockManager, tm *core.TransactionManager) error {
 	// Setup miner
 	d.startMine = cfg.Chain.StartMine
-	if cfg.Chain.StartMine {
+	if cfg.Chain.StartMine || cfg.Chain.Privkey != "" {
 		d.coinbase = common.HexToAddress(cfg.Chain.Coinbase)
 		d.miner = common.HexToAddress(cfg.Chain.Miner)
 		minerKey, err := secp256k1.NewPrivateKeyFromHex(cfg.Chain.Privkey)
@@ -122,15 +125,105 @@ func (d *Dpos) Start() {
 	if !d.startMine {
 		return
 	}
-	go d.loop()
+	d.StartMining()
 }
 
 // Stop stops miner.
 func (d *Dpos) Stop() {
-	if !d.startMine {
-		return
+	d.StopMining()
+}
+
+// StartMining starts block production.
+func (d *Dpos) StartMining() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.minerKey == nil {
+		return ErrMinerNotConfigured
+	}
+	if d.mining {
+		return ErrAlreadyMining
+	}
+	d.mining = true
+	go d.loop()
+	return nil
+}
+
+// StopMining stops block production.
+func (d *Dpos) StopMining() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.mining {
+		return ErrNotMining
 	}
 	d.quitCh <- 0
+	d.mining = false
+	return nil
+}
+
+// IsMining returns true if block production is running.
+func (d *Dpos) IsMining() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.mining
+}
+
+// BlockInterval returns block interval.
+func (d *Dpos) BlockInterval() time.Duration {
+	return BlockInterval
+}
+
+// MissedSlots checks block production of the miner. It returns whether the miner is a member of the dynasty of
+// the tail block, and the number of slots without a block among the last n slots of the miner before now.
+func (d *Dpos) MissedSlots(now time.Time, n int) (member bool, missed int, err error) {
+	if d.minerKey == nil || n <= 0 {
+		return false, 0, nil
+	}
+	tail := d.bm.TailBlock()
+	dynasty, err := tail.State().DposState().Dynasty()
+	if err != nil {
+		return false, 0, err
+	}
+	index := -1
+	for i, addr := range dynasty {
+		if addr.Equals(d.miner) {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return false, 0, nil
+	}
+
+	// A block of the last slot may not be arrived yet.
+	interval := int64(BlockInterval / time.Second)
+	slot := lastMintSlot(now.Add(-BlockInterval)).Unix()
+	for slot > 0 && d.calcProposerIndex(slot) != index {
+		slot -= interval
+	}
+	slots := make(map[int64]bool)
+	first := slot
+	for i := 0; i < n && slot > 0; i++ {
+		slots[slot] = true
+		first = slot
+		slot -= interval * int64(d.dynastySize)
+	}
+
+	oldest := tail.Timestamp()
+	b := tail
+	for b != nil && b.Timestamp() >= first {
+		delete(slots, b.Timestamp())
+		oldest = b.Timestamp()
+		b = d.bm.BlockByHash(b.ParentHash())
+	}
+	if b == nil {
+		// Slots before the genesis block are not missed.
+		for ts := range slots {
+			if ts < oldest {
+				delete(slots, ts)
+			}
+		}
+	}
+	return true, len(slots), nil
 }
 
 func (d *Dpos) consensusSize() int {
@@ -260,8 +353,10 @@ func (d *Dpos) mintBlock(now time.Time) error {
 		return ErrInvalidBlockProposer
 	}
 
+	start := time.Now()
 	block, err := d.makeBlock(tail, deadline, nextMintSlot(now))
 	if err != nil {
+		metricsMintFailures.Mark(1)
 		logging.Console().WithFields(logrus.Fields{
 			"tail":     tail,
 			"deadline": deadline,
@@ -272,6 +367,7 @@ func (d *Dpos) mintBlock(now time.Time) error {
 
 	err = block.Seal()
 	if err != nil {
+		metricsMintFailures.Mark(1)
 		logging.Console().WithFields(logrus.Fields{
 			"block": block,
 			"err":   err,
@@ -281,6 +377,7 @@ func (d *Dpos) mintBlock(now time.Time) error {
 
 	sig, err := crypto.NewSignature(algorithm.SECP256K1)
 	if err != nil {
+		metricsMintFailures.Mark(1)
 		logging.Console().WithFields(logrus.Fields{
 			"err": err,
 		}).Error("Failed to create new crypto signature.")
@@ -290,11 +387,13 @@ func (d *Dpos) mintBlock(now time.Time) error {
 
 	err = block.SignThis(sig)
 	if err != nil {
+		metricsMintFailures.Mark(1)
 		logging.Console().WithFields(logrus.Fields{
 			"err": err,
 		}).Error("Failed to sign block.")
 		return err
 	}
+	metricsBlockProduceTime.UpdateSince(start)
 
 	// TODO @cl9200 Return transactions if an error condition.
 
@@ -307,12 +406,14 @@ func (d *Dpos) mintBlock(now time.Time) error {
 
 	err = d.bm.PushCreatedBlock(block)
 	if err != nil {
+		metricsMintFailures.Mark(1)
 		logging.Console().WithFields(logrus.Fields{
 			"block": block,
 			"err":   err,
 		}).Error("Failed to push block to blockchain.")
 		return err
 	}
+	metricsMintedBlocks.Mark(1)
 
 	d.bm.BroadCast(block.GetBlockData())
 
@@ -2,6 +2,7 @@ package dpos_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/medibloc/go-medibloc/common"
 	"github.com/medibloc/go-medibloc/consensus/dpos"
@@ -90,3 +91,32 @@ func TestChangeDynasty(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, false, inDynasty)
 }
+
+func TestMissedSlots(t *testing.T) {
+	testNetwork := testutil.NewNetwork(t, testutil.DynastySize)
+	defer testNetwork.Cleanup()
+
+	cfg := testutil.NewConfig(t).SetRandomGenesis(testutil.DynastySize).SetMinerFromDynasties(nil)
+	cfg.Config.Chain.StartMine = false
+	seed := testNetwork.NewSeedNodeWithConfig(cfg)
+	seed.Start()
+
+	d := seed.Med.Consensus().(*dpos.Dpos)
+
+	bb := blockutil.New(t, testutil.DynastySize).Block(seed.Tail()).AddKeyPairs(seed.Config.TokenDist)
+	for i := 0; i < 2*testutil.DynastySize; i++ {
+		bb = bb.Child().SignMiner()
+		require.NoError(t, seed.Med.BlockManager().PushBlockData(bb.Build().BlockData))
+	}
+	now := time.Unix(seed.Tail().Timestamp(), 0).Add(dpos.BlockInterval)
+
+	member, missed, err := d.MissedSlots(now, 2)
+	require.NoError(t, err)
+	assert.True(t, member)
+	assert.Equal(t, 0, missed)
+
+	member, missed, err = d.MissedSlots(now.Add(2*d.DynastyInterval()), 2)
+	require.NoError(t, err)
+	assert.True(t, member)
+	assert.Equal(t, 2, missed)
+}
@@ -40,14 +40,17 @@ const (
 // Error types of dpos package.
 var (
 	ErrAlreadyCandidate       = errors.New("account is already a candidate")
+	ErrAlreadyMining          = errors.New("block production is already running")
 	ErrBlockMintedInNextSlot  = errors.New("cannot mint block now, there is a block minted in current slot")
 	ErrInvalidBlockForgeTime  = errors.New("invalid time to forge block")
 	ErrInvalidBlockInterval   = errors.New("invalid block interval")
 	ErrInvalidBlockProposer   = errors.New("invalid block proposer")
 	ErrInvalidBlockReward     = errors.New("invalid block reward")
 	ErrInvalidDynastySize     = errors.New("invalid dynasty size")
+	ErrMinerNotConfigured     = errors.New("miner is not configured")
 	ErrNotCandidate           = errors.New("account is not a candidate")
 	ErrNoVote                 = errors.New("vote to no one")
+	ErrNotMining              = errors.New("block production is not running")
 	ErrOverMaxVote            = errors.New("too many vote")
 	ErrDuplicateVote          = errors.New("cannot vote multiple vote for same account")
 	ErrWaitingBlockInLastSlot = errors.New("cannot mint block now, waiting for last block")
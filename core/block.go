@@ -1068,8 +1068,10 @@ func (b *Block) GetBlockData() *BlockData {
 func (b *Block) EmitTxExecutionEvent(emitter *EventEmitter) {
 	for _, tx := range b.Transactions() {
 		event := &Event{
-			Topic: TopicTransactionExecutionResult,
-			Data:  byteutils.Bytes2Hex(tx.Hash()),
+			Topic:       TopicTransactionExecutionResult,
+			Data:        byteutils.Bytes2Hex(tx.Hash()),
+			Block:       b,
+			Transaction: tx,
 		}
 		emitter.Trigger(event)
 	}
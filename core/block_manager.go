@@ -183,6 +183,22 @@ func (bm *BlockManager) ForceLIB(b *Block) error {
 	return bm.bc.SetLIB(b)
 }
 
+//InspectLIB finds LIB by consensus on current chain and updates it
+func (bm *BlockManager) InspectLIB() (prevLIB *Block, newLIB *Block, err error) {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+
+	prevLIB = bm.bc.LIB()
+	newLIB = bm.consensus.FindLIB(bm.bc)
+	if newLIB == nil {
+		return nil, nil, ErrLIBNotFound
+	}
+	if err := bm.bc.SetLIB(newLIB); err != nil {
+		return nil, nil, err
+	}
+	return prevLIB, newLIB, nil
+}
+
 // Relay relays BlockData to network.
 func (bm *BlockManager) Relay(bd *BlockData) {
 	bm.ns.Relay(MessageTypeNewBlock, bd, net.MessagePriorityHigh)
@@ -459,6 +475,7 @@ func (bm *BlockManager) rearrangeTransactions(revertBlock []*Block, newBlocks []
 			event := &Event{
 				Topic: TopicRevertBlock,
 				Data:  byteutils.Bytes2Hex(block.Hash()),
+				Block: block,
 			}
 			bm.bc.eventEmitter.Trigger(event)
 		}
@@ -240,6 +240,22 @@ func (bs *BlockState) PutAccount(acc *Account) error {
 	return bs.accState.putAccount(acc)
 }
 
+//TypedCertificationRequired returns whether the genesis requires cert type of certifications.
+func (bs *BlockState) TypedCertificationRequired() (bool, error) {
+	acc, err := bs.GetAccount(GenesisCoinbase)
+	if err != nil {
+		return false, err
+	}
+	_, err = acc.GetData(ChainRulePrefix, requireTypedCertificationRule)
+	if err == ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 //GetTx returns txs in state
 func (bs *BlockState) GetTx(txHash []byte) (*Transaction, error) {
 	return bs.txState.Get(txHash)
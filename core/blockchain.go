@@ -143,6 +143,7 @@ func (bc *BlockChain) Setup(genesis *corepb.Genesis, consensus Consensus, stor s
 		}).Error("Failed to load LIB from storage.")
 		return err
 	}
+	updateChainMetrics(bc.mainTailBlock, bc.lib)
 	return nil
 }
 
@@ -236,11 +237,13 @@ func (bc *BlockChain) SetLIB(newLIB *Block) error {
 		return err
 	}
 	bc.lib = newLIB
+	updateChainMetrics(bc.mainTailBlock, bc.lib)
 
 	if bc.eventEmitter != nil {
 		event := &Event{
 			Topic: TopicLibBlock,
 			Data:  byteutils.Bytes2Hex(newLIB.Hash()),
+			Block: newLIB,
 		}
 		bc.eventEmitter.Trigger(event)
 	}
@@ -296,11 +299,22 @@ func (bc *BlockChain) SetTailBlock(newTail *Block) ([]*Block, []*Block, error) {
 		return nil, nil, err
 	}
 	bc.mainTailBlock = newTail
+	updateChainMetrics(bc.mainTailBlock, bc.lib)
+
+	metricsBlocks.Mark(int64(len(newBlocks)))
+	for _, block := range newBlocks {
+		metricsTxs.Mark(int64(len(block.Transactions())))
+	}
+	if len(blocks) > 0 {
+		metricsReorgs.Mark(1)
+		metricsReorgDepth.Update(int64(len(blocks)))
+	}
 
 	if bc.eventEmitter != nil {
 		event := &Event{
 			Topic: TopicNewTailBlock,
 			Data:  byteutils.Bytes2Hex(newTail.Hash()),
+			Block: newTail,
 		}
 		bc.eventEmitter.Trigger(event)
 	}
@@ -356,6 +370,37 @@ func (bc *BlockChain) IsForkedBeforeLIB(block *Block) bool {
 	return false
 }
 
+// Rollback moves the main tail back to the canonical block of the height by ChainStorage.Rollback, and returns the
+// reverted blocks from the old tail. It is used on the storage of a stopped node.
+func (bc *BlockChain) Rollback(height uint64, force bool) ([]*BlockData, error) {
+	blocks, err := NewChainStorage(bc.storage, bc.consensus).Rollback(height, force)
+	if err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err":    err,
+			"height": height,
+		}).Error("Failed to roll back the chain.")
+		return nil, err
+	}
+
+	newTail, err := bc.loadTailFromStorage()
+	if err != nil {
+		return nil, err
+	}
+	newLIB, err := bc.loadLIBFromStorage()
+	if err != nil {
+		return nil, err
+	}
+	bc.lib = newLIB
+	bc.mainTailBlock = newTail
+	bc.addToTailBlocks(newTail)
+	for _, block := range blocks {
+		bc.cachedBlocks.Remove(byteutils.Bytes2Hex(block.Hash()))
+		bc.tailBlocks.Remove(byteutils.Bytes2Hex(block.Hash()))
+	}
+	updateChainMetrics(bc.mainTailBlock, bc.lib)
+	return blocks, nil
+}
+
 func (bc *BlockChain) parentBlock(block *Block) (*Block, error) {
 	parentHash := block.ParentHash()
 	block = bc.BlockByHash(parentHash)
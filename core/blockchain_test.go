@@ -16,13 +16,18 @@
 package core_test
 
 import (
+	"errors"
 	"testing"
 
 	"time"
 
+	"github.com/medibloc/go-medibloc/consensus/dpos"
 	"github.com/medibloc/go-medibloc/core"
 	"github.com/medibloc/go-medibloc/medlet"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/byteutils"
 	"github.com/medibloc/go-medibloc/util/testutil"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -59,3 +64,84 @@ func TestRestartNode(t *testing.T) {
 	}
 
 }
+
+// failingWriteStorage fails batch writes while fail is set.
+type failingWriteStorage struct {
+	storage.Storage
+	fail bool
+}
+
+var errWriteFailed = errors.New("write failed")
+
+func (s *failingWriteStorage) Write(batch *storage.WriteBatch) error {
+	if s.fail {
+		return errWriteFailed
+	}
+	return s.Storage.Write(batch)
+}
+
+func TestRollback(t *testing.T) {
+	testNet := testutil.NewNetwork(t, testutil.DynastySize)
+	defer testNet.Cleanup()
+	testNet.SetLogTestHook()
+
+	seed := testNet.NewSeedNode()
+	testNet.SetMinerFromDynasties(seed)
+	seed.Start()
+	for seed.Tail().Height() < 4 {
+		time.Sleep(100 * time.Millisecond)
+	}
+	seed.Stop()
+
+	rocks, err := storage.NewRocksStorage(seed.Config.Config.Global.Datadir)
+	require.NoError(t, err)
+	defer rocks.Close()
+	stor := &failingWriteStorage{Storage: rocks}
+	bc, err := core.NewBlockChain(seed.Config.Config)
+	require.NoError(t, err)
+	require.NoError(t, bc.Setup(seed.Config.Genesis, dpos.New(testutil.DynastySize), stor))
+
+	tail := bc.MainTailBlock()
+	_, err = bc.Rollback(tail.Height(), false)
+	assert.Equal(t, core.ErrInvalidRollbackHeight, err)
+
+	third, err := bc.BlockByHeight(3)
+	require.NoError(t, err)
+	require.NoError(t, bc.SetLIB(third))
+	_, err = bc.Rollback(2, false)
+	assert.Equal(t, core.ErrCannotRevertLIB, err)
+
+	// nothing is written if the batch fails.
+	stor.fail = true
+	_, err = bc.Rollback(2, true)
+	assert.Equal(t, errWriteFailed, err)
+	assert.Equal(t, tail.Hash(), bc.MainTailBlock().Hash())
+	assert.Equal(t, third.Hash(), bc.LIB().Hash())
+	journal, err := core.ReadTxJournal(stor)
+	require.NoError(t, err)
+	assert.Empty(t, journal)
+	stored, err := stor.Get(tail.Hash())
+	require.NoError(t, err)
+	assert.NotEmpty(t, stored)
+
+	stor.fail = false
+	reverted, err := bc.Rollback(2, true)
+	require.NoError(t, err)
+	require.Len(t, reverted, int(tail.Height()-2))
+	assert.Equal(t, tail.Hash(), reverted[0].Hash())
+	assert.EqualValues(t, 2, bc.MainTailBlock().Height())
+	assert.EqualValues(t, 2, bc.LIB().Height())
+
+	txs := 0
+	for _, block := range reverted {
+		txs += len(block.Transactions())
+		_, err := stor.Get(block.Hash())
+		assert.Equal(t, storage.ErrKeyNotFound, err)
+		_, err = stor.Get(byteutils.FromUint64(block.Height()))
+		assert.Equal(t, storage.ErrKeyNotFound, err)
+	}
+
+	journal, err = core.ReadTxJournal(stor)
+	require.NoError(t, err)
+	assert.Len(t, journal, txs)
+}
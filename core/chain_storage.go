@@ -0,0 +1,299 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package core
+
+import (
+	"github.com/medibloc/go-medibloc/common/trie"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+)
+
+// ChainStorage reads the chain layout of a storage without setting up a blockchain. It is used to inspect and
+// repair the storage of a stopped node.
+type ChainStorage struct {
+	storage   storage.Storage
+	consensus Consensus
+}
+
+// NewChainStorage returns a chain storage.
+func NewChainStorage(stor storage.Storage, consensus Consensus) *ChainStorage {
+	return &ChainStorage{
+		storage:   stor,
+		consensus: consensus,
+	}
+}
+
+// TailHash returns the hash of the main tail block.
+func (cs *ChainStorage) TailHash() ([]byte, error) {
+	return cs.storage.Get([]byte(tailBlockKey))
+}
+
+// LIBHash returns the hash of the latest irreversible block.
+func (cs *ChainStorage) LIBHash() ([]byte, error) {
+	return cs.storage.Get([]byte(libKey))
+}
+
+// HashByHeight returns the hash of the block of the height on the canonical chain.
+func (cs *ChainStorage) HashByHeight(height uint64) ([]byte, error) {
+	return cs.storage.Get(byteutils.FromUint64(height))
+}
+
+// BlockData returns the block data of the hash.
+func (cs *ChainStorage) BlockData(hash []byte) (*BlockData, error) {
+	v, err := cs.storage.Get(hash)
+	if err != nil {
+		return nil, err
+	}
+	return BytesToBlockData(v)
+}
+
+// BlockDataByHeight returns the block data of the height on the canonical chain.
+func (cs *ChainStorage) BlockDataByHeight(height uint64) (*BlockData, error) {
+	hash, err := cs.HashByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	return cs.BlockData(hash)
+}
+
+// Block returns the block of the hash with its states loaded.
+func (cs *ChainStorage) Block(hash []byte) (*Block, error) {
+	bd, err := cs.BlockData(hash)
+	if err != nil {
+		return nil, err
+	}
+	return bd.GetExecutedBlock(cs.consensus, cs.storage)
+}
+
+// VerifyBlock checks that the block is indexed at its height and its state roots resolve. If full is true, every
+// node of the account and transaction tries is visited.
+func (cs *ChainStorage) VerifyBlock(bd *BlockData, full bool) error {
+	hash, err := cs.HashByHeight(bd.Height())
+	if err != nil {
+		return err
+	}
+	if !byteutils.Equal(hash, bd.Hash()) {
+		return ErrNotOnCanonicalChain
+	}
+	if _, err := bd.GetExecutedBlock(cs.consensus, cs.storage); err != nil {
+		return err
+	}
+	if !full {
+		return nil
+	}
+	for _, root := range [][]byte{bd.AccStateRoot(), bd.TxStateRoot()} {
+		if _, err := cs.WalkTrie(root, nil, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WalkTrie visits the leaves of the trie under the prefix in order of key, and returns the number of visited
+// leaves. It stops when fn returns false.
+func (cs *ChainStorage) WalkTrie(root []byte, prefix []byte, fn func(key, value []byte) bool) (int, error) {
+	if len(root) == 0 {
+		return 0, nil
+	}
+	t, err := trie.NewTrie(root, cs.storage)
+	if err != nil {
+		return 0, err
+	}
+	iter, err := t.Iterator(prefix)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for {
+		exist, err := iter.Next()
+		if err != nil {
+			return n, err
+		}
+		if !exist {
+			return n, nil
+		}
+		n++
+		if fn != nil && !fn(iter.Key(), iter.Value()) {
+			return n, nil
+		}
+	}
+}
+
+// Replay re-executes the canonical blocks above the height of from up to the height of to on the state of the block
+// of from, which is loaded from its stored state roots. Each block is verified against its header, and it returns the
+// height of the first block which fails. States made by the execution are kept in memory, so the storage is never
+// changed.
+func (cs *ChainStorage) Replay(from, to uint64, txMap TxFactory) (uint64, error) {
+	stor := storage.NewOverlayStorage(cs.storage)
+	parentData, err := cs.BlockDataByHeight(from)
+	if err != nil {
+		return from, err
+	}
+	parent, err := parentData.GetExecutedBlock(cs.consensus, stor)
+	if err != nil {
+		return from, err
+	}
+
+	for height := from + 1; height <= to; height++ {
+		bd, err := cs.BlockDataByHeight(height)
+		if err != nil {
+			return height, err
+		}
+		if !byteutils.Equal(bd.ParentHash(), parent.Hash()) {
+			return height, ErrNotOnCanonicalChain
+		}
+		if err := bd.VerifyIntegrity(); err != nil {
+			return height, err
+		}
+		block, err := bd.ExecuteOnParentBlock(parent, txMap)
+		if err != nil {
+			return height, err
+		}
+		parent = block
+	}
+	return to, nil
+}
+
+// SetTail sets the main tail to the block on the canonical chain by Rollback. LIB cannot be reverted.
+func (cs *ChainStorage) SetTail(hash []byte) error {
+	bd, err := cs.BlockData(hash)
+	if err != nil {
+		return err
+	}
+	if err := cs.VerifyBlock(bd, false); err != nil {
+		return err
+	}
+	tailHash, err := cs.TailHash()
+	if err != nil {
+		return err
+	}
+	if byteutils.Equal(tailHash, hash) {
+		return nil
+	}
+	_, err = cs.Rollback(bd.Height(), false)
+	return err
+}
+
+// Rollback moves the main tail back to the canonical block of the height and returns the reverted blocks from the
+// old tail. Transactions of the reverted blocks are appended to the pending journal and the reverted blocks are
+// removed from the storage together with the heights above the new tail. LIB cannot be reverted unless force is
+// true.
+func (cs *ChainStorage) Rollback(height uint64, force bool) ([]*BlockData, error) {
+	tailHash, err := cs.TailHash()
+	if err != nil {
+		return nil, err
+	}
+	tail, err := cs.BlockData(tailHash)
+	if err != nil {
+		return nil, err
+	}
+	if height < GenesisHeight || height >= tail.Height() {
+		return nil, ErrInvalidRollbackHeight
+	}
+	lib, err := cs.libBlockData()
+	if err != nil {
+		return nil, err
+	}
+	if height < lib.Height() && !force {
+		return nil, ErrCannotRevertLIB
+	}
+
+	newTail, err := cs.BlockDataByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	var blocks []*BlockData
+	for block := tail; !byteutils.Equal(block.Hash(), newTail.Hash()); {
+		blocks = append(blocks, block)
+		if block, err = cs.BlockData(block.ParentHash()); err != nil {
+			return nil, err
+		}
+	}
+
+	// All writes are applied at once, so that a crash leaves the storage either before or after the rollback.
+	batch := storage.NewWriteBatch()
+
+	// Journal transactions in the order of execution, so that nonces are kept in order when they are pushed again.
+	var txs []*Transaction
+	for i := len(blocks) - 1; i >= 0; i-- {
+		txs = append(txs, blocks[i].Transactions()...)
+	}
+	if err := appendTxJournal(cs.storage, batch, txs); err != nil {
+		return nil, err
+	}
+
+	newLIB := lib
+	if newTail.Height() < lib.Height() {
+		newLIB = newTail
+		batch.Put([]byte(libKey), newLIB.Hash())
+	}
+	for block := newTail; !byteutils.Equal(block.Hash(), newLIB.Hash()); {
+		batch.Put(byteutils.FromUint64(block.Height()), block.Hash())
+		if block, err = cs.BlockData(block.ParentHash()); err != nil {
+			return nil, err
+		}
+	}
+	batch.Put([]byte(tailBlockKey), newTail.Hash())
+	for h := newTail.Height() + 1; ; h++ {
+		_, err := cs.HashByHeight(h)
+		if err == storage.ErrKeyNotFound {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		batch.Delete(byteutils.FromUint64(h))
+	}
+	for _, block := range blocks {
+		batch.Delete(block.Hash())
+	}
+	if err := cs.storage.Write(batch); err != nil {
+		return nil, err
+	}
+	return blocks, nil
+}
+
+// SetLIB sets LIB to the block on the canonical chain. LIB cannot be higher than the tail.
+func (cs *ChainStorage) SetLIB(hash []byte) error {
+	bd, err := cs.BlockData(hash)
+	if err != nil {
+		return err
+	}
+	if err := cs.VerifyBlock(bd, false); err != nil {
+		return err
+	}
+	tailHash, err := cs.TailHash()
+	if err != nil {
+		return err
+	}
+	tail, err := cs.BlockData(tailHash)
+	if err != nil {
+		return err
+	}
+	if bd.Height() > tail.Height() {
+		return ErrLIBAboveTail
+	}
+	return cs.storage.Put([]byte(libKey), hash)
+}
+
+func (cs *ChainStorage) libBlockData() (*BlockData, error) {
+	hash, err := cs.LIBHash()
+	if err != nil {
+		return nil, err
+	}
+	return cs.BlockData(hash)
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package core_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/medibloc/go-medibloc/consensus/dpos"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/medlet"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/medibloc/go-medibloc/util/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainStorage(t *testing.T) {
+	testNet := testutil.NewNetwork(t, testutil.DynastySize)
+	defer testNet.Cleanup()
+	testNet.SetLogTestHook()
+
+	seed := testNet.NewSeedNode()
+	testNet.SetMinerFromDynasties(seed)
+	seed.Start()
+	for seed.Tail().Height() < 3 {
+		time.Sleep(100 * time.Millisecond)
+	}
+	seed.Stop()
+
+	stor, err := storage.NewRocksStorage(seed.Config.Config.Global.Datadir)
+	require.NoError(t, err)
+	defer stor.Close()
+	cs := core.NewChainStorage(stor, dpos.New(testutil.DynastySize))
+
+	tailHash, err := cs.TailHash()
+	require.NoError(t, err)
+	tail, err := cs.BlockData(tailHash)
+	require.NoError(t, err)
+	require.True(t, tail.Height() >= 3)
+
+	for height := core.GenesisHeight; height <= tail.Height(); height++ {
+		bd, err := cs.BlockDataByHeight(height)
+		require.NoError(t, err)
+		assert.NoError(t, cs.VerifyBlock(bd, true))
+	}
+
+	genesis, err := cs.BlockDataByHeight(core.GenesisHeight)
+	require.NoError(t, err)
+	n, err := cs.WalkTrie(genesis.AccStateRoot(), nil, nil)
+	require.NoError(t, err)
+	assert.True(t, n > 0)
+
+	failed, err := cs.Replay(core.GenesisHeight, tail.Height(), medlet.DefaultTxMap)
+	require.NoError(t, err)
+	assert.Equal(t, tail.Height(), failed)
+
+	second, err := cs.BlockDataByHeight(2)
+	require.NoError(t, err)
+	third, err := cs.BlockDataByHeight(3)
+	require.NoError(t, err)
+	require.NoError(t, stor.Put(byteutils.FromUint64(3), second.Hash()))
+	failed, err = cs.Replay(core.GenesisHeight, tail.Height(), medlet.DefaultTxMap)
+	assert.Equal(t, core.ErrNotOnCanonicalChain, err)
+	assert.EqualValues(t, 3, failed)
+	require.NoError(t, stor.Put(byteutils.FromUint64(3), third.Hash()))
+
+	require.NoError(t, cs.SetLIB(second.Hash()))
+	assert.Equal(t, core.ErrCannotRevertLIB, cs.SetTail(genesis.Hash()))
+	require.NoError(t, cs.SetTail(second.Hash()))
+	// blocks above the tail are not on the canonical chain anymore.
+	assert.Equal(t, storage.ErrKeyNotFound, cs.SetLIB(tail.Hash()))
+
+	tailHash, err = cs.TailHash()
+	require.NoError(t, err)
+	assert.Equal(t, second.Hash(), tailHash)
+	for height := third.Height(); height <= tail.Height(); height++ {
+		_, err = cs.HashByHeight(height)
+		assert.Equal(t, storage.ErrKeyNotFound, err)
+	}
+	hash, err := cs.HashByHeight(second.Height())
+	require.NoError(t, err)
+	assert.Equal(t, second.Hash(), hash)
+}
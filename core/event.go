@@ -2,8 +2,10 @@ package core
 
 import (
 	"sync"
+	"sync/atomic"
 
 	"github.com/medibloc/go-medibloc/util/logging"
+	"github.com/sirupsen/logrus"
 )
 
 // TODO @ggomma check whether event emitter exists
@@ -28,6 +30,10 @@ const (
 type EventSubscriber struct {
 	eventCh chan *Event
 	topics  []string
+
+	dropped      uint64
+	overflowCh   chan struct{}
+	overflowOnce sync.Once
 }
 
 func topicList() map[string]bool {
@@ -40,6 +46,11 @@ func topicList() map[string]bool {
 	return topicList
 }
 
+// IsValidTopic returns true if the topic exists.
+func IsValidTopic(topic string) bool {
+	return topicList()[topic]
+}
+
 // NewEventSubscriber creates new event subscriber
 func NewEventSubscriber(size int, topics []string) (*EventSubscriber, error) {
 	topicList := topicList()
@@ -52,8 +63,9 @@ func NewEventSubscriber(size int, topics []string) (*EventSubscriber, error) {
 
 	eventCh := make(chan *Event, size)
 	return &EventSubscriber{
-		eventCh: eventCh,
-		topics:  topics,
+		eventCh:    eventCh,
+		topics:     topics,
+		overflowCh: make(chan struct{}),
 	}, nil
 }
 
@@ -62,12 +74,36 @@ func (s *EventSubscriber) EventChan() chan *Event {
 	return s.eventCh
 }
 
+// Topics returns subscribed topics
+func (s *EventSubscriber) Topics() []string {
+	return s.topics
+}
+
+// Overflowed returns a channel which is closed when the first event is dropped
+func (s *EventSubscriber) Overflowed() <-chan struct{} {
+	return s.overflowCh
+}
+
+// Dropped returns the number of events dropped because the event channel is full
+func (s *EventSubscriber) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+func (s *EventSubscriber) drop() {
+	atomic.AddUint64(&s.dropped, 1)
+	s.overflowOnce.Do(func() {
+		logging.Console().Warn("Event subscriber is too slow. Events are dropped.")
+		close(s.overflowCh)
+	})
+}
+
 // EventEmitter structure
 type EventEmitter struct {
 	eventSubscribers *sync.Map
 	eventCh          chan *Event
 	quitCh           chan bool
 	size             int
+	store            *EventStore
 }
 
 // NewEventEmitter creates new event emitter
@@ -109,6 +145,16 @@ func (e *EventEmitter) Trigger(event *Event) {
 	e.eventCh <- event
 }
 
+// InjectStore sets the event store which persists events before they are sent to subscribers.
+func (e *EventEmitter) InjectStore(store *EventStore) {
+	e.store = store
+}
+
+// Store returns the event store. It returns nil if events are not persisted.
+func (e *EventEmitter) Store() *EventStore {
+	return e.store
+}
+
 // Register event channel
 func (e *EventEmitter) Register(subscribers ...*EventSubscriber) {
 	for _, subscriber := range subscribers {
@@ -146,6 +192,15 @@ func (e *EventEmitter) loop() {
 			logging.Console().Info("Stopped Event Emitter")
 			return
 		case event := <-e.eventCh:
+			if e.store != nil {
+				if err := e.store.Append(event); err != nil {
+					logging.Console().WithFields(logrus.Fields{
+						"topic": event.Topic,
+						"err":   err,
+					}).Error("Failed to store event.")
+				}
+			}
+
 			topic := event.Topic
 			subscribers, ok := e.eventSubscribers.Load(topic)
 			// If topic subscriber doesn't exist, continue
@@ -155,10 +210,11 @@ func (e *EventEmitter) loop() {
 
 			subs, _ := subscribers.(*sync.Map)
 			subs.Range(func(key, value interface{}) bool {
+				subscriber := key.(*EventSubscriber)
 				select {
-				case key.(*EventSubscriber).eventCh <- event:
+				case subscriber.eventCh <- event:
 				default:
-					logging.Console().Warn("timeout to dispatch event")
+					subscriber.drop()
 				}
 				return true
 			})
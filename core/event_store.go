@@ -0,0 +1,322 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package core
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+)
+
+const (
+	eventLastSeqKey      = "event_last_seq"
+	eventFirstSeqKey     = "event_first_seq"
+	eventMaxHeightKey    = "event_max_height"
+	eventPrunedHeightKey = "event_pruned_height"
+	eventKeyPrefix       = "event_seq_"
+	eventHeightKeyPrefix = "event_height_"
+)
+
+// DefaultEventStoreTopics are topics stored if topics are not configured. Pending transactions are excluded.
+var DefaultEventStoreTopics = []string{
+	TopicLibBlock,
+	TopicNewTailBlock,
+	TopicRevertBlock,
+	TopicTransactionExecutionResult,
+}
+
+// EventStore persists emitted events with a monotonically increasing sequence number.
+type EventStore struct {
+	mu sync.RWMutex
+
+	storage   storage.Storage
+	topics    map[string]bool
+	maxEvents uint64
+
+	firstSeq     uint64
+	lastSeq      uint64
+	maxHeight    uint64
+	prunedHeight uint64
+}
+
+// NewEventStore creates an event store. Events older than the last maxEvents events are pruned if maxEvents
+// is not 0.
+func NewEventStore(stor storage.Storage, topics []string, maxEvents uint64) (*EventStore, error) {
+	if len(topics) == 0 {
+		topics = DefaultEventStoreTopics
+	}
+	list := topicList()
+	s := &EventStore{
+		storage:   stor,
+		topics:    make(map[string]bool),
+		maxEvents: maxEvents,
+	}
+	for _, topic := range topics {
+		if !list[topic] {
+			return nil, ErrWrongEventTopic
+		}
+		s.topics[topic] = true
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *EventStore) load() error {
+	var err error
+	if s.lastSeq, err = s.getUint64(eventLastSeqKey); err != nil {
+		return err
+	}
+	if s.firstSeq, err = s.getUint64(eventFirstSeqKey); err != nil {
+		return err
+	}
+	if s.maxHeight, err = s.getUint64(eventMaxHeightKey); err != nil {
+		return err
+	}
+	if s.prunedHeight, err = s.getUint64(eventPrunedHeightKey); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *EventStore) getUint64(key string) (uint64, error) {
+	v, err := s.storage.Get([]byte(key))
+	if err == ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return byteutils.Uint64(v), nil
+}
+
+func eventKey(seq uint64) []byte {
+	return append([]byte(eventKeyPrefix), byteutils.FromUint64(seq)...)
+}
+
+func eventHeightKey(height uint64) []byte {
+	return append([]byte(eventHeightKeyPrefix), byteutils.FromUint64(height)...)
+}
+
+// Append stores the event and sets the sequence number of the event. Events of topics which are not stored
+// are ignored. The event, its height index and pruning of old events are written in a single batch.
+func (s *EventStore) Append(event *Event) error {
+	if !s.topics[event.Topic] {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pb := &corepb.Event{
+		Seq:       s.lastSeq + 1,
+		Topic:     event.Topic,
+		Data:      event.Data,
+		Height:    s.maxHeight,
+		Timestamp: time.Now().Unix(),
+	}
+	if event.Block != nil {
+		pb.Height = event.Block.Height()
+		pb.BlockHash = event.Block.Hash()
+		pb.Timestamp = event.Block.Timestamp()
+	}
+	value, err := proto.Marshal(pb)
+	if err != nil {
+		return err
+	}
+
+	batch := storage.NewWriteBatch()
+	firstSeq, prunedHeight, err := s.prune(batch, pb.Seq)
+	if err != nil {
+		return err
+	}
+	batch.Put(eventKey(pb.Seq), value)
+	if err := s.index(batch, pb); err != nil {
+		return err
+	}
+	maxHeight := s.maxHeight
+	if pb.Height > maxHeight {
+		maxHeight = pb.Height
+		batch.Put([]byte(eventMaxHeightKey), byteutils.FromUint64(maxHeight))
+	}
+	batch.Put([]byte(eventLastSeqKey), byteutils.FromUint64(pb.Seq))
+	if err := s.storage.Write(batch); err != nil {
+		return err
+	}
+
+	s.firstSeq = firstSeq
+	s.prunedHeight = prunedHeight
+	s.lastSeq = pb.Seq
+	s.maxHeight = maxHeight
+	event.Seq = pb.Seq
+	return nil
+}
+
+// index maps the height of the event to its sequence number if no event is stored at the height. Only heights of
+// stored events are mapped, and SeqByHeight seeks the first mapped height for skipped heights.
+func (s *EventStore) index(batch *storage.WriteBatch, pb *corepb.Event) error {
+	if pb.Height > s.maxHeight {
+		batch.Put(eventHeightKey(pb.Height), byteutils.FromUint64(pb.Seq))
+		return nil
+	}
+	_, err := s.storage.Get(eventHeightKey(pb.Height))
+	if err == nil {
+		return nil
+	}
+	if err != ErrNotFound {
+		return err
+	}
+	batch.Put(eventHeightKey(pb.Height), byteutils.FromUint64(pb.Seq))
+	return nil
+}
+
+// prune adds deletion of events older than the last maxEvents events to the batch, and returns the first
+// sequence number and the highest height of pruned events after the batch is written. They are written only if
+// they are changed.
+func (s *EventStore) prune(batch *storage.WriteBatch, lastSeq uint64) (uint64, uint64, error) {
+	firstSeq, prunedHeight := s.firstSeq, s.prunedHeight
+	if firstSeq == 0 {
+		firstSeq = lastSeq
+	}
+	for s.maxEvents > 0 && lastSeq-firstSeq+1 > s.maxEvents {
+		pb, err := s.get(firstSeq)
+		if err != nil && err != ErrNotFound {
+			return 0, 0, err
+		}
+		if pb != nil {
+			if err := s.unindex(batch, pb); err != nil {
+				return 0, 0, err
+			}
+			if pb.Height > prunedHeight {
+				prunedHeight = pb.Height
+			}
+		}
+		batch.Delete(eventKey(firstSeq))
+		firstSeq++
+	}
+	if firstSeq != s.firstSeq {
+		batch.Put([]byte(eventFirstSeqKey), byteutils.FromUint64(firstSeq))
+	}
+	if prunedHeight != s.prunedHeight {
+		batch.Put([]byte(eventPrunedHeightKey), byteutils.FromUint64(prunedHeight))
+	}
+	return firstSeq, prunedHeight, nil
+}
+
+// unindex adds deletion of the height of the pruned event to the batch if the height is mapped to the event.
+func (s *EventStore) unindex(batch *storage.WriteBatch, pb *corepb.Event) error {
+	v, err := s.storage.Get(eventHeightKey(pb.Height))
+	if err == ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if byteutils.Uint64(v) == pb.Seq {
+		batch.Delete(eventHeightKey(pb.Height))
+	}
+	return nil
+}
+
+func (s *EventStore) get(seq uint64) (*corepb.Event, error) {
+	value, err := s.storage.Get(eventKey(seq))
+	if err != nil {
+		return nil, err
+	}
+	pb := new(corepb.Event)
+	if err := proto.Unmarshal(value, pb); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// Topics returns whether topics are stored.
+func (s *EventStore) Topics() map[string]bool {
+	return s.topics
+}
+
+// FirstSeq returns the sequence number of the oldest event which is not pruned. It returns 0 if nothing is stored.
+func (s *EventStore) FirstSeq() uint64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.firstSeq
+}
+
+// LastSeq returns the sequence number of the latest event. It returns 0 if nothing is stored.
+func (s *EventStore) LastSeq() uint64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.lastSeq
+}
+
+// SeqByHeight returns the sequence number of the first event at the height or above, which is found by seeking
+// the first stored height from the height. Events are returned from the oldest one if the height is below the
+// lowest stored height. It returns ErrEventPruned if events at the height are pruned.
+func (s *EventStore) SeqByHeight(height uint64) (uint64, error) {
+	s.mu.RLock()
+	maxHeight, firstSeq, prunedHeight := s.maxHeight, s.firstSeq, s.prunedHeight
+	s.mu.RUnlock()
+
+	if firstSeq == 0 || height > maxHeight {
+		return 0, ErrNotFound
+	}
+	if firstSeq > 1 && height <= prunedHeight {
+		return 0, ErrEventPruned
+	}
+	_, v, err := s.storage.Seek([]byte(eventHeightKeyPrefix), eventHeightKey(height))
+	if err != nil {
+		return 0, err
+	}
+	return byteutils.Uint64(v), nil
+}
+
+// Events returns at most limit events from the sequence number. Events are returned from the oldest one
+// if fromSeq is 0.
+func (s *EventStore) Events(fromSeq uint64, limit uint64) ([]*corepb.Event, error) {
+	s.mu.RLock()
+	firstSeq, lastSeq := s.firstSeq, s.lastSeq
+	s.mu.RUnlock()
+
+	if fromSeq == 0 {
+		fromSeq = firstSeq
+	}
+	if fromSeq < firstSeq {
+		return nil, ErrEventPruned
+	}
+
+	var events []*corepb.Event
+	for seq := fromSeq; seq <= lastSeq && uint64(len(events)) < limit; seq++ {
+		pb, err := s.get(seq)
+		if err == ErrNotFound {
+			return nil, ErrEventPruned
+		}
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, pb)
+	}
+	return events, nil
+}
+
+// Close closes the storage of the event store.
+func (s *EventStore) Close() error {
+	return s.storage.Close()
+}
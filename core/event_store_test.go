@@ -0,0 +1,148 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package core_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/medibloc/go-medibloc/util/testutil/blockutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventStore(t *testing.T) {
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	store, err := core.NewEventStore(stor, nil, 0)
+	require.NoError(t, err)
+
+	bb := blockutil.New(t, 3).Genesis()
+	for height := uint64(1); height <= 3; height++ {
+		block := bb.Height(height).Build()
+		require.NoError(t, store.Append(&core.Event{Topic: core.TopicNewTailBlock, Data: "tail", Block: block}))
+		require.NoError(t, store.Append(&core.Event{Topic: core.TopicLibBlock, Data: "lib", Block: block}))
+	}
+	pending := &core.Event{Topic: core.TopicPendingTransaction, Data: "pending"}
+	require.NoError(t, store.Append(pending))
+	assert.Equal(t, uint64(0), pending.Seq)
+
+	assert.Equal(t, uint64(1), store.FirstSeq())
+	assert.Equal(t, uint64(6), store.LastSeq())
+
+	seq, err := store.SeqByHeight(2)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), seq)
+	_, err = store.SeqByHeight(4)
+	assert.Equal(t, core.ErrNotFound, err)
+
+	events, err := store.Events(seq, 3)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	assert.Equal(t, uint64(3), events[0].Seq)
+	assert.Equal(t, core.TopicNewTailBlock, events[0].Topic)
+	assert.Equal(t, uint64(2), events[0].Height)
+	assert.Equal(t, uint64(5), events[2].Seq)
+	assert.Equal(t, uint64(3), events[2].Height)
+
+	// sequence continues after reopening the store.
+	store, err = core.NewEventStore(stor, nil, 4)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), store.LastSeq())
+	require.NoError(t, store.Append(&core.Event{Topic: core.TopicRevertBlock, Data: "revert"}))
+	assert.Equal(t, uint64(7), store.LastSeq())
+	assert.Equal(t, uint64(4), store.FirstSeq())
+
+	_, err = store.Events(1, 10)
+	assert.Equal(t, core.ErrEventPruned, err)
+	events, err = store.Events(0, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	assert.Equal(t, uint64(3), events[3].Height)
+}
+
+func TestEventStoreHeightIndex(t *testing.T) {
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	store, err := core.NewEventStore(stor, nil, 3)
+	require.NoError(t, err)
+
+	bb := blockutil.New(t, 3).Genesis()
+	appendTail := func(height uint64) {
+		block := bb.Height(height).Build()
+		require.NoError(t, store.Append(&core.Event{Topic: core.TopicNewTailBlock, Data: "tail", Block: block}))
+	}
+	seqByHeight := func(height uint64) uint64 {
+		seq, err := store.SeqByHeight(height)
+		require.NoError(t, err)
+		return seq
+	}
+
+	appendTail(1)
+	appendTail(4)
+	// skipped heights are not stored.
+	_, err = stor.Get(append([]byte("event_height_"), byteutils.FromUint64(2)...))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Equal(t, uint64(1), seqByHeight(1))
+	assert.Equal(t, uint64(2), seqByHeight(2))
+	assert.Equal(t, uint64(2), seqByHeight(3))
+	assert.Equal(t, uint64(2), seqByHeight(4))
+	_, err = store.SeqByHeight(5)
+	assert.Equal(t, core.ErrNotFound, err)
+
+	appendTail(5)
+	appendTail(6)
+	assert.Equal(t, uint64(2), store.FirstSeq())
+	_, err = store.SeqByHeight(1)
+	assert.Equal(t, core.ErrEventPruned, err)
+	assert.Equal(t, uint64(2), seqByHeight(2))
+
+	// heights up to the pruned events are reported as pruned.
+	appendTail(7)
+	for height := uint64(1); height <= 4; height++ {
+		_, err = store.SeqByHeight(height)
+		assert.Equal(t, core.ErrEventPruned, err)
+	}
+	assert.Equal(t, uint64(3), seqByHeight(5))
+	assert.Equal(t, uint64(4), seqByHeight(6))
+	assert.Equal(t, uint64(5), seqByHeight(7))
+}
+
+func TestEventEmitterWithStore(t *testing.T) {
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	store, err := core.NewEventStore(stor, nil, 0)
+	require.NoError(t, err)
+
+	emitter := core.NewEventEmitter(1024)
+	emitter.InjectStore(store)
+	emitter.Start()
+	defer emitter.Stop()
+
+	subscriber := register(emitter, core.TopicLibBlock)
+	emitter.Trigger(&core.Event{Topic: core.TopicLibBlock, Data: "lib"})
+
+	select {
+	case e := <-subscriber.EventChan():
+		assert.Equal(t, uint64(1), e.Seq)
+	case <-time.After(time.Second):
+		t.Fatal("event is not received")
+	}
+	assert.Equal(t, uint64(1), store.LastSeq())
+}
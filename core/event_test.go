@@ -139,3 +139,34 @@ func TestEventEmitterWithRunningRegDereg(t *testing.T) {
 
 	emitter.Stop()
 }
+
+func TestEventSubscriberOverflow(t *testing.T) {
+	emitter := core.NewEventEmitter(1024)
+	emitter.Start()
+	defer emitter.Stop()
+
+	subscriber, err := core.NewEventSubscriber(10, []string{core.TopicPendingTransaction})
+	assert.NoError(t, err)
+	emitter.Register(subscriber)
+
+	for i := 0; i < 15; i++ {
+		emitter.Trigger(&core.Event{
+			Topic: core.TopicPendingTransaction,
+			Data:  fmt.Sprintf("%d", i),
+		})
+	}
+
+	select {
+	case <-subscriber.Overflowed():
+	case <-time.After(time.Second):
+		t.Fatal("subscriber is not notified of overflow")
+	}
+	deadline := time.Now().Add(time.Second)
+	for subscriber.Dropped() != 5 {
+		if time.Now().After(deadline) {
+			t.Fatalf("dropped events: expected 5, actual %d", subscriber.Dropped())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Len(t, subscriber.EventChan(), 10)
+}
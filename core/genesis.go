@@ -40,6 +40,8 @@ var (
 	GenesisCoinbase = common.HexToAddress("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
 	// GenesisHeight is height of genesis block
 	GenesisHeight = uint64(1)
+
+	requireTypedCertificationRule = []byte("require_typed_certification")
 )
 
 func genesisHash(quote string) []byte {
@@ -63,6 +65,92 @@ func LoadGenesisConf(filePath string) (*corepb.Genesis, error) {
 	return genesis, nil
 }
 
+// ValidateGenesisConf checks the genesis configuration before generating the genesis block. It returns the
+// total supply of the token distribution.
+func ValidateGenesisConf(conf *corepb.Genesis) (*util.Uint128, error) {
+	if conf.GetMeta() == nil || conf.Meta.ChainId == 0 {
+		return nil, ErrInvalidGenesisMeta
+	}
+
+	dynasty := make(map[common.Address]bool)
+	for _, v := range conf.GetConsensus().GetDpos().GetDynasty() {
+		if !common.IsHexAddress(v) {
+			logging.Console().WithFields(logrus.Fields{
+				"member": v,
+			}).Error("Invalid dynasty member address.")
+			return nil, ErrInvalidGenesisAddress
+		}
+		member := common.HexToAddress(v)
+		if dynasty[member] {
+			logging.Console().WithFields(logrus.Fields{
+				"member": v,
+			}).Error("Duplicated dynasty member.")
+			return nil, ErrDuplicatedGenesisAddress
+		}
+		dynasty[member] = true
+	}
+	if uint32(len(dynasty)) != conf.Meta.DynastySize {
+		logging.Console().WithFields(logrus.Fields{
+			"dynastySize": conf.Meta.DynastySize,
+			"members":     len(dynasty),
+		}).Error("Dynasty size does not match.")
+		return nil, ErrInvalidGenesisDynastySize
+	}
+
+	supply := util.NewUint128()
+	holders := make(map[common.Address]bool)
+	for _, dist := range conf.TokenDistribution {
+		if !common.IsHexAddress(dist.Address) {
+			logging.Console().WithFields(logrus.Fields{
+				"address": dist.Address,
+			}).Error("Invalid token distribution address.")
+			return nil, ErrInvalidGenesisAddress
+		}
+		holder := common.HexToAddress(dist.Address)
+		if holders[holder] {
+			logging.Console().WithFields(logrus.Fields{
+				"address": dist.Address,
+			}).Error("Duplicated token distribution address.")
+			return nil, ErrDuplicatedGenesisAddress
+		}
+		holders[holder] = true
+
+		balance, err := util.NewUint128FromString(dist.Balance)
+		if err != nil {
+			logging.Console().WithFields(logrus.Fields{
+				"address": dist.Address,
+				"balance": dist.Balance,
+			}).Error("Invalid balance of token distribution.")
+			return nil, ErrInvalidGenesisAmount
+		}
+		vesting, err := util.NewUint128FromString(dist.Vesting)
+		if err != nil {
+			logging.Console().WithFields(logrus.Fields{
+				"address": dist.Address,
+				"vesting": dist.Vesting,
+			}).Error("Invalid vesting of token distribution.")
+			return nil, ErrInvalidGenesisAmount
+		}
+		for _, v := range dist.Vote {
+			if !common.IsHexAddress(v) || !dynasty[common.HexToAddress(v)] {
+				logging.Console().WithFields(logrus.Fields{
+					"address": dist.Address,
+					"vote":    v,
+				}).Error("Vote target is not a dynasty member.")
+				return nil, ErrGenesisVoteNotInDynasty
+			}
+		}
+
+		if supply, err = supply.Add(balance); err != nil {
+			return nil, err
+		}
+		if supply, err = supply.Add(vesting); err != nil {
+			return nil, err
+		}
+	}
+	return supply, nil
+}
+
 // NewGenesisBlock generates genesis block
 func NewGenesisBlock(conf *corepb.Genesis, consensus Consensus, sto storage.Storage) (*Block, error) {
 	if conf == nil {
@@ -309,6 +397,10 @@ func NewGenesisBlock(conf *corepb.Genesis, consensus Consensus, sto storage.Stor
 	genesisBlock.supply = supply
 	genesisBlock.state.supply = supply.DeepCopy()
 
+	if err := putChainRules(genesisBlock.state, conf.Meta); err != nil {
+		return nil, err
+	}
+
 	if err := genesisBlock.Commit(); err != nil {
 		return nil, err
 	}
@@ -336,6 +428,36 @@ func NewGenesisBlock(conf *corepb.Genesis, consensus Consensus, sto storage.Stor
 	return genesisBlock, nil
 }
 
+// putChainRules stores chain rules of the genesis configuration in the data of GenesisCoinbase.
+func putChainRules(bs *BlockState, meta *corepb.GenesisMeta) error {
+	if !meta.RequireTypedCertification {
+		return nil
+	}
+	acc, err := bs.GetAccount(GenesisCoinbase)
+	if err != nil {
+		return err
+	}
+	if err := acc.Data.Prepare(); err != nil {
+		return err
+	}
+	if err := acc.Data.BeginBatch(); err != nil {
+		return err
+	}
+	if err := acc.PutData(ChainRulePrefix, requireTypedCertificationRule, []byte{1}); err != nil {
+		if err := acc.Data.RollBack(); err != nil {
+			return err
+		}
+		return err
+	}
+	if err := acc.Data.Commit(); err != nil {
+		return err
+	}
+	if err := acc.Data.Flush(); err != nil {
+		return err
+	}
+	return bs.PutAccount(acc)
+}
+
 // CheckGenesisBlock checks if a block is genesis block
 func CheckGenesisBlock(block *Block) bool {
 	if block == nil {
@@ -383,6 +505,21 @@ func CheckGenesisConf(block *Block, genesis *corepb.Genesis) bool {
 		return false
 	}
 
+	required, err := block.state.TypedCertificationRequired()
+	if err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to get chain rules from genesis block.")
+		return false
+	}
+	if required != genesis.Meta.RequireTypedCertification {
+		logging.Console().WithFields(logrus.Fields{
+			"block":   block,
+			"genesis": genesis,
+		}).Error("Genesis chain rules do not match.")
+		return false
+	}
+
 	tokenDist := genesis.GetTokenDistribution()
 	if len(accounts)-2 != len(tokenDist) {
 		logging.Console().WithFields(logrus.Fields{
@@ -16,6 +16,7 @@
 package core_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/medibloc/go-medibloc/consensus/dpos"
@@ -114,4 +115,35 @@ func TestCheckGenesisBlock(t *testing.T) {
 	modified = copystructure.Must(copystructure.Copy(conf)).(*corepb.Genesis)
 	modified.TokenDistribution[4].Balance = "Wrong Value"
 	require.False(t, core.CheckGenesisConf(genesis, modified))
+
+	modified = copystructure.Must(copystructure.Copy(conf)).(*corepb.Genesis)
+	modified.Meta.RequireTypedCertification = true
+	require.False(t, core.CheckGenesisConf(genesis, modified))
+}
+
+func TestValidateGenesisConf(t *testing.T) {
+	conf, _, _ := testutil.NewTestGenesisConf(t, testutil.DynastySize)
+	supply, err := core.ValidateGenesisConf(conf)
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("%d000000000000000000", len(conf.TokenDistribution)), supply.String())
+
+	modified := copystructure.Must(copystructure.Copy(conf)).(*corepb.Genesis)
+	modified.Meta.DynastySize = uint32(testutil.DynastySize + 1)
+	_, err = core.ValidateGenesisConf(modified)
+	assert.Equal(t, core.ErrInvalidGenesisDynastySize, err)
+
+	modified = copystructure.Must(copystructure.Copy(conf)).(*corepb.Genesis)
+	modified.TokenDistribution[1].Address = modified.TokenDistribution[0].Address
+	_, err = core.ValidateGenesisConf(modified)
+	assert.Equal(t, core.ErrDuplicatedGenesisAddress, err)
+
+	modified = copystructure.Must(copystructure.Copy(conf)).(*corepb.Genesis)
+	modified.TokenDistribution[0].Vesting = "-1"
+	_, err = core.ValidateGenesisConf(modified)
+	assert.Equal(t, core.ErrInvalidGenesisAmount, err)
+
+	modified = copystructure.Must(copystructure.Copy(conf)).(*corepb.Genesis)
+	modified.TokenDistribution[0].Vote = []string{modified.TokenDistribution[len(modified.TokenDistribution)-1].Address}
+	_, err = core.ValidateGenesisConf(modified)
+	assert.Equal(t, core.ErrGenesisVoteNotInDynasty, err)
 }
@@ -0,0 +1,43 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package core
+
+import (
+	"github.com/medibloc/go-medibloc/metrics"
+)
+
+// Metrics of blockchain
+var (
+	metricsTailHeight = metrics.NewGauge("med.chain.height")
+	metricsLIBHeight  = metrics.NewGauge("med.chain.lib.height")
+	metricsLIBLag     = metrics.NewGauge("med.chain.lib.lag")
+	metricsBlocks     = metrics.NewMeter("med.chain.blocks")
+	metricsTxs        = metrics.NewMeter("med.chain.txs")
+	metricsReorgs     = metrics.NewMeter("med.chain.reorgs")
+	metricsReorgDepth = metrics.NewHistogramWithUniformSample("med.chain.reorg.depth", 100)
+
+	metricsTxPoolSize    = metrics.NewGauge("med.txpool.size")
+	metricsTxPoolEvicted = metrics.NewMeter("med.txpool.evicted")
+)
+
+func updateChainMetrics(tail *Block, lib *Block) {
+	if tail == nil || lib == nil {
+		return
+	}
+	metricsTailHeight.Update(int64(tail.Height()))
+	metricsLIBHeight.Update(int64(lib.Height()))
+	metricsLIBLag.Update(int64(tail.Height() - lib.Height()))
+}
@@ -12,12 +12,18 @@ It has these top-level messages:
 	Block
 	DownloadParentBlock
 	Transaction
+	TransactionJournal
 	TransactionHashTarget
 	DefaultPayload
 	VotePayload
 	AddCertificationPayload
+	RegisterIssuerPayload
 	RevokeCertificationPayload
 	AddRecordPayload
+	AmendRecordPayload
+	AddRecordBatchPayload
+	TransferOutput
+	BatchTransferPayload
 */
 package corepb
 
@@ -300,6 +306,22 @@ func (m *Transaction) GetPayerSign() []byte {
 	return nil
 }
 
+type TransactionJournal struct {
+	Transactions []*Transaction `protobuf:"bytes,1,rep,name=transactions" json:"transactions,omitempty"`
+}
+
+func (m *TransactionJournal) Reset()                    { *m = TransactionJournal{} }
+func (m *TransactionJournal) String() string            { return proto.CompactTextString(m) }
+func (*TransactionJournal) ProtoMessage()               {}
+func (*TransactionJournal) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{4} }
+
+func (m *TransactionJournal) GetTransactions() []*Transaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
 type TransactionHashTarget struct {
 	TxType    string `protobuf:"bytes,1,opt,name=tx_type,json=txType,proto3" json:"tx_type,omitempty"`
 	From      []byte `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
@@ -314,7 +336,7 @@ type TransactionHashTarget struct {
 func (m *TransactionHashTarget) Reset()                    { *m = TransactionHashTarget{} }
 func (m *TransactionHashTarget) String() string            { return proto.CompactTextString(m) }
 func (*TransactionHashTarget) ProtoMessage()               {}
-func (*TransactionHashTarget) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{4} }
+func (*TransactionHashTarget) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{5} }
 
 func (m *TransactionHashTarget) GetTxType() string {
 	if m != nil {
@@ -379,7 +401,7 @@ type DefaultPayload struct {
 func (m *DefaultPayload) Reset()                    { *m = DefaultPayload{} }
 func (m *DefaultPayload) String() string            { return proto.CompactTextString(m) }
 func (*DefaultPayload) ProtoMessage()               {}
-func (*DefaultPayload) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{5} }
+func (*DefaultPayload) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{6} }
 
 func (m *DefaultPayload) GetMessage() string {
 	if m != nil {
@@ -395,7 +417,7 @@ type VotePayload struct {
 func (m *VotePayload) Reset()                    { *m = VotePayload{} }
 func (m *VotePayload) String() string            { return proto.CompactTextString(m) }
 func (*VotePayload) ProtoMessage()               {}
-func (*VotePayload) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{6} }
+func (*VotePayload) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{7} }
 
 func (m *VotePayload) GetCandidates() [][]byte {
 	if m != nil {
@@ -408,12 +430,13 @@ type AddCertificationPayload struct {
 	IssueTime      int64  `protobuf:"varint,1,opt,name=issue_time,json=issueTime,proto3" json:"issue_time,omitempty"`
 	ExpirationTime int64  `protobuf:"varint,2,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
 	Hash           []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	CertType       string `protobuf:"bytes,4,opt,name=cert_type,json=certType,proto3" json:"cert_type,omitempty"`
 }
 
 func (m *AddCertificationPayload) Reset()                    { *m = AddCertificationPayload{} }
 func (m *AddCertificationPayload) String() string            { return proto.CompactTextString(m) }
 func (*AddCertificationPayload) ProtoMessage()               {}
-func (*AddCertificationPayload) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{7} }
+func (*AddCertificationPayload) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{8} }
 
 func (m *AddCertificationPayload) GetIssueTime() int64 {
 	if m != nil {
@@ -436,14 +459,47 @@ func (m *AddCertificationPayload) GetHash() []byte {
 	return nil
 }
 
+func (m *AddCertificationPayload) GetCertType() string {
+	if m != nil {
+		return m.CertType
+	}
+	return ""
+}
+
+type RegisterIssuerPayload struct {
+	CertType string `protobuf:"bytes,1,opt,name=cert_type,json=certType,proto3" json:"cert_type,omitempty"`
+	SchemaId string `protobuf:"bytes,2,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+}
+
+func (m *RegisterIssuerPayload) Reset()                    { *m = RegisterIssuerPayload{} }
+func (m *RegisterIssuerPayload) String() string            { return proto.CompactTextString(m) }
+func (*RegisterIssuerPayload) ProtoMessage()               {}
+func (*RegisterIssuerPayload) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{9} }
+
+func (m *RegisterIssuerPayload) GetCertType() string {
+	if m != nil {
+		return m.CertType
+	}
+	return ""
+}
+
+func (m *RegisterIssuerPayload) GetSchemaId() string {
+	if m != nil {
+		return m.SchemaId
+	}
+	return ""
+}
+
 type RevokeCertificationPayload struct {
 	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (m *RevokeCertificationPayload) Reset()                    { *m = RevokeCertificationPayload{} }
-func (m *RevokeCertificationPayload) String() string            { return proto.CompactTextString(m) }
-func (*RevokeCertificationPayload) ProtoMessage()               {}
-func (*RevokeCertificationPayload) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{8} }
+func (m *RevokeCertificationPayload) Reset()         { *m = RevokeCertificationPayload{} }
+func (m *RevokeCertificationPayload) String() string { return proto.CompactTextString(m) }
+func (*RevokeCertificationPayload) ProtoMessage()    {}
+func (*RevokeCertificationPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptorBlock, []int{10}
+}
 
 func (m *RevokeCertificationPayload) GetHash() []byte {
 	if m != nil {
@@ -459,7 +515,7 @@ type AddRecordPayload struct {
 func (m *AddRecordPayload) Reset()                    { *m = AddRecordPayload{} }
 func (m *AddRecordPayload) String() string            { return proto.CompactTextString(m) }
 func (*AddRecordPayload) ProtoMessage()               {}
-func (*AddRecordPayload) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{9} }
+func (*AddRecordPayload) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{11} }
 
 func (m *AddRecordPayload) GetHash() []byte {
 	if m != nil {
@@ -468,61 +524,166 @@ func (m *AddRecordPayload) GetHash() []byte {
 	return nil
 }
 
+type AmendRecordPayload struct {
+	PrevHash []byte `protobuf:"bytes,1,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`
+	Hash     []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *AmendRecordPayload) Reset()                    { *m = AmendRecordPayload{} }
+func (m *AmendRecordPayload) String() string            { return proto.CompactTextString(m) }
+func (*AmendRecordPayload) ProtoMessage()               {}
+func (*AmendRecordPayload) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{12} }
+
+func (m *AmendRecordPayload) GetPrevHash() []byte {
+	if m != nil {
+		return m.PrevHash
+	}
+	return nil
+}
+
+func (m *AmendRecordPayload) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+type AddRecordBatchPayload struct {
+	MerkleRoot  []byte `protobuf:"bytes,1,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	RecordCount uint32 `protobuf:"varint,2,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+}
+
+func (m *AddRecordBatchPayload) Reset()                    { *m = AddRecordBatchPayload{} }
+func (m *AddRecordBatchPayload) String() string            { return proto.CompactTextString(m) }
+func (*AddRecordBatchPayload) ProtoMessage()               {}
+func (*AddRecordBatchPayload) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{13} }
+
+func (m *AddRecordBatchPayload) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+func (m *AddRecordBatchPayload) GetRecordCount() uint32 {
+	if m != nil {
+		return m.RecordCount
+	}
+	return 0
+}
+
+type TransferOutput struct {
+	To    []byte `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *TransferOutput) Reset()                    { *m = TransferOutput{} }
+func (m *TransferOutput) String() string            { return proto.CompactTextString(m) }
+func (*TransferOutput) ProtoMessage()               {}
+func (*TransferOutput) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{14} }
+
+func (m *TransferOutput) GetTo() []byte {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *TransferOutput) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type BatchTransferPayload struct {
+	Outputs []*TransferOutput `protobuf:"bytes,1,rep,name=outputs" json:"outputs,omitempty"`
+}
+
+func (m *BatchTransferPayload) Reset()                    { *m = BatchTransferPayload{} }
+func (m *BatchTransferPayload) String() string            { return proto.CompactTextString(m) }
+func (*BatchTransferPayload) ProtoMessage()               {}
+func (*BatchTransferPayload) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{15} }
+
+func (m *BatchTransferPayload) GetOutputs() []*TransferOutput {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BlockHeader)(nil), "corepb.BlockHeader")
 	proto.RegisterType((*Block)(nil), "corepb.Block")
 	proto.RegisterType((*DownloadParentBlock)(nil), "corepb.DownloadParentBlock")
 	proto.RegisterType((*Transaction)(nil), "corepb.Transaction")
+	proto.RegisterType((*TransactionJournal)(nil), "corepb.TransactionJournal")
 	proto.RegisterType((*TransactionHashTarget)(nil), "corepb.TransactionHashTarget")
 	proto.RegisterType((*DefaultPayload)(nil), "corepb.DefaultPayload")
 	proto.RegisterType((*VotePayload)(nil), "corepb.VotePayload")
 	proto.RegisterType((*AddCertificationPayload)(nil), "corepb.AddCertificationPayload")
+	proto.RegisterType((*RegisterIssuerPayload)(nil), "corepb.RegisterIssuerPayload")
 	proto.RegisterType((*RevokeCertificationPayload)(nil), "corepb.RevokeCertificationPayload")
 	proto.RegisterType((*AddRecordPayload)(nil), "corepb.AddRecordPayload")
+	proto.RegisterType((*AmendRecordPayload)(nil), "corepb.AmendRecordPayload")
+	proto.RegisterType((*AddRecordBatchPayload)(nil), "corepb.AddRecordBatchPayload")
+	proto.RegisterType((*TransferOutput)(nil), "corepb.TransferOutput")
+	proto.RegisterType((*BatchTransferPayload)(nil), "corepb.BatchTransferPayload")
 }
 
 func init() { proto.RegisterFile("block.proto", fileDescriptorBlock) }
 
 var fileDescriptorBlock = []byte{
-	// 629 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xdf, 0x6e, 0xd3, 0x30,
-	0x14, 0xc6, 0x95, 0xa4, 0x4d, 0xd7, 0x93, 0xae, 0x4c, 0xde, 0x3f, 0x33, 0x06, 0x54, 0x11, 0x82,
-	0x09, 0xc4, 0x84, 0xe0, 0x82, 0x2b, 0x2e, 0x06, 0xbb, 0x18, 0x77, 0x53, 0x56, 0x71, 0x5b, 0xb9,
-	0xf1, 0x59, 0x6b, 0xad, 0x8d, 0x23, 0xdb, 0xdd, 0xda, 0x07, 0xe0, 0x41, 0x78, 0x26, 0x78, 0x20,
-	0x64, 0x27, 0x21, 0x99, 0xd6, 0x02, 0x77, 0x3e, 0xdf, 0xf9, 0x8e, 0xdc, 0xf3, 0xf3, 0xd7, 0x40,
-	0x34, 0x9e, 0xc9, 0xf4, 0xe6, 0x34, 0x57, 0xd2, 0x48, 0x12, 0xa6, 0x52, 0x61, 0x3e, 0x8e, 0x7f,
-	0xf9, 0x10, 0x7d, 0xb6, 0xfa, 0x05, 0x32, 0x8e, 0x8a, 0x10, 0x68, 0x4d, 0x99, 0x9e, 0x52, 0x6f,
-	0xe0, 0x9d, 0xf4, 0x12, 0x77, 0x26, 0xcf, 0x21, 0xca, 0x99, 0xc2, 0xcc, 0x8c, 0x5c, 0xcb, 0x77,
-	0x2d, 0x28, 0xa4, 0x0b, 0x6b, 0x38, 0x82, 0xad, 0x54, 0x8a, 0x6c, 0xcc, 0x34, 0xd2, 0xc0, 0x75,
-	0xff, 0xd4, 0xe4, 0x00, 0x42, 0x85, 0x77, 0x4c, 0x71, 0xda, 0x72, 0x9d, 0xb2, 0xb2, 0xba, 0x5e,
-	0xe4, 0xf9, 0x6c, 0x45, 0xdb, 0x85, 0x5e, 0x54, 0xe4, 0x18, 0xba, 0x46, 0xcc, 0x51, 0x1b, 0x36,
-	0xcf, 0x69, 0x38, 0xf0, 0x4e, 0x82, 0xa4, 0x16, 0xc8, 0x63, 0xd8, 0x4a, 0xa7, 0x4c, 0x64, 0x23,
-	0xc1, 0x69, 0x67, 0xe0, 0x9d, 0x6c, 0x27, 0x1d, 0x57, 0x7f, 0xe5, 0x64, 0x07, 0x02, 0x36, 0x9b,
-	0xd0, 0xc8, 0xa9, 0xf6, 0x68, 0x77, 0xd1, 0x62, 0x92, 0xd1, 0x5e, 0xb1, 0x8b, 0x3d, 0x93, 0x17,
-	0xd0, 0x67, 0x69, 0x3a, 0xd2, 0x86, 0x19, 0x1c, 0x29, 0x29, 0x0d, 0xdd, 0x77, 0xdd, 0x1e, 0x4b,
-	0xd3, 0x2b, 0x2b, 0x26, 0x52, 0x1a, 0x12, 0xc3, 0xb6, 0x59, 0x36, 0x4d, 0x07, 0xce, 0x14, 0x99,
-	0x65, 0xed, 0x79, 0x02, 0x5d, 0x9e, 0x4b, 0x5d, 0xf4, 0x0f, 0x8b, 0xad, 0xad, 0x60, 0x9b, 0xf1,
-	0x77, 0x0f, 0xda, 0x0e, 0x2b, 0x79, 0x03, 0xe1, 0xd4, 0xa1, 0x75, 0x48, 0xa3, 0xf7, 0xbb, 0xa7,
-	0x05, 0xf9, 0xd3, 0x06, 0xf5, 0xa4, 0xb4, 0x90, 0x8f, 0xd0, 0x33, 0x8a, 0x65, 0x9a, 0xa5, 0x46,
-	0xc8, 0x4c, 0x53, 0x7f, 0x10, 0x34, 0x47, 0x86, 0x75, 0x2f, 0xb9, 0x67, 0xb4, 0x34, 0xa7, 0x28,
-	0x26, 0x53, 0xe3, 0xf8, 0xb7, 0x92, 0xb2, 0x8a, 0x3f, 0xc1, 0xee, 0xb9, 0xbc, 0xcb, 0x66, 0x92,
-	0xf1, 0x4b, 0xf7, 0x5e, 0xc5, 0x8f, 0x5a, 0xf7, 0xca, 0x15, 0x2d, 0xbf, 0xa6, 0x15, 0xff, 0xf0,
-	0x21, 0x6a, 0x5c, 0xba, 0x76, 0xee, 0x10, 0x3a, 0x66, 0x39, 0x32, 0xab, 0x1c, 0xdd, 0x68, 0x37,
-	0x09, 0xcd, 0x72, 0xb8, 0xca, 0xd1, 0x9a, 0xaf, 0x95, 0x9c, 0x97, 0x89, 0x70, 0x67, 0xd2, 0x07,
-	0xdf, 0xc8, 0x32, 0x09, 0xbe, 0x91, 0x64, 0x0f, 0xda, 0xb7, 0x6c, 0xb6, 0xc0, 0x32, 0x04, 0x45,
-	0xf1, 0x8f, 0x0c, 0xec, 0x41, 0x3b, 0x93, 0x59, 0x8a, 0x2e, 0x00, 0xad, 0xa4, 0x28, 0xee, 0x25,
-	0x63, 0xeb, 0x7e, 0x32, 0x28, 0x74, 0x72, 0xb6, 0xb2, 0x0c, 0x28, 0xb8, 0x6b, 0xaa, 0xb2, 0xca,
-	0xcc, 0xfe, 0xc3, 0xcc, 0x1c, 0x34, 0x32, 0x73, 0x0c, 0xdd, 0x9c, 0xad, 0x50, 0x5d, 0xd9, 0x46,
-	0xf1, 0xd2, 0xb5, 0x10, 0xff, 0xf4, 0x60, 0xbf, 0xc1, 0xc8, 0xfe, 0x21, 0x86, 0x4c, 0x4d, 0xd0,
-	0x34, 0xc9, 0x78, 0x6b, 0xc9, 0xf8, 0x0f, 0xc8, 0x04, 0x0f, 0xc9, 0xb4, 0x36, 0x92, 0x69, 0x6f,
-	0x24, 0x13, 0x6e, 0x22, 0xd3, 0xf9, 0x4f, 0x32, 0xf1, 0x6b, 0xe8, 0x9f, 0xe3, 0x35, 0x5b, 0xcc,
-	0xcc, 0x65, 0xc9, 0x8a, 0x42, 0x67, 0x8e, 0x5a, 0xb3, 0x49, 0xb5, 0x4d, 0x55, 0xc6, 0x6f, 0x21,
-	0xfa, 0x26, 0x0d, 0x56, 0xc6, 0x67, 0x00, 0x29, 0xcb, 0xb8, 0xe0, 0xcc, 0xa0, 0xa6, 0xde, 0x20,
-	0xb0, 0x5f, 0x8b, 0x5a, 0x89, 0x17, 0x70, 0x78, 0xc6, 0xf9, 0x17, 0x54, 0x46, 0x5c, 0x8b, 0x94,
-	0x59, 0x68, 0xd5, 0xe8, 0x53, 0x00, 0xa1, 0xf5, 0x02, 0x47, 0x76, 0x27, 0x77, 0x4d, 0x90, 0x74,
-	0x9d, 0x32, 0x14, 0x73, 0x24, 0xaf, 0xe0, 0x11, 0x2e, 0x73, 0xa1, 0xdc, 0x4c, 0xe1, 0xf1, 0x9d,
-	0xa7, 0x5f, 0xcb, 0xce, 0x58, 0xe5, 0x34, 0xa8, 0x73, 0x1a, 0xbf, 0x83, 0xa3, 0x04, 0x6f, 0xe5,
-	0x0d, 0xae, 0xbd, 0x79, 0x4d, 0xb2, 0xe3, 0x97, 0xb0, 0x73, 0xc6, 0x79, 0x82, 0xa9, 0x54, 0xfc,
-	0x2f, 0xbe, 0x71, 0xe8, 0x3e, 0xa9, 0x1f, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0xa0, 0x3c, 0xd5,
-	0x2d, 0x61, 0x05, 0x00, 0x00,
+	// 813 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdd, 0x8e, 0xe3, 0x34,
+	0x14, 0x56, 0xd2, 0xff, 0x93, 0x4e, 0x59, 0x79, 0xa7, 0x33, 0x61, 0x67, 0x61, 0x4b, 0x84, 0x60,
+	0x04, 0x62, 0xb4, 0x02, 0x09, 0xae, 0xb8, 0x98, 0xdd, 0x45, 0xea, 0x20, 0x21, 0x16, 0x6f, 0xc5,
+	0x0d, 0x17, 0x91, 0xeb, 0x9c, 0x36, 0xd1, 0x24, 0x71, 0xe4, 0x38, 0xb3, 0xed, 0x03, 0xf0, 0x02,
+	0xbc, 0x01, 0xcf, 0x04, 0x0f, 0x84, 0x6c, 0x27, 0xd3, 0x94, 0x76, 0x60, 0xee, 0x7c, 0xbe, 0xf3,
+	0x1d, 0xdb, 0xe7, 0x3b, 0x9f, 0x13, 0xf0, 0x96, 0xa9, 0xe0, 0xb7, 0x57, 0x85, 0x14, 0x4a, 0x90,
+	0x3e, 0x17, 0x12, 0x8b, 0x65, 0xf0, 0xb7, 0x0b, 0xde, 0x2b, 0x8d, 0xcf, 0x91, 0x45, 0x28, 0x09,
+	0x81, 0x6e, 0xcc, 0xca, 0xd8, 0x77, 0x66, 0xce, 0xe5, 0x98, 0x9a, 0x35, 0x79, 0x01, 0x5e, 0xc1,
+	0x24, 0xe6, 0x2a, 0x34, 0x29, 0xd7, 0xa4, 0xc0, 0x42, 0x73, 0x4d, 0x78, 0x06, 0x43, 0x2e, 0x92,
+	0x7c, 0xc9, 0x4a, 0xf4, 0x3b, 0x26, 0x7b, 0x1f, 0x93, 0x33, 0xe8, 0x4b, 0x7c, 0xcf, 0x64, 0xe4,
+	0x77, 0x4d, 0xa6, 0x8e, 0x34, 0x5e, 0x56, 0x45, 0x91, 0x6e, 0xfd, 0x9e, 0xc5, 0x6d, 0x44, 0x9e,
+	0xc3, 0x48, 0x25, 0x19, 0x96, 0x8a, 0x65, 0x85, 0xdf, 0x9f, 0x39, 0x97, 0x1d, 0xba, 0x03, 0xc8,
+	0x87, 0x30, 0xe4, 0x31, 0x4b, 0xf2, 0x30, 0x89, 0xfc, 0xc1, 0xcc, 0xb9, 0x3c, 0xa1, 0x03, 0x13,
+	0xdf, 0x44, 0xe4, 0x09, 0x74, 0x58, 0xba, 0xf6, 0x3d, 0x83, 0xea, 0xa5, 0xee, 0xa5, 0x4c, 0xd6,
+	0xb9, 0x3f, 0xb6, 0xbd, 0xe8, 0x35, 0xf9, 0x14, 0x26, 0x8c, 0xf3, 0xb0, 0x54, 0x4c, 0x61, 0x28,
+	0x85, 0x50, 0xfe, 0xd4, 0x64, 0xc7, 0x8c, 0xf3, 0x77, 0x1a, 0xa4, 0x42, 0x28, 0x12, 0xc0, 0x89,
+	0xda, 0xb4, 0x49, 0x67, 0x86, 0xe4, 0xa9, 0xcd, 0x8e, 0x73, 0x01, 0xa3, 0xa8, 0x10, 0xa5, 0xcd,
+	0x9f, 0xdb, 0xae, 0x35, 0xa0, 0x93, 0xc1, 0xef, 0x0e, 0xf4, 0x8c, 0xac, 0xe4, 0x4b, 0xe8, 0xc7,
+	0x46, 0x5a, 0x23, 0xa9, 0xf7, 0xf5, 0xd3, 0x2b, 0xab, 0xfc, 0x55, 0x4b, 0x75, 0x5a, 0x53, 0xc8,
+	0x77, 0x30, 0x56, 0x92, 0xe5, 0x25, 0xe3, 0x2a, 0x11, 0x79, 0xe9, 0xbb, 0xb3, 0x4e, 0xbb, 0x64,
+	0xb1, 0xcb, 0xd1, 0x3d, 0xa2, 0x56, 0x33, 0xc6, 0x64, 0x1d, 0x2b, 0xa3, 0x7f, 0x97, 0xd6, 0x51,
+	0xf0, 0x3d, 0x3c, 0x7d, 0x23, 0xde, 0xe7, 0xa9, 0x60, 0xd1, 0x5b, 0x33, 0x2f, 0x7b, 0xa9, 0x63,
+	0x53, 0x6e, 0xd4, 0x72, 0x77, 0x6a, 0x05, 0x7f, 0xba, 0xe0, 0xb5, 0x0e, 0x3d, 0x5a, 0x77, 0x0e,
+	0x03, 0xb5, 0x09, 0xd5, 0xb6, 0x40, 0x53, 0x3a, 0xa2, 0x7d, 0xb5, 0x59, 0x6c, 0x0b, 0xd4, 0xe4,
+	0x95, 0x14, 0x59, 0xed, 0x08, 0xb3, 0x26, 0x13, 0x70, 0x95, 0xa8, 0x9d, 0xe0, 0x2a, 0x41, 0x4e,
+	0xa1, 0x77, 0xc7, 0xd2, 0x0a, 0x6b, 0x13, 0xd8, 0xe0, 0x7f, 0x3c, 0x70, 0x0a, 0xbd, 0x5c, 0xe4,
+	0x1c, 0x8d, 0x01, 0xba, 0xd4, 0x06, 0x7b, 0xce, 0x18, 0xee, 0x3b, 0xc3, 0x87, 0x41, 0xc1, 0xb6,
+	0x5a, 0x03, 0x1f, 0xcc, 0x31, 0x4d, 0xd8, 0x78, 0x66, 0x7a, 0xe8, 0x99, 0xb3, 0x96, 0x67, 0x9e,
+	0xc3, 0xa8, 0x60, 0x5b, 0x94, 0xef, 0x74, 0xc2, 0x4e, 0x7a, 0x07, 0x04, 0x3f, 0x01, 0x69, 0x49,
+	0xf4, 0xa3, 0xa8, 0x64, 0xce, 0xd2, 0x83, 0x49, 0x3a, 0x8f, 0x9c, 0x64, 0xf0, 0x97, 0x03, 0xd3,
+	0x56, 0x56, 0xbf, 0xaf, 0x05, 0x93, 0x6b, 0x54, 0x6d, 0xa1, 0x9d, 0xa3, 0x42, 0xbb, 0x07, 0x42,
+	0x77, 0x0e, 0x85, 0xee, 0x3e, 0x28, 0x74, 0xef, 0x41, 0xa1, 0xfb, 0x0f, 0x09, 0x3d, 0x78, 0xa4,
+	0xd0, 0xc1, 0x17, 0x30, 0x79, 0x83, 0x2b, 0x56, 0xa5, 0xea, 0x6d, 0x2d, 0xbd, 0x0f, 0x83, 0x0c,
+	0xcb, 0x92, 0xad, 0x9b, 0x6e, 0x9a, 0x30, 0xf8, 0x0a, 0xbc, 0x5f, 0x85, 0xc2, 0x86, 0xf8, 0x31,
+	0x00, 0x67, 0x79, 0x94, 0x44, 0x4c, 0xa1, 0xd5, 0x71, 0x4c, 0x5b, 0x48, 0xf0, 0x87, 0x03, 0xe7,
+	0xd7, 0x51, 0xf4, 0x1a, 0xa5, 0x4a, 0x56, 0x09, 0x67, 0x5a, 0xb5, 0xa6, 0xf6, 0x23, 0x80, 0xa4,
+	0x2c, 0x2b, 0x0c, 0x75, 0x53, 0xe6, 0x9c, 0x0e, 0x1d, 0x19, 0x64, 0x91, 0x64, 0x48, 0x3e, 0x87,
+	0x0f, 0x70, 0x53, 0x24, 0xd2, 0xd4, 0x58, 0x8e, 0x6b, 0x38, 0x93, 0x1d, 0x6c, 0x88, 0x8d, 0xef,
+	0x3b, 0x2d, 0xdf, 0x5f, 0xc0, 0x88, 0xa3, 0x54, 0x76, 0x20, 0x5d, 0xd3, 0xc2, 0x50, 0x03, 0x7a,
+	0x24, 0xc1, 0x2f, 0x30, 0xa5, 0xb8, 0x4e, 0x4a, 0x85, 0xf2, 0x46, 0x1f, 0x27, 0x9b, 0x1b, 0xed,
+	0x55, 0x39, 0xfb, 0x55, 0x3a, 0x59, 0xf2, 0x18, 0x33, 0xa6, 0xb5, 0xb5, 0x8f, 0x69, 0x68, 0x81,
+	0x9b, 0x28, 0x78, 0x09, 0xcf, 0x28, 0xde, 0x89, 0x5b, 0x3c, 0xda, 0xe9, 0x91, 0x97, 0x19, 0x7c,
+	0x06, 0x4f, 0xae, 0xa3, 0x88, 0x22, 0x17, 0x32, 0xfa, 0x2f, 0xde, 0x0f, 0x40, 0xae, 0x33, 0xcc,
+	0xff, 0xc5, 0xbc, 0x80, 0x51, 0x21, 0xf1, 0x2e, 0x6c, 0xd1, 0x87, 0x1a, 0x98, 0xd7, 0x1f, 0x8b,
+	0xd6, 0xbf, 0xc0, 0x6e, 0xf3, 0x1b, 0x4c, 0xef, 0x8f, 0x7b, 0xc5, 0x14, 0x8f, 0x9b, 0x9d, 0x5e,
+	0x80, 0x97, 0xa1, 0xbc, 0x4d, 0xeb, 0x6f, 0xa9, 0xdd, 0x0b, 0x2c, 0x64, 0x3e, 0xa5, 0x9f, 0xc0,
+	0x58, 0x9a, 0xb2, 0x90, 0x8b, 0x2a, 0x57, 0x66, 0xd7, 0x13, 0xea, 0x59, 0xec, 0xb5, 0x86, 0x82,
+	0x6f, 0x61, 0x62, 0x5e, 0xc5, 0x0a, 0xe5, 0xcf, 0x95, 0x2a, 0x2a, 0x55, 0x3b, 0xdc, 0x39, 0x74,
+	0xb8, 0xdb, 0x72, 0x78, 0x30, 0x87, 0x53, 0x73, 0x97, 0xa6, 0xb8, 0xb9, 0xd3, 0x4b, 0x18, 0x08,
+	0xb3, 0x4f, 0xf3, 0x34, 0xcf, 0xf6, 0x9e, 0xe6, 0xfd, 0x31, 0xb4, 0xa1, 0x2d, 0xfb, 0xe6, 0xc7,
+	0xf9, 0xcd, 0x3f, 0x03, 0x00, 0x8e, 0xa4, 0x38, 0xaf, 0x47, 0x07, 0x00, 0x00,
 }
@@ -0,0 +1,105 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: event.proto
+
+/*
+Package corepb is a generated protocol buffer package.
+
+It is generated from these files:
+	event.proto
+
+It has these top-level messages:
+	Event
+*/
+package corepb
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type Event struct {
+	Seq       uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Topic     string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Data      string `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Height    uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	BlockHash []byte `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Timestamp int64  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *Event) Reset()                    { *m = Event{} }
+func (m *Event) String() string            { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()               {}
+func (*Event) Descriptor() ([]byte, []int) { return fileDescriptorEvent, []int{0} }
+
+func (m *Event) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *Event) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *Event) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *Event) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Event) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *Event) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Event)(nil), "corepb.Event")
+}
+
+func init() { proto.RegisterFile("event.proto", fileDescriptorEvent) }
+
+var fileDescriptorEvent = []byte{
+	// 165 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0xce, 0xb1, 0x0a, 0xc2, 0x30,
+	0x10, 0xc6, 0x71, 0x62, 0xdb, 0x40, 0x4f, 0x07, 0x39, 0x44, 0x32, 0x28, 0x04, 0xa7, 0x4c, 0x2e,
+	0x3e, 0x83, 0xe0, 0x9c, 0x17, 0x90, 0xb4, 0x1e, 0x26, 0x68, 0x4d, 0x6c, 0x0e, 0x9f, 0xc5, 0xc7,
+	0x95, 0x46, 0xc1, 0xed, 0xfb, 0xff, 0xe0, 0xe0, 0x60, 0x4e, 0x2f, 0x7a, 0xf0, 0x3e, 0x8d, 0x91,
+	0x23, 0xca, 0x3e, 0x8e, 0x94, 0xba, 0xdd, 0x5b, 0x40, 0x73, 0x9c, 0x1c, 0x97, 0x50, 0x65, 0x7a,
+	0x2a, 0xa1, 0x85, 0xa9, 0xed, 0x34, 0x71, 0x05, 0x0d, 0xc7, 0x14, 0x7a, 0x35, 0xd3, 0xc2, 0xb4,
+	0xf6, 0x1b, 0x88, 0x50, 0x5f, 0x1c, 0x3b, 0x55, 0x15, 0x2c, 0x1b, 0xd7, 0x20, 0x3d, 0x85, 0xab,
+	0x67, 0x55, 0x97, 0xf3, 0x5f, 0xe1, 0x16, 0xa0, 0xbb, 0xc7, 0xfe, 0x76, 0xf6, 0x2e, 0x7b, 0xd5,
+	0x68, 0x61, 0x16, 0xb6, 0x2d, 0x72, 0x72, 0xd9, 0xe3, 0x06, 0x5a, 0x0e, 0x03, 0x65, 0x76, 0x43,
+	0x52, 0x52, 0x0b, 0x53, 0xd9, 0x3f, 0x74, 0xb2, 0x7c, 0x7a, 0xf8, 0x0c, 0x00, 0x2c, 0x16, 0x35,
+	0x6d, 0xb8, 0x00, 0x00, 0x00,
+}
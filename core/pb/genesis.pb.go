@@ -72,6 +72,8 @@ type GenesisMeta struct {
 	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	// Dynasty size.
 	DynastySize uint32 `protobuf:"varint,2,opt,name=dynasty_size,json=dynastySize,proto3" json:"dynasty_size,omitempty"`
+	// Reject certifications without cert type, which are not verified against the issuer registry.
+	RequireTypedCertification bool `protobuf:"varint,3,opt,name=require_typed_certification,json=requireTypedCertification,proto3" json:"require_typed_certification,omitempty"`
 }
 
 func (m *GenesisMeta) Reset()                    { *m = GenesisMeta{} }
@@ -93,6 +95,13 @@ func (m *GenesisMeta) GetDynastySize() uint32 {
 	return 0
 }
 
+func (m *GenesisMeta) GetRequireTypedCertification() bool {
+	if m != nil {
+		return m.RequireTypedCertification
+	}
+	return false
+}
+
 type GenesisConsensus struct {
 	Dpos *GenesisConsensusDpos `protobuf:"bytes,1,opt,name=dpos" json:"dpos,omitempty"`
 }
@@ -177,24 +186,27 @@ func init() {
 func init() { proto.RegisterFile("genesis.proto", fileDescriptorGenesis) }
 
 var fileDescriptorGenesis = []byte{
-	// 304 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x51, 0x4b, 0xc3, 0x30,
-	0x14, 0x85, 0xa9, 0x2d, 0x9b, 0xbd, 0x75, 0xa0, 0xd1, 0x87, 0x08, 0x3e, 0xd4, 0xbe, 0xb8, 0xa7,
-	0x31, 0x26, 0xf8, 0x07, 0x1c, 0x88, 0x88, 0x08, 0xd1, 0xf7, 0x92, 0x36, 0x97, 0x19, 0xd4, 0xa4,
-	0xf4, 0x66, 0x83, 0x0d, 0xff, 0x99, 0x7f, 0x4e, 0x9a, 0xb6, 0x38, 0x8a, 0x7b, 0xcb, 0xb9, 0xe7,
-	0x4b, 0x72, 0x4e, 0x08, 0x4c, 0x56, 0x68, 0x90, 0x34, 0xcd, 0xaa, 0xda, 0x3a, 0xcb, 0x46, 0xa5,
-	0xad, 0xb1, 0x2a, 0xb2, 0x9f, 0x00, 0xc6, 0x0f, 0xad, 0xc3, 0x6e, 0x20, 0xfa, 0x42, 0x27, 0x79,
-	0x90, 0x06, 0xd3, 0x64, 0x71, 0x3e, 0x6b, 0x91, 0x59, 0x67, 0x3f, 0xa3, 0x93, 0xc2, 0x03, 0xec,
-	0x0e, 0xe2, 0xd2, 0x1a, 0x42, 0x43, 0x6b, 0xe2, 0x47, 0x9e, 0xe6, 0x03, 0xfa, 0xbe, 0xf7, 0xc5,
-	0x1f, 0xca, 0x5e, 0x80, 0x39, 0xfb, 0x81, 0x26, 0x57, 0x9a, 0x5c, 0xad, 0x8b, 0xb5, 0xd3, 0xd6,
-	0xf0, 0x30, 0x0d, 0xa7, 0xc9, 0x22, 0x1d, 0x1c, 0xf0, 0xd6, 0x80, 0xcb, 0x3d, 0x4e, 0x9c, 0xb9,
-	0xe1, 0x28, 0x7b, 0x82, 0x64, 0x2f, 0x1d, 0xbb, 0x84, 0xe3, 0xf2, 0x5d, 0x6a, 0x93, 0x6b, 0xe5,
-	0x4b, 0x4c, 0xc4, 0xd8, 0xeb, 0x47, 0xc5, 0xae, 0xe1, 0x44, 0x6d, 0x8d, 0x24, 0xb7, 0xcd, 0x49,
-	0xef, 0xd0, 0xa7, 0x9e, 0x88, 0xa4, 0x9b, 0xbd, 0xea, 0x1d, 0x66, 0x4b, 0x38, 0x1d, 0x86, 0x67,
-	0x73, 0x88, 0x54, 0x65, 0xa9, 0x7b, 0x92, 0xab, 0x43, 0x25, 0x97, 0x95, 0x25, 0xe1, 0xc9, 0x6c,
-	0x0e, 0x17, 0xff, 0xb9, 0x8c, 0xc3, 0xb8, 0xbb, 0x8c, 0x07, 0x69, 0x38, 0x8d, 0x45, 0x2f, 0xb3,
-	0x6f, 0xe0, 0x87, 0x3a, 0x37, 0xbb, 0xa4, 0x52, 0x35, 0x52, 0x1b, 0x21, 0x16, 0xbd, 0x6c, 0x9c,
-	0x42, 0x7e, 0x4a, 0x53, 0xb6, 0x5d, 0x62, 0xd1, 0xcb, 0xc6, 0xd9, 0x20, 0x39, 0x6d, 0x56, 0x3c,
-	0x6c, 0x9d, 0x4e, 0x32, 0x06, 0xd1, 0xc6, 0x3a, 0xe4, 0x91, 0x0f, 0xe0, 0xd7, 0xc5, 0xc8, 0xff,
-	0x87, 0xdb, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe3, 0xe1, 0x8e, 0x50, 0x20, 0x02, 0x00, 0x00,
+	// 337 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x4b, 0xc3, 0x30,
+	0x14, 0xc6, 0xa9, 0x2b, 0xdb, 0xfa, 0xea, 0x40, 0xa3, 0x87, 0x0c, 0x3d, 0xd4, 0x5e, 0xec, 0x69,
+	0x8c, 0x09, 0x1e, 0xbd, 0x6c, 0x20, 0x1e, 0x44, 0x88, 0xbb, 0x97, 0xb4, 0x79, 0xce, 0xa0, 0x26,
+	0xb5, 0xc9, 0x06, 0x1b, 0xfe, 0x07, 0xfe, 0x49, 0xfe, 0x73, 0xd2, 0xb4, 0xc5, 0x51, 0xdc, 0x2d,
+	0xdf, 0xfb, 0x7e, 0x09, 0xdf, 0xfb, 0x08, 0x8c, 0x56, 0xa8, 0xd0, 0x48, 0x33, 0x29, 0x4a, 0x6d,
+	0x35, 0xe9, 0xe7, 0xba, 0xc4, 0x22, 0x8b, 0x7f, 0x3c, 0x18, 0xdc, 0xd7, 0x0e, 0xb9, 0x06, 0xff,
+	0x03, 0x2d, 0xa7, 0x5e, 0xe4, 0x25, 0xe1, 0xec, 0x6c, 0x52, 0x23, 0x93, 0xc6, 0x7e, 0x44, 0xcb,
+	0x99, 0x03, 0xc8, 0x2d, 0x04, 0xb9, 0x56, 0x06, 0x95, 0x59, 0x1b, 0x7a, 0xe4, 0x68, 0xda, 0xa1,
+	0xe7, 0xad, 0xcf, 0xfe, 0x50, 0xf2, 0x04, 0xc4, 0xea, 0x37, 0x54, 0xa9, 0x90, 0xc6, 0x96, 0x32,
+	0x5b, 0x5b, 0xa9, 0x15, 0xed, 0x45, 0xbd, 0x24, 0x9c, 0x45, 0x9d, 0x07, 0x96, 0x15, 0xb8, 0xd8,
+	0xe3, 0xd8, 0xa9, 0xed, 0x8e, 0xe2, 0x6f, 0x0f, 0xc2, 0xbd, 0x78, 0x64, 0x0c, 0xc3, 0xfc, 0x95,
+	0x4b, 0x95, 0x4a, 0xe1, 0xb6, 0x18, 0xb1, 0x81, 0xd3, 0x0f, 0x82, 0x5c, 0xc1, 0xb1, 0xd8, 0x2a,
+	0x6e, 0xec, 0x36, 0x35, 0x72, 0x87, 0x2e, 0xf6, 0x88, 0x85, 0xcd, 0xec, 0x59, 0xee, 0x90, 0xdc,
+	0xc1, 0x45, 0x89, 0x9f, 0x6b, 0x59, 0x62, 0x6a, 0xb7, 0x05, 0x8a, 0x34, 0xc7, 0xd2, 0xca, 0x17,
+	0x99, 0xf3, 0x26, 0xa7, 0x97, 0x0c, 0xd9, 0xb8, 0x41, 0x96, 0x15, 0x31, 0xdf, 0x07, 0xe2, 0x05,
+	0x9c, 0x74, 0xb7, 0x27, 0x53, 0xf0, 0x45, 0xa1, 0x4d, 0xd3, 0xe9, 0xe5, 0xa1, 0x96, 0x16, 0x85,
+	0x36, 0xcc, 0x91, 0xf1, 0x14, 0xce, 0xff, 0x73, 0x09, 0x85, 0x41, 0x13, 0x96, 0x7a, 0x51, 0x2f,
+	0x09, 0x58, 0x2b, 0xe3, 0x2f, 0xa0, 0x87, 0x4a, 0xab, 0x6e, 0x71, 0x21, 0x4a, 0x34, 0x75, 0x84,
+	0x80, 0xb5, 0xb2, 0x72, 0x32, 0xfe, 0xce, 0x55, 0x5e, 0x77, 0x11, 0xb0, 0x56, 0x56, 0xce, 0x06,
+	0x8d, 0x95, 0x6a, 0xe5, 0x76, 0x0e, 0x58, 0x2b, 0x09, 0x01, 0x7f, 0xa3, 0x2d, 0x52, 0xdf, 0x05,
+	0x70, 0xe7, 0xac, 0xef, 0x3e, 0xd4, 0xcd, 0xef, 0x00, 0xa2, 0x2c, 0x50, 0xf7, 0x61, 0x02, 0x00,
+	0x00,
 }
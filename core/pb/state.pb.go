@@ -12,6 +12,7 @@ It has these top-level messages:
 	DataState
 	Record
 	Certification
+	IssuerRegistration
 */
 package corepb
 
@@ -191,9 +192,12 @@ func (m *DataState) GetCertificationStateRootHash() []byte {
 }
 
 type Record struct {
-	RecordHash []byte `protobuf:"bytes,1,opt,name=record_hash,json=recordHash,proto3" json:"record_hash,omitempty"`
-	Owner      []byte `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	Timestamp  int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	RecordHash  []byte `protobuf:"bytes,1,opt,name=record_hash,json=recordHash,proto3" json:"record_hash,omitempty"`
+	Owner       []byte `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Timestamp   int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	RecordCount uint32 `protobuf:"varint,4,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+	PrevHash    []byte `protobuf:"bytes,5,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`
+	NextHash    []byte `protobuf:"bytes,6,opt,name=next_hash,json=nextHash,proto3" json:"next_hash,omitempty"`
 }
 
 func (m *Record) Reset()                    { *m = Record{} }
@@ -222,6 +226,27 @@ func (m *Record) GetTimestamp() int64 {
 	return 0
 }
 
+func (m *Record) GetRecordCount() uint32 {
+	if m != nil {
+		return m.RecordCount
+	}
+	return 0
+}
+
+func (m *Record) GetPrevHash() []byte {
+	if m != nil {
+		return m.PrevHash
+	}
+	return nil
+}
+
+func (m *Record) GetNextHash() []byte {
+	if m != nil {
+		return m.NextHash
+	}
+	return nil
+}
+
 type Certification struct {
 	CertificateHash []byte `protobuf:"bytes,1,opt,name=certificate_hash,json=certificateHash,proto3" json:"certificate_hash,omitempty"`
 	Issuer          []byte `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
@@ -229,6 +254,8 @@ type Certification struct {
 	IssueTime       int64  `protobuf:"varint,4,opt,name=issue_time,json=issueTime,proto3" json:"issue_time,omitempty"`
 	ExpirationTime  int64  `protobuf:"varint,5,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
 	RevocationTime  int64  `protobuf:"varint,6,opt,name=revocation_time,json=revocationTime,proto3" json:"revocation_time,omitempty"`
+	CertType        string `protobuf:"bytes,7,opt,name=cert_type,json=certType,proto3" json:"cert_type,omitempty"`
+	SchemaId        string `protobuf:"bytes,8,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
 }
 
 func (m *Certification) Reset()                    { *m = Certification{} }
@@ -278,47 +305,118 @@ func (m *Certification) GetRevocationTime() int64 {
 	return 0
 }
 
+func (m *Certification) GetCertType() string {
+	if m != nil {
+		return m.CertType
+	}
+	return ""
+}
+
+func (m *Certification) GetSchemaId() string {
+	if m != nil {
+		return m.SchemaId
+	}
+	return ""
+}
+
+type IssuerRegistration struct {
+	Issuer    []byte `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	CertType  string `protobuf:"bytes,2,opt,name=cert_type,json=certType,proto3" json:"cert_type,omitempty"`
+	SchemaId  string `protobuf:"bytes,3,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	Registrar []byte `protobuf:"bytes,4,opt,name=registrar,proto3" json:"registrar,omitempty"`
+	Timestamp int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *IssuerRegistration) Reset()                    { *m = IssuerRegistration{} }
+func (m *IssuerRegistration) String() string            { return proto.CompactTextString(m) }
+func (*IssuerRegistration) ProtoMessage()               {}
+func (*IssuerRegistration) Descriptor() ([]byte, []int) { return fileDescriptorState, []int{4} }
+
+func (m *IssuerRegistration) GetIssuer() []byte {
+	if m != nil {
+		return m.Issuer
+	}
+	return nil
+}
+
+func (m *IssuerRegistration) GetCertType() string {
+	if m != nil {
+		return m.CertType
+	}
+	return ""
+}
+
+func (m *IssuerRegistration) GetSchemaId() string {
+	if m != nil {
+		return m.SchemaId
+	}
+	return ""
+}
+
+func (m *IssuerRegistration) GetRegistrar() []byte {
+	if m != nil {
+		return m.Registrar
+	}
+	return nil
+}
+
+func (m *IssuerRegistration) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Account)(nil), "corepb.Account")
 	proto.RegisterType((*DataState)(nil), "corepb.DataState")
 	proto.RegisterType((*Record)(nil), "corepb.Record")
 	proto.RegisterType((*Certification)(nil), "corepb.Certification")
+	proto.RegisterType((*IssuerRegistration)(nil), "corepb.IssuerRegistration")
 }
 
 func init() { proto.RegisterFile("state.proto", fileDescriptorState) }
 
 var fileDescriptorState = []byte{
-	// 511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x94, 0xdb, 0x8e, 0xd3, 0x3c,
-	0x10, 0xc7, 0x95, 0xaf, 0x87, 0xd5, 0x4e, 0x0f, 0xe9, 0x67, 0x96, 0x62, 0x21, 0x96, 0xad, 0x2a,
-	0xc4, 0x06, 0x90, 0xb8, 0xd9, 0x27, 0x58, 0x40, 0x88, 0x4b, 0x14, 0xca, 0x25, 0x8a, 0xdc, 0xc4,
-	0x4b, 0x23, 0x92, 0x4c, 0x64, 0x4f, 0x0f, 0x4f, 0xc1, 0xb3, 0xf0, 0x4a, 0xbc, 0x09, 0xb2, 0x9d,
-	0x83, 0xab, 0xbd, 0x9c, 0x9f, 0x7f, 0x9e, 0xf9, 0x3b, 0x1a, 0x05, 0x26, 0x9a, 0x04, 0xc9, 0xf7,
-	0xb5, 0x42, 0x42, 0x36, 0x4e, 0x51, 0xc9, 0x7a, 0xbb, 0xfe, 0x3d, 0x84, 0x8b, 0xfb, 0x34, 0xc5,
-	0x7d, 0x45, 0x8c, 0xc3, 0x85, 0xc8, 0x32, 0x25, 0xb5, 0xe6, 0xc1, 0x2a, 0x88, 0xa6, 0x71, 0x5b,
-	0x9a, 0x93, 0xad, 0x28, 0x44, 0x95, 0x4a, 0xfe, 0x9f, 0x3b, 0x69, 0x4a, 0x76, 0x05, 0xa3, 0x0a,
-	0x0d, 0x1f, 0xac, 0x82, 0x68, 0x18, 0xbb, 0xc2, 0xf8, 0x07, 0xa9, 0x29, 0xaf, 0x7e, 0xf2, 0x89,
-	0xf3, 0x9b, 0x92, 0xbd, 0x86, 0xf0, 0x80, 0x24, 0xb3, 0x44, 0x21, 0x52, 0xb2, 0x13, 0x7a, 0xc7,
-	0xa7, 0xd6, 0x98, 0x59, 0x1c, 0x23, 0xd2, 0x17, 0xa1, 0x77, 0xec, 0x05, 0x5c, 0x6e, 0x45, 0x95,
-	0x1d, 0xf3, 0x8c, 0x76, 0x7c, 0x66, 0x8d, 0x1e, 0xb0, 0xb7, 0xf0, 0x7f, 0x21, 0x34, 0x25, 0x1d,
-	0x49, 0x48, 0xf3, 0xf9, 0x2a, 0x88, 0x06, 0x71, 0x68, 0x0e, 0x3e, 0xb4, 0x7c, 0xa3, 0x4d, 0xa7,
-	0x7d, 0xa5, 0x49, 0xfc, 0x32, 0x69, 0x42, 0xd7, 0xa9, 0x03, 0x5d, 0xa7, 0x8e, 0x98, 0x4e, 0x8b,
-	0xbe, 0xd3, 0xf7, 0x96, 0x6f, 0x34, 0x7b, 0x09, 0x90, 0x62, 0x51, 0x08, 0x92, 0x4a, 0x14, 0xfc,
-	0xa9, 0x6d, 0xe5, 0x11, 0x16, 0xc1, 0xc2, 0x3c, 0x42, 0x69, 0xef, 0x71, 0x4b, 0x6b, 0xcd, 0x1d,
-	0xef, 0x5e, 0x77, 0x0d, 0x60, 0x48, 0x52, 0xe3, 0x51, 0x2a, 0xfe, 0xcc, 0x85, 0x32, 0xe4, 0xab,
-	0x01, 0xec, 0x1d, 0x30, 0x3a, 0xe9, 0xe4, 0x41, 0x61, 0xe9, 0xb5, 0xba, 0xb1, 0x5a, 0x48, 0x27,
-	0xfd, 0x59, 0x61, 0xd9, 0xf5, 0xba, 0x85, 0x85, 0x91, 0x09, 0x3d, 0x75, 0xe5, 0x3e, 0x29, 0x9d,
-	0xf4, 0x06, 0x3b, 0xf1, 0x15, 0xcc, 0x33, 0x41, 0xc2, 0xd3, 0x22, 0xab, 0x4d, 0x0d, 0x6d, 0xad,
-	0xf5, 0x9f, 0x00, 0x2e, 0x3f, 0x09, 0x12, 0xdf, 0xcc, 0xb2, 0xb8, 0x24, 0x89, 0x5d, 0x1c, 0xef,
-	0x5e, 0xd0, 0x26, 0xb1, 0x52, 0x37, 0xe0, 0x0e, 0x96, 0x4a, 0xa6, 0xa8, 0xb2, 0x47, 0x17, 0xdc,
-	0xd2, 0x3c, 0x71, 0xa7, 0xe7, 0x97, 0xee, 0xe1, 0x3a, 0x95, 0x8a, 0xf2, 0x87, 0x3c, 0x15, 0x94,
-	0x63, 0xf5, 0xe8, 0xee, 0xc0, 0xde, 0x7d, 0x7e, 0x26, 0x9d, 0xb5, 0x58, 0xff, 0x80, 0x71, 0x6c,
-	0x3b, 0xb3, 0x1b, 0x98, 0x34, 0x09, 0xbc, 0x9c, 0xe0, 0x90, 0x9d, 0x76, 0x05, 0x23, 0x3c, 0x56,
-	0x52, 0x35, 0x89, 0x5c, 0x61, 0x56, 0x84, 0xf2, 0x52, 0x6a, 0x12, 0x65, 0x6d, 0xe7, 0x0d, 0xe2,
-	0x1e, 0xac, 0xff, 0x06, 0x30, 0xfb, 0xe8, 0x4f, 0x67, 0x6f, 0x60, 0xd1, 0xc7, 0x91, 0x67, 0xdf,
-	0xc4, 0xe3, 0x76, 0xe0, 0x12, 0xc6, 0xb9, 0xd6, 0xfb, 0x6e, 0x62, 0x53, 0x99, 0x91, 0x8d, 0x2a,
-	0xb3, 0xe6, 0x89, 0x3d, 0x30, 0xfb, 0x61, 0xbd, 0xc4, 0xa4, 0xe0, 0x43, 0x97, 0xc8, 0x92, 0x4d,
-	0x5e, 0x4a, 0x76, 0x0b, 0xa1, 0x3c, 0xd5, 0xb9, 0x72, 0x1f, 0xcc, 0x3a, 0x23, 0xeb, 0xcc, 0x7b,
-	0xdc, 0x8a, 0x4a, 0x1e, 0x30, 0xf5, 0xc4, 0xb1, 0x13, 0x7b, 0x6c, 0xc4, 0xed, 0xd8, 0xfe, 0x15,
-	0xee, 0xfe, 0x05, 0x00, 0x00, 0xff, 0xff, 0x13, 0xad, 0xfe, 0x22, 0x24, 0x04, 0x00, 0x00,
+	// 631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe5, 0xa6, 0x49, 0x9b, 0x69, 0xfe, 0xb1, 0x94, 0xb2, 0xa2, 0x94, 0x9a, 0x08, 0x51,
+	0x03, 0x12, 0x97, 0x3e, 0x41, 0x29, 0x42, 0xf4, 0x86, 0x4c, 0x38, 0x5b, 0x1b, 0x7b, 0xdb, 0x58,
+	0xc4, 0x5e, 0x6b, 0x77, 0x9a, 0xa6, 0x2f, 0xc1, 0x0b, 0x70, 0xe3, 0x09, 0x38, 0xf3, 0x74, 0x68,
+	0x67, 0xfd, 0x97, 0x4a, 0x1c, 0xf7, 0x37, 0xdf, 0x7e, 0x33, 0xdf, 0x66, 0x62, 0x38, 0x30, 0x28,
+	0x50, 0xbe, 0x2f, 0xb4, 0x42, 0xc5, 0x06, 0xb1, 0xd2, 0xb2, 0x58, 0xce, 0x7f, 0xec, 0xc2, 0xde,
+	0x45, 0x1c, 0xab, 0xdb, 0x1c, 0x19, 0x87, 0x3d, 0x91, 0x24, 0x5a, 0x1a, 0xc3, 0x3d, 0xdf, 0x0b,
+	0x46, 0x61, 0x75, 0xb4, 0x95, 0xa5, 0x58, 0x8b, 0x3c, 0x96, 0x7c, 0xc7, 0x55, 0xca, 0x23, 0x3b,
+	0x84, 0x7e, 0xae, 0x2c, 0xef, 0xf9, 0x5e, 0xb0, 0x1b, 0xba, 0x83, 0xd5, 0x6f, 0xa4, 0xc1, 0x34,
+	0xbf, 0xe1, 0x07, 0x4e, 0x5f, 0x1e, 0xd9, 0x6b, 0x98, 0x6e, 0x14, 0xca, 0x24, 0xd2, 0x4a, 0x61,
+	0xb4, 0x12, 0x66, 0xc5, 0x47, 0xa4, 0x18, 0x13, 0x0e, 0x95, 0xc2, 0xcf, 0xc2, 0xac, 0xd8, 0x73,
+	0x18, 0x2e, 0x45, 0x9e, 0xdc, 0xa5, 0x09, 0xae, 0xf8, 0x98, 0x14, 0x0d, 0x60, 0x6f, 0xe1, 0xd1,
+	0x5a, 0x18, 0x8c, 0x6a, 0x12, 0xa1, 0xe1, 0x13, 0xdf, 0x0b, 0x7a, 0xe1, 0xd4, 0x16, 0x3e, 0x54,
+	0x7c, 0x61, 0xac, 0xd3, 0x6d, 0x6e, 0x50, 0x7c, 0xb7, 0xd3, 0x4c, 0x9d, 0x53, 0x0d, 0x6a, 0xa7,
+	0x9a, 0x58, 0xa7, 0x59, 0xe3, 0xf4, 0xad, 0xe2, 0x0b, 0xc3, 0x5e, 0x00, 0xc4, 0x6a, 0xbd, 0x16,
+	0x28, 0xb5, 0x58, 0xf3, 0x27, 0x64, 0xd5, 0x22, 0x2c, 0x80, 0x99, 0x0d, 0xa1, 0x4d, 0x2b, 0xdc,
+	0x11, 0xa9, 0x26, 0x8e, 0xd7, 0xe9, 0x4e, 0x00, 0x2c, 0x89, 0x0a, 0x75, 0x27, 0x35, 0x7f, 0xea,
+	0x86, 0xb2, 0xe4, 0x8b, 0x05, 0xec, 0x1d, 0x30, 0xdc, 0x9a, 0xe8, 0x5a, 0xab, 0xac, 0x65, 0x75,
+	0x4a, 0xb2, 0x29, 0x6e, 0xcd, 0x27, 0xad, 0xb2, 0xda, 0xeb, 0x0c, 0x66, 0x56, 0x8c, 0xaa, 0x25,
+	0xf5, 0xdd, 0x93, 0xe2, 0xd6, 0x2c, 0x54, 0x2d, 0x7c, 0x05, 0x93, 0x44, 0xa0, 0x68, 0xc9, 0x02,
+	0x92, 0x8d, 0x2c, 0xad, 0x54, 0xf3, 0xdf, 0x1e, 0x0c, 0x3f, 0x0a, 0x14, 0x5f, 0xed, 0xb2, 0xb8,
+	0x49, 0x22, 0x5a, 0x9c, 0xd6, 0x3d, 0xaf, 0x9a, 0x84, 0x44, 0x75, 0x83, 0x73, 0x38, 0xd2, 0x32,
+	0x56, 0x3a, 0x79, 0x70, 0xc1, 0x2d, 0xcd, 0x63, 0x57, 0xed, 0x5e, 0xba, 0x80, 0x93, 0x58, 0x6a,
+	0x4c, 0xaf, 0xd3, 0x58, 0x60, 0xaa, 0xf2, 0x07, 0x77, 0x7b, 0x74, 0xf7, 0x59, 0x47, 0xd4, 0xb1,
+	0x98, 0xff, 0xf1, 0x60, 0x10, 0x92, 0x35, 0x3b, 0x85, 0x83, 0x72, 0x84, 0xd6, 0xa0, 0xe0, 0x10,
+	0xb5, 0x3b, 0x84, 0xbe, 0xba, 0xcb, 0xa5, 0x2e, 0x47, 0x72, 0x07, 0xbb, 0x23, 0x98, 0x66, 0xd2,
+	0xa0, 0xc8, 0x0a, 0x6a, 0xd8, 0x0b, 0x1b, 0xc0, 0x5e, 0xc2, 0xa8, 0x34, 0xa5, 0xff, 0x09, 0xdf,
+	0xf5, 0xbd, 0x60, 0x1c, 0x96, 0x8d, 0x2e, 0x2d, 0x62, 0xc7, 0x30, 0x2c, 0xb4, 0xdc, 0xb8, 0xae,
+	0x7d, 0xb2, 0xde, 0xb7, 0x80, 0x7a, 0x1e, 0xc3, 0x30, 0x97, 0xdb, 0x32, 0xce, 0xc0, 0x15, 0x2d,
+	0xa0, 0xe1, 0x7f, 0xee, 0xc0, 0xf8, 0xb2, 0x9d, 0x8d, 0xbd, 0x81, 0x59, 0x13, 0x56, 0x76, 0x5e,
+	0xbc, 0xc5, 0xc9, 0xf9, 0x08, 0x06, 0xa9, 0x31, 0xb7, 0x75, 0x9c, 0xf2, 0x64, 0xf3, 0x94, 0x52,
+	0x99, 0x94, 0x0f, 0xd8, 0x00, 0xbb, 0x7d, 0xa4, 0x8b, 0x6c, 0x44, 0x4a, 0xd3, 0x0b, 0x87, 0x44,
+	0x16, 0x69, 0x26, 0xd9, 0x19, 0x4c, 0xe5, 0xb6, 0x48, 0xb5, 0xfb, 0x39, 0x48, 0xd3, 0x27, 0xcd,
+	0xa4, 0xc1, 0x95, 0x50, 0xcb, 0x8d, 0x8a, 0x5b, 0xc2, 0x81, 0x13, 0x36, 0x98, 0x84, 0xc7, 0x6e,
+	0x9c, 0x08, 0xef, 0x0b, 0xc9, 0xf7, 0x7c, 0x2f, 0x18, 0x86, 0xfb, 0x16, 0x2c, 0xee, 0x0b, 0x2a,
+	0x9a, 0x78, 0x25, 0x33, 0x11, 0xa5, 0x09, 0xdf, 0x77, 0x45, 0x07, 0xae, 0x92, 0xf9, 0x2f, 0x0f,
+	0xd8, 0x15, 0x65, 0x0a, 0xe5, 0x4d, 0x6a, 0xd0, 0x75, 0x6f, 0xe5, 0xf6, 0x3a, 0xb9, 0x3b, 0x8d,
+	0x76, 0xfe, 0xd7, 0xa8, 0xd7, 0x6d, 0x64, 0x5f, 0x4c, 0x97, 0x1d, 0x34, 0x3d, 0xc9, 0x28, 0x6c,
+	0x40, 0x77, 0x3f, 0xfa, 0xff, 0xec, 0xc7, 0x72, 0x40, 0x9f, 0xd4, 0xf3, 0xbf, 0x03, 0x00, 0x0f,
+	0xc3, 0xab, 0x5f, 0x61, 0x05, 0x00, 0x00,
 }
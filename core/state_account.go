@@ -33,6 +33,8 @@ const (
 	RecordsPrefix      = "r_"  // records
 	CertReceivedPrefix = "cr_" // certs received
 	CertIssuedPrefix   = "ci_" // certs issued
+	IssuerPrefix       = "is_" // issuer registrations
+	ChainRulePrefix    = "ru_" // chain rules set at genesis, in the data of GenesisCoinbase
 )
 
 // Account default item in state
@@ -269,6 +271,131 @@ func (acc *Account) PutData(prefix string, key []byte, value []byte) error {
 	return acc.Data.Put(append([]byte(prefix), key...), value)
 }
 
+//GetRecord returns record in account's data trie
+func (acc *Account) GetRecord(recordHash []byte) (*corepb.Record, error) {
+	recordBytes, err := acc.GetData(RecordsPrefix, recordHash)
+	if err != nil {
+		return nil, err
+	}
+	pbRecord := new(corepb.Record)
+	if err := proto.Unmarshal(recordBytes, pbRecord); err != nil {
+		return nil, err
+	}
+	return pbRecord, nil
+}
+
+//RecordHistory returns every version of the record from the first to the latest
+func (acc *Account) RecordHistory(recordHash []byte) ([]*corepb.Record, error) {
+	record, err := acc.GetRecord(recordHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var prevs []*corepb.Record
+	for prev := record; len(prev.PrevHash) != 0; {
+		prev, err = acc.GetRecord(prev.PrevHash)
+		if err != nil {
+			return nil, err
+		}
+		prevs = append(prevs, prev)
+	}
+
+	history := make([]*corepb.Record, 0, len(prevs)+1)
+	for i := len(prevs) - 1; i >= 0; i-- {
+		history = append(history, prevs[i])
+	}
+	history = append(history, record)
+
+	for next := record; len(next.NextHash) != 0; {
+		next, err = acc.GetRecord(next.NextHash)
+		if err != nil {
+			return nil, err
+		}
+		history = append(history, next)
+	}
+	return history, nil
+}
+
+//Records returns every record in account's data trie
+func (acc *Account) Records() ([]*corepb.Record, error) {
+	values, err := acc.dataValues(RecordsPrefix)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*corepb.Record, 0, len(values))
+	for _, v := range values {
+		pbRecord := new(corepb.Record)
+		if err := proto.Unmarshal(v, pbRecord); err != nil {
+			return nil, err
+		}
+		records = append(records, pbRecord)
+	}
+	return records, nil
+}
+
+//GetCertification returns certification in account's data trie
+func (acc *Account) GetCertification(prefix string, certHash []byte) (*corepb.Certification, error) {
+	certBytes, err := acc.GetData(prefix, certHash)
+	if err != nil {
+		return nil, err
+	}
+	pbCert := new(corepb.Certification)
+	if err := proto.Unmarshal(certBytes, pbCert); err != nil {
+		return nil, err
+	}
+	return pbCert, nil
+}
+
+//Certifications returns every certification received or issued by the account according to prefix
+func (acc *Account) Certifications(prefix string) ([]*corepb.Certification, error) {
+	values, err := acc.dataValues(prefix)
+	if err != nil {
+		return nil, err
+	}
+	certs := make([]*corepb.Certification, 0, len(values))
+	for _, v := range values {
+		pbCert := new(corepb.Certification)
+		if err := proto.Unmarshal(v, pbCert); err != nil {
+			return nil, err
+		}
+		certs = append(certs, pbCert)
+	}
+	return certs, nil
+}
+
+func (acc *Account) dataValues(prefix string) ([][]byte, error) {
+	var values [][]byte
+	iter, err := acc.Data.Iterator([]byte(prefix))
+	if err != nil {
+		return nil, err
+	}
+	exist, err := iter.Next()
+	if err != nil {
+		return nil, err
+	}
+	for exist {
+		values = append(values, iter.Value())
+		exist, err = iter.Next()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+//GetIssuerRegistration returns issuer registration of the certification type
+func (acc *Account) GetIssuerRegistration(certType string) (*corepb.IssuerRegistration, error) {
+	regBytes, err := acc.GetData(IssuerPrefix, []byte(certType))
+	if err != nil {
+		return nil, err
+	}
+	pbReg := new(corepb.IssuerRegistration)
+	if err := proto.Unmarshal(regBytes, pbReg); err != nil {
+		return nil, err
+	}
+	return pbReg, nil
+}
+
 //UpdateBandwidth update bandwidth
 func (acc *Account) UpdateBandwidth(timestamp int64) error {
 	var err error
@@ -456,47 +583,41 @@ func (as *AccountState) PutTx(tx *Transaction) error {
 //accounts returns account slice
 func (as *AccountState) accounts() ([]*Account, error) {
 	var accounts []*Account
-	iter, err := as.Iterator(nil)
+	err := as.iterateAccounts(func(acc *Account) error {
+		accounts = append(accounts, acc)
+		return nil
+	})
 	if err != nil {
-		logging.Console().WithFields(logrus.Fields{
-			"err": err,
-		}).Error("Failed to get iterator of account trie.")
 		return nil, err
 	}
+	return accounts, nil
+}
 
-	exist, err := iter.Next()
+//iterateAccounts calls fn with every account in order of address
+func (as *AccountState) iterateAccounts(fn func(*Account) error) error {
+	iter, err := newAccountIterator(as)
 	if err != nil {
 		logging.Console().WithFields(logrus.Fields{
 			"err": err,
-		}).Error("Failed to iterate account trie.")
-		return nil, err
+		}).Error("Failed to get iterator of account trie.")
+		return err
 	}
 
-	for exist {
-		acc, err := newAccount(as.storage)
-		if err != nil {
-			return nil, err
-		}
-		accountBytes := iter.Value()
-
-		pbAccount := new(corepb.Account)
-		if err := proto.Unmarshal(accountBytes, pbAccount); err != nil {
-			return nil, err
-		}
-		if err := acc.fromProto(pbAccount); err != nil {
-			return nil, err
-		}
-
-		accounts = append(accounts, acc)
-		exist, err = iter.Next()
+	for {
+		acc, err := iter.next()
 		if err != nil {
 			logging.Console().WithFields(logrus.Fields{
 				"err": err,
 			}).Error("Failed to iterate account trie.")
-			return nil, err
+			return err
+		}
+		if acc == nil {
+			return nil
+		}
+		if err := fn(acc); err != nil {
+			return err
 		}
 	}
-	return accounts, nil
 }
 
 //KeyTrieToSlice generate slice from trie (slice of key)
@@ -0,0 +1,265 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package core
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/medibloc/go-medibloc/common/trie"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+)
+
+// AccountDump is a snapshot of an account for auditing. Amounts are decimal strings, and addresses, data keys and
+// data values are hex strings.
+type AccountDump struct {
+	Address         string            `json:"address"`
+	Balance         string            `json:"balance"`
+	Nonce           uint64            `json:"nonce"`
+	Vesting         string            `json:"vesting"`
+	Bandwidth       string            `json:"bandwidth"`
+	LastBandwidthTs int64             `json:"last_bandwidth_ts"`
+	Unstaking       string            `json:"unstaking"`
+	LastUnstakingTs int64             `json:"last_unstaking_ts"`
+	Collateral      string            `json:"collateral"`
+	VotePower       string            `json:"vote_power"`
+	Voted           []string          `json:"voted"`
+	Data            map[string]string `json:"data"`
+}
+
+// AccountDiff is the changed fields of an account between two account states.
+type AccountDiff struct {
+	Address string       `json:"address"`
+	Fields  []*FieldDiff `json:"fields"`
+}
+
+// FieldDiff is a changed field of an account. From or To is empty if the field does not exist in the state.
+type FieldDiff struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// NewAccountDump returns the dump of the account including its voted set and data trie entries.
+func NewAccountDump(acc *Account) (*AccountDump, error) {
+	data := make(map[string]string)
+	iter, err := acc.Data.Iterator(nil)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		exist, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		if !exist {
+			break
+		}
+		data[byteutils.Bytes2Hex(iter.Key())] = byteutils.Bytes2Hex(iter.Value())
+	}
+
+	voted := byteutils.BytesSlice2HexSlice(acc.VotedSlice())
+	if voted == nil {
+		voted = []string{}
+	}
+	return &AccountDump{
+		Address:         acc.Address.Hex(),
+		Balance:         acc.Balance.String(),
+		Nonce:           acc.Nonce,
+		Vesting:         acc.Vesting.String(),
+		Bandwidth:       acc.Bandwidth.String(),
+		LastBandwidthTs: acc.LastBandwidthTs,
+		Unstaking:       acc.Unstaking.String(),
+		LastUnstakingTs: acc.LastUnstakingTs,
+		Collateral:      acc.Collateral.String(),
+		VotePower:       acc.VotePower.String(),
+		Voted:           voted,
+		Data:            data,
+	}, nil
+}
+
+// fields returns the fields of the dump by name. Each data trie entry is a field named "data.{key}".
+func (d *AccountDump) fields() map[string]string {
+	if d == nil {
+		return nil
+	}
+	fields := map[string]string{
+		"balance":           d.Balance,
+		"nonce":             strconv.FormatUint(d.Nonce, 10),
+		"vesting":           d.Vesting,
+		"bandwidth":         d.Bandwidth,
+		"last_bandwidth_ts": strconv.FormatInt(d.LastBandwidthTs, 10),
+		"unstaking":         d.Unstaking,
+		"last_unstaking_ts": strconv.FormatInt(d.LastUnstakingTs, 10),
+		"collateral":        d.Collateral,
+		"vote_power":        d.VotePower,
+		"voted":             strings.Join(d.Voted, ";"),
+	}
+	for k, v := range d.Data {
+		fields["data."+k] = v
+	}
+	return fields
+}
+
+// DiffAccountDumps returns the changed fields from one dump to another in order of field name. A nil dump is an
+// account which does not exist.
+func DiffAccountDumps(from, to *AccountDump) []*FieldDiff {
+	fromFields, toFields := from.fields(), to.fields()
+	names := make(map[string]bool)
+	for name := range fromFields {
+		names[name] = true
+	}
+	for name := range toFields {
+		names[name] = true
+	}
+
+	var diffs []*FieldDiff
+	for name := range names {
+		f, okFrom := fromFields[name]
+		t, okTo := toFields[name]
+		if okFrom && okTo && f == t {
+			continue
+		}
+		diffs = append(diffs, &FieldDiff{
+			Field: name,
+			From:  f,
+			To:    t,
+		})
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Field < diffs[j].Field })
+	return diffs
+}
+
+// Dump calls fn with the dump of every account in order of address. It stops when fn returns an error.
+func (as *AccountState) Dump(fn func(*AccountDump) error) error {
+	return as.iterateAccounts(func(acc *Account) error {
+		dump, err := NewAccountDump(acc)
+		if err != nil {
+			return err
+		}
+		return fn(dump)
+	})
+}
+
+// DiffAccountStates calls fn with the changed fields of every account which differs between two account states in
+// order of address. It stops when fn returns an error.
+func DiffAccountStates(from, to *AccountState, fn func(*AccountDiff) error) error {
+	fromIter, err := newAccountIterator(from)
+	if err != nil {
+		return err
+	}
+	toIter, err := newAccountIterator(to)
+	if err != nil {
+		return err
+	}
+
+	fromAcc, err := fromIter.next()
+	if err != nil {
+		return err
+	}
+	toAcc, err := toIter.next()
+	if err != nil {
+		return err
+	}
+	for fromAcc != nil || toAcc != nil {
+		var f, t *Account
+		switch {
+		case toAcc == nil:
+			f = fromAcc
+		case fromAcc == nil:
+			t = toAcc
+		default:
+			cmp := bytes.Compare(fromAcc.Address.Bytes(), toAcc.Address.Bytes())
+			if cmp <= 0 {
+				f = fromAcc
+			}
+			if cmp >= 0 {
+				t = toAcc
+			}
+		}
+
+		var fromDump, toDump *AccountDump
+		addr := ""
+		if f != nil {
+			if fromDump, err = NewAccountDump(f); err != nil {
+				return err
+			}
+			addr = fromDump.Address
+			if fromAcc, err = fromIter.next(); err != nil {
+				return err
+			}
+		}
+		if t != nil {
+			if toDump, err = NewAccountDump(t); err != nil {
+				return err
+			}
+			addr = toDump.Address
+			if toAcc, err = toIter.next(); err != nil {
+				return err
+			}
+		}
+
+		diffs := DiffAccountDumps(fromDump, toDump)
+		if len(diffs) == 0 {
+			continue
+		}
+		if err := fn(&AccountDiff{Address: addr, Fields: diffs}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// accountIterator iterates accounts of an account state in order of address.
+type accountIterator struct {
+	as   *AccountState
+	iter *trie.Iterator
+}
+
+func newAccountIterator(as *AccountState) (*accountIterator, error) {
+	iter, err := as.Iterator(nil)
+	if err != nil {
+		return nil, err
+	}
+	return &accountIterator{
+		as:   as,
+		iter: iter,
+	}, nil
+}
+
+// next returns the next account. It returns nil if there is no more account.
+func (it *accountIterator) next() (*Account, error) {
+	exist, err := it.iter.Next()
+	if err != nil || !exist {
+		return nil, err
+	}
+	acc, err := newAccount(it.as.storage)
+	if err != nil {
+		return nil, err
+	}
+	pbAccount := new(corepb.Account)
+	if err := proto.Unmarshal(it.iter.Value(), pbAccount); err != nil {
+		return nil, err
+	}
+	if err := acc.fromProto(pbAccount); err != nil {
+		return nil, err
+	}
+	return acc, nil
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/util/testutil"
+	"github.com/medibloc/go-medibloc/util/testutil/blockutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountStateDumpAndDiff(t *testing.T) {
+	genesis := blockutil.New(t, testutil.DynastySize).Genesis()
+	from := genesis.TokenDist[0]
+	to := testutil.NewAddrKeyPair(t)
+
+	child := genesis.Child().
+		Tx().StakeTx(from, 100000000000000000).Execute().
+		Tx().Type(core.TxOpTransfer).Value(10).To(to.Addr).SignPair(from).Execute().
+		SignMiner().Build()
+	parentState := genesis.Build().State().AccState()
+	childState := child.State().AccState()
+
+	dumps := make(map[string]*core.AccountDump)
+	require.NoError(t, parentState.Dump(func(dump *core.AccountDump) error {
+		dumps[dump.Address] = dump
+		return nil
+	}))
+	for _, pair := range genesis.TokenDist {
+		acc, err := parentState.GetAccount(pair.Addr)
+		require.NoError(t, err)
+		require.Contains(t, dumps, pair.Addr.Hex())
+		assert.Equal(t, acc.Balance.String(), dumps[pair.Addr.Hex()].Balance)
+	}
+	assert.NotContains(t, dumps, to.Addr.Hex())
+
+	require.NoError(t, core.DiffAccountStates(childState, childState, func(diff *core.AccountDiff) error {
+		t.Errorf("unexpected diff of %s", diff.Address)
+		return nil
+	}))
+
+	diffs := make(map[string]map[string]*core.FieldDiff)
+	require.NoError(t, core.DiffAccountStates(parentState, childState, func(diff *core.AccountDiff) error {
+		fields := make(map[string]*core.FieldDiff)
+		for _, f := range diff.Fields {
+			fields[f.Field] = f
+		}
+		diffs[diff.Address] = fields
+		return nil
+	}))
+	require.Contains(t, diffs, to.Addr.Hex())
+	assert.Equal(t, &core.FieldDiff{Field: "balance", From: "", To: "10"}, diffs[to.Addr.Hex()]["balance"])
+	require.Contains(t, diffs, from.Addr.Hex())
+	assert.Equal(t, &core.FieldDiff{Field: "nonce", From: "0", To: "2"}, diffs[from.Addr.Hex()]["nonce"])
+	assert.Contains(t, diffs[from.Addr.Hex()], "vesting")
+}
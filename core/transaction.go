@@ -20,6 +20,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/common/merkle"
 	"github.com/medibloc/go-medibloc/core/pb"
 	"github.com/medibloc/go-medibloc/crypto"
 	"github.com/medibloc/go-medibloc/crypto/signature"
@@ -465,6 +466,100 @@ func (tx *TransferTx) Bandwidth() (*util.Uint128, error) {
 	return TxBaseBandwidth, nil
 }
 
+//BatchTransferTx is a structure for sending MED to multiple receivers
+type BatchTransferTx struct {
+	hash    []byte
+	from    common.Address
+	total   *util.Uint128
+	outputs []*TransferOutput
+}
+
+//NewBatchTransferTx returns BatchTransferTx
+func NewBatchTransferTx(tx *Transaction) (ExecutableTx, error) {
+	if len(tx.payload) > MaxBatchTransferPayloadSize {
+		return nil, ErrTooLargePayload
+	}
+	payload := new(BatchTransferPayload)
+	if err := BytesToTransactionPayload(tx.payload, payload); err != nil {
+		return nil, err
+	}
+	if len(payload.Outputs) == 0 {
+		return nil, ErrNoTransferOutputs
+	}
+	if len(payload.Outputs) > MaxBatchTransferOutputs {
+		return nil, ErrTooManyTransferOutputs
+	}
+
+	total := util.NewUint128()
+	for _, output := range payload.Outputs {
+		if output.Value.Cmp(util.Uint128Zero()) == 0 {
+			return nil, ErrVoidTransaction
+		}
+		sum, err := total.Add(output.Value)
+		if err != nil {
+			return nil, err
+		}
+		total = sum
+	}
+	// tx value should be the sum of all outputs
+	if tx.value.Cmp(total) != 0 {
+		return nil, ErrInvalidAmount
+	}
+
+	return &BatchTransferTx{
+		hash:    tx.Hash(),
+		from:    tx.From(),
+		total:   total,
+		outputs: payload.Outputs,
+	}, nil
+}
+
+//Execute BatchTransferTx
+func (tx *BatchTransferTx) Execute(b *Block) error {
+	// subtract total amount from sender's account at once
+	sender, err := b.state.GetAccount(tx.from)
+	if err != nil {
+		return err
+	}
+	sender.Balance, err = sender.Balance.Sub(tx.total)
+	if err == util.ErrUint128Underflow {
+		return ErrBalanceNotEnough
+	}
+	if err != nil {
+		return err
+	}
+	err = b.State().PutAccount(sender)
+	if err != nil {
+		return err
+	}
+
+	// add balance to each receiver's account
+	for _, output := range tx.outputs {
+		receiver, err := b.state.GetAccount(output.To)
+		if err != nil {
+			return err
+		}
+		receiver.Balance, err = receiver.Balance.Add(output.Value)
+		if err != nil {
+			return err
+		}
+		err = b.State().PutAccount(receiver)
+		if err != nil {
+			return err
+		}
+		err = b.state.accState.addTxsTo(output.To, tx.hash)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Bandwidth returns bandwidth proportional to the number of outputs.
+func (tx *BatchTransferTx) Bandwidth() (*util.Uint128, error) {
+	return TxBaseBandwidth.Mul(util.NewUint128FromUint(uint64(len(tx.outputs))))
+}
+
 //AddRecordTx is a structure for adding record
 type AddRecordTx struct {
 	owner      common.Address
@@ -542,6 +637,203 @@ func (tx *AddRecordTx) Bandwidth() (*util.Uint128, error) {
 	return TxBaseBandwidth, nil
 }
 
+//AmendRecordTx is a structure for amending record
+type AmendRecordTx struct {
+	owner          common.Address
+	timestamp      int64
+	prevRecordHash []byte
+	recordHash     []byte
+}
+
+//NewAmendRecordTx returns AmendRecordTx
+func NewAmendRecordTx(tx *Transaction) (ExecutableTx, error) {
+	if len(tx.payload) > MaxPayloadSize {
+		return nil, ErrTooLargePayload
+	}
+	payload := new(AmendRecordPayload)
+	if err := BytesToTransactionPayload(tx.payload, payload); err != nil {
+		return nil, err
+	}
+	if byteutils.Equal(payload.PrevRecordHash, payload.RecordHash) {
+		return nil, ErrRecordAlreadyAdded
+	}
+
+	return &AmendRecordTx{
+		owner:          tx.From(),
+		timestamp:      tx.Timestamp(),
+		prevRecordHash: payload.PrevRecordHash,
+		recordHash:     payload.RecordHash,
+	}, nil
+}
+
+//Execute AmendRecordTx
+func (tx *AmendRecordTx) Execute(b *Block) error {
+	var err error
+	acc, err := b.State().GetAccount(tx.owner)
+	if err != nil {
+		return err
+	}
+
+	prevRecord, err := acc.GetRecord(tx.prevRecordHash)
+	if err == ErrNotFound {
+		return ErrRecordNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if len(prevRecord.NextHash) != 0 {
+		return ErrRecordAlreadyAmended
+	}
+
+	_, err = acc.GetData(RecordsPrefix, tx.recordHash)
+	if err != nil && err != ErrNotFound {
+		return err
+	}
+	if err == nil {
+		return ErrRecordAlreadyAdded
+	}
+
+	prevRecord.NextHash = tx.recordHash
+	prevRecordBytes, err := proto.Marshal(prevRecord)
+	if err != nil {
+		return err
+	}
+	pbRecord := &corepb.Record{
+		Owner:      tx.owner.Bytes(),
+		RecordHash: tx.recordHash,
+		Timestamp:  tx.timestamp,
+		PrevHash:   tx.prevRecordHash,
+	}
+	recordBytes, err := proto.Marshal(pbRecord)
+	if err != nil {
+		return err
+	}
+	err = acc.Data.Prepare()
+	if err != nil {
+		return err
+	}
+	err = acc.Data.BeginBatch()
+	if err != nil {
+		return err
+	}
+	err = acc.PutData(RecordsPrefix, tx.prevRecordHash, prevRecordBytes)
+	if err != nil {
+		return err
+	}
+	err = acc.PutData(RecordsPrefix, tx.recordHash, recordBytes)
+	if err != nil {
+		return err
+	}
+	err = acc.Data.Commit()
+	if err != nil {
+		return err
+	}
+	err = acc.Data.Flush()
+	if err != nil {
+		return err
+	}
+	return b.State().PutAccount(acc)
+}
+
+//Bandwidth returns bandwidth.
+func (tx *AmendRecordTx) Bandwidth() (*util.Uint128, error) {
+	return TxBaseBandwidth, nil
+}
+
+//AddRecordBatchTx is a structure for anchoring merkle root of records
+type AddRecordBatchTx struct {
+	owner       common.Address
+	timestamp   int64
+	merkleRoot  []byte
+	recordCount uint32
+}
+
+//NewAddRecordBatchTx returns AddRecordBatchTx
+func NewAddRecordBatchTx(tx *Transaction) (ExecutableTx, error) {
+	if len(tx.payload) > MaxPayloadSize {
+		return nil, ErrTooLargePayload
+	}
+	payload := new(AddRecordBatchPayload)
+	if err := BytesToTransactionPayload(tx.payload, payload); err != nil {
+		return nil, err
+	}
+	if len(payload.MerkleRoot) != RecordBatchRootLength {
+		return nil, ErrInvalidMerkleRoot
+	}
+	if payload.RecordCount == 0 {
+		return nil, ErrNoRecordsInBatch
+	}
+
+	return &AddRecordBatchTx{
+		owner:       tx.From(),
+		timestamp:   tx.Timestamp(),
+		merkleRoot:  payload.MerkleRoot,
+		recordCount: payload.RecordCount,
+	}, nil
+}
+
+//Execute AddRecordBatchTx
+func (tx *AddRecordBatchTx) Execute(b *Block) error {
+	var err error
+	acc, err := b.State().GetAccount(tx.owner)
+	if err != nil {
+		return err
+	}
+
+	_, err = acc.GetData(RecordsPrefix, tx.merkleRoot)
+	if err != nil && err != ErrNotFound {
+		return err
+	}
+	if err == nil {
+		return ErrRecordAlreadyAdded
+	}
+
+	pbRecord := &corepb.Record{
+		Owner:       tx.owner.Bytes(),
+		RecordHash:  tx.merkleRoot,
+		Timestamp:   tx.timestamp,
+		RecordCount: tx.recordCount,
+	}
+	recordBytes, err := proto.Marshal(pbRecord)
+	if err != nil {
+		return err
+	}
+	err = acc.Data.Prepare()
+	if err != nil {
+		return err
+	}
+	err = acc.Data.BeginBatch()
+	if err != nil {
+		return err
+	}
+	err = acc.PutData(RecordsPrefix, tx.merkleRoot, recordBytes)
+	if err != nil {
+		return err
+	}
+	err = acc.Data.Commit()
+	if err != nil {
+		return err
+	}
+	err = acc.Data.Flush()
+	if err != nil {
+		return err
+	}
+	return b.State().PutAccount(acc)
+}
+
+//Bandwidth returns bandwidth.
+func (tx *AddRecordBatchTx) Bandwidth() (*util.Uint128, error) {
+	return TxBaseBandwidth, nil
+}
+
+//VerifyRecordInBatch checks that recordHash is the index-th leaf of the anchored record batch.
+func VerifyRecordInBatch(record *corepb.Record, recordHash []byte, index uint64, path [][]byte) error {
+	if record.RecordCount == 0 {
+		return ErrNoRecordsInBatch
+	}
+	return merkle.Verify(recordHash, index, uint64(record.RecordCount), path, record.RecordHash)
+}
+
 //VestTx is a structure for withdrawing vesting
 type VestTx struct {
 	user   common.Address
@@ -700,6 +992,108 @@ func (tx *WithdrawVestingTx) Bandwidth() (*util.Uint128, error) {
 	return TxBaseBandwidth, nil
 }
 
+//RegisterIssuerTx is a structure for registering issuer of a certification type
+type RegisterIssuerTx struct {
+	Registrar common.Address
+	Issuer    common.Address
+
+	CertType  string
+	SchemaID  string
+	Timestamp int64
+}
+
+//NewRegisterIssuerTx returns RegisterIssuerTx
+func NewRegisterIssuerTx(tx *Transaction) (ExecutableTx, error) {
+	if len(tx.payload) > MaxPayloadSize {
+		return nil, ErrTooLargePayload
+	}
+	payload := new(RegisterIssuerPayload)
+	if err := BytesToTransactionPayload(tx.payload, payload); err != nil {
+		return nil, err
+	}
+	if len(payload.CertType) == 0 || len(payload.CertType) > MaxCertTypeLength {
+		return nil, ErrInvalidCertType
+	}
+	if len(payload.SchemaID) == 0 || len(payload.SchemaID) > MaxSchemaIDLength {
+		return nil, ErrInvalidSchemaID
+	}
+
+	// Issuer registers itself if receiver is not specified.
+	issuer := tx.To()
+	if issuer.Equals(common.Address{}) {
+		issuer = tx.From()
+	}
+
+	return &RegisterIssuerTx{
+		Registrar: tx.From(),
+		Issuer:    issuer,
+		CertType:  payload.CertType,
+		SchemaID:  payload.SchemaID,
+		Timestamp: tx.Timestamp(),
+	}, nil
+}
+
+//Execute RegisterIssuerTx
+func (tx *RegisterIssuerTx) Execute(b *Block) error {
+	governance, err := b.State().DposState().InDynasty(tx.Registrar)
+	if err != nil {
+		return err
+	}
+	if !governance && !tx.Registrar.Equals(tx.Issuer) {
+		return ErrNotGovernance
+	}
+
+	issuer, err := b.State().GetAccount(tx.Issuer)
+	if err != nil {
+		return err
+	}
+	_, err = issuer.GetIssuerRegistration(tx.CertType)
+	if err != nil && err != ErrNotFound {
+		return err
+	}
+	// Only dynasty members can overwrite existing registration.
+	if err == nil && !governance {
+		return ErrIssuerAlreadyRegistered
+	}
+
+	pbReg := &corepb.IssuerRegistration{
+		Issuer:    tx.Issuer.Bytes(),
+		CertType:  tx.CertType,
+		SchemaId:  tx.SchemaID,
+		Registrar: tx.Registrar.Bytes(),
+		Timestamp: tx.Timestamp,
+	}
+	regBytes, err := proto.Marshal(pbReg)
+	if err != nil {
+		return err
+	}
+
+	if err := issuer.Data.Prepare(); err != nil {
+		return err
+	}
+	if err := issuer.Data.BeginBatch(); err != nil {
+		return err
+	}
+	if err := issuer.PutData(IssuerPrefix, []byte(tx.CertType), regBytes); err != nil {
+		if err := issuer.Data.RollBack(); err != nil {
+			return err
+		}
+		return err
+	}
+	if err := issuer.Data.Commit(); err != nil {
+		return err
+	}
+	if err := issuer.Data.Flush(); err != nil {
+		return err
+	}
+	return b.State().PutAccount(issuer)
+}
+
+//Bandwidth returns bandwidth.
+func (tx *RegisterIssuerTx) Bandwidth() (*util.Uint128, error) {
+	return TxBaseBandwidth, nil
+}
+
 //AddCertificationTx is a structure for adding certification
 type AddCertificationTx struct {
 	Issuer    common.Address
@@ -708,6 +1102,7 @@ type AddCertificationTx struct {
 	CertificateHash []byte
 	IssueTime       int64
 	ExpirationTime  int64
+	CertType        string
 }
 
 //NewAddCertificationTx returns AddCertificationTx
@@ -726,6 +1121,7 @@ func NewAddCertificationTx(tx *Transaction) (ExecutableTx, error) {
 		CertificateHash: payload.CertificateHash,
 		IssueTime:       payload.IssueTime,
 		ExpirationTime:  payload.ExpirationTime,
+		CertType:        payload.CertType,
 	}, nil
 }
 
@@ -755,7 +1151,28 @@ func (tx *AddCertificationTx) Execute(b *Block) error {
 		return ErrCertIssuedAlreadyAdded
 	}
 
-	//TODO: certification payload Verify: drsleepytiger
+	// Typed certification should be issued by registered issuer. Untyped certification, which predates the
+	// registry, is not verified by it and is rejected if the genesis requires typed certifications.
+	if len(tx.CertType) == 0 {
+		required, err := b.State().TypedCertificationRequired()
+		if err != nil {
+			return err
+		}
+		if required {
+			return ErrUntypedCertification
+		}
+	}
+	var schemaID string
+	if len(tx.CertType) != 0 {
+		reg, err := issuer.GetIssuerRegistration(tx.CertType)
+		if err == ErrNotFound {
+			return ErrIssuerNotRegistered
+		}
+		if err != nil {
+			return err
+		}
+		schemaID = reg.SchemaId
+	}
 
 	pbCertification := &corepb.Certification{
 		CertificateHash: tx.CertificateHash,
@@ -764,6 +1181,8 @@ func (tx *AddCertificationTx) Execute(b *Block) error {
 		IssueTime:       tx.IssueTime,
 		ExpirationTime:  tx.ExpirationTime,
 		RevocationTime:  int64(-1),
+		CertType:        tx.CertType,
+		SchemaId:        schemaID,
 	}
 	certificationBytes, err := proto.Marshal(pbCertification)
 	if err != nil {
@@ -851,6 +1270,12 @@ func NewRevokeCertificationTx(tx *Transaction) (ExecutableTx, error) {
 	}, nil
 }
 
+// IsCertificationExpired returns whether the certification is expired at the timestamp. A certification whose
+// expiration time is 0 never expires.
+func IsCertificationExpired(cert *corepb.Certification, ts int64) bool {
+	return cert.ExpirationTime != 0 && cert.ExpirationTime < ts
+}
+
 //Execute RevokeCertificationTx
 func (tx *RevokeCertificationTx) Execute(b *Block) error {
 	issuer, err := b.State().GetAccount(tx.Revoker)
@@ -874,7 +1299,7 @@ func (tx *RevokeCertificationTx) Execute(b *Block) error {
 	if pbCert.RevocationTime > int64(-1) {
 		return ErrCertAlreadyRevoked
 	}
-	if pbCert.ExpirationTime < tx.RevocationTime {
+	if IsCertificationExpired(pbCert, tx.RevocationTime) {
 		return ErrCertAlreadyExpired
 	}
 
@@ -0,0 +1,94 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package core
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+)
+
+const txJournalKey = "pending_tx_journal"
+
+// ReadTxJournal returns transactions in the pending journal of the storage.
+func ReadTxJournal(stor storage.Storage) ([]*Transaction, error) {
+	v, err := stor.Get([]byte(txJournalKey))
+	if err == storage.ErrKeyNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	pbJournal := new(corepb.TransactionJournal)
+	if err := proto.Unmarshal(v, pbJournal); err != nil {
+		return nil, err
+	}
+	txs := make([]*Transaction, 0, len(pbJournal.Transactions))
+	for _, pbTx := range pbJournal.Transactions {
+		tx := new(Transaction)
+		if err := tx.FromProto(pbTx); err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
+
+// appendTxJournal adds the pending journal with the transactions appended to the batch. Transactions already in the
+// journal are skipped, so journaling the same transactions again does not duplicate them.
+func appendTxJournal(stor storage.Storage, batch *storage.WriteBatch, txs []*Transaction) error {
+	journal, err := ReadTxJournal(stor)
+	if err != nil {
+		return err
+	}
+	journaled := make(map[string]bool)
+	for _, tx := range journal {
+		journaled[byteutils.Bytes2Hex(tx.Hash())] = true
+	}
+	for _, tx := range txs {
+		if journaled[byteutils.Bytes2Hex(tx.Hash())] {
+			continue
+		}
+		journaled[byteutils.Bytes2Hex(tx.Hash())] = true
+		journal = append(journal, tx)
+	}
+
+	pbJournal := &corepb.TransactionJournal{
+		Transactions: make([]*corepb.Transaction, 0, len(journal)),
+	}
+	for _, tx := range journal {
+		pbTx, err := tx.ToProto()
+		if err != nil {
+			return err
+		}
+		pbJournal.Transactions = append(pbJournal.Transactions, pbTx.(*corepb.Transaction))
+	}
+	v, err := proto.Marshal(pbJournal)
+	if err != nil {
+		return err
+	}
+	batch.Put([]byte(txJournalKey), v)
+	return nil
+}
+
+func clearTxJournal(stor storage.Storage) error {
+	err := stor.Delete([]byte(txJournalKey))
+	if err == storage.ErrKeyNotFound {
+		return nil
+	}
+	return err
+}
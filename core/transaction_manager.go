@@ -23,6 +23,7 @@ import (
 	"github.com/medibloc/go-medibloc/core/pb"
 	"github.com/medibloc/go-medibloc/medlet/pb"
 	"github.com/medibloc/go-medibloc/net"
+	"github.com/medibloc/go-medibloc/storage"
 	"github.com/medibloc/go-medibloc/util/logging"
 	"github.com/sirupsen/logrus"
 )
@@ -102,6 +103,26 @@ func (mgr *TransactionManager) Push(tx *Transaction) error {
 	return nil
 }
 
+// RestoreJournal pushes transactions in the pending journal of the storage, which are reverted by rollback, and
+// clears the journal. Transactions failed to push are dropped. It returns the number of pushed transactions.
+func (mgr *TransactionManager) RestoreJournal(stor storage.Storage) (int, error) {
+	txs, err := ReadTxJournal(stor)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, tx := range txs {
+		if err := mgr.Push(tx); err != nil {
+			continue
+		}
+		n++
+	}
+	if err := clearTxJournal(stor); err != nil {
+		return n, err
+	}
+	return n, nil
+}
+
 // Pop pop transaction from TransactionManager.
 func (mgr *TransactionManager) Pop() *Transaction {
 	return mgr.pool.Pop()
@@ -17,7 +17,9 @@ package core
 
 import (
 	"github.com/gogo/protobuf/proto"
+	"github.com/medibloc/go-medibloc/common"
 	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/util"
 	"github.com/medibloc/go-medibloc/util/byteutils"
 )
 
@@ -44,11 +46,64 @@ func (payload *AddRecordPayload) ToBytes() ([]byte, error) {
 	return proto.Marshal(payloadPb)
 }
 
+// AmendRecordPayload is payload type for TxOpAmendRecord
+type AmendRecordPayload struct {
+	PrevRecordHash []byte
+	RecordHash     []byte
+}
+
+// FromBytes converts bytes to payload.
+func (payload *AmendRecordPayload) FromBytes(b []byte) error {
+	payloadPb := &corepb.AmendRecordPayload{}
+	if err := proto.Unmarshal(b, payloadPb); err != nil {
+		return err
+	}
+	payload.PrevRecordHash = payloadPb.PrevHash
+	payload.RecordHash = payloadPb.Hash
+	return nil
+}
+
+// ToBytes returns marshaled AmendRecordPayload
+func (payload *AmendRecordPayload) ToBytes() ([]byte, error) {
+	payloadPb := &corepb.AmendRecordPayload{
+		PrevHash: payload.PrevRecordHash,
+		Hash:     payload.RecordHash,
+	}
+	return proto.Marshal(payloadPb)
+}
+
+// AddRecordBatchPayload is payload type for TxOpAddRecordBatch
+type AddRecordBatchPayload struct {
+	MerkleRoot  []byte
+	RecordCount uint32
+}
+
+// FromBytes converts bytes to payload.
+func (payload *AddRecordBatchPayload) FromBytes(b []byte) error {
+	payloadPb := &corepb.AddRecordBatchPayload{}
+	if err := proto.Unmarshal(b, payloadPb); err != nil {
+		return err
+	}
+	payload.MerkleRoot = payloadPb.MerkleRoot
+	payload.RecordCount = payloadPb.RecordCount
+	return nil
+}
+
+// ToBytes returns marshaled AddRecordBatchPayload
+func (payload *AddRecordBatchPayload) ToBytes() ([]byte, error) {
+	payloadPb := &corepb.AddRecordBatchPayload{
+		MerkleRoot:  payload.MerkleRoot,
+		RecordCount: payload.RecordCount,
+	}
+	return proto.Marshal(payloadPb)
+}
+
 // AddCertificationPayload is payload type for AddCertificationTx
 type AddCertificationPayload struct {
 	IssueTime       int64
 	ExpirationTime  int64
 	CertificateHash []byte
+	CertType        string
 }
 
 // FromBytes converts bytes to payload.
@@ -60,6 +115,7 @@ func (payload *AddCertificationPayload) FromBytes(b []byte) error {
 	payload.IssueTime = payloadPb.IssueTime
 	payload.ExpirationTime = payloadPb.ExpirationTime
 	payload.CertificateHash = payloadPb.Hash
+	payload.CertType = payloadPb.CertType
 	return nil
 }
 
@@ -69,6 +125,33 @@ func (payload *AddCertificationPayload) ToBytes() ([]byte, error) {
 		IssueTime:      payload.IssueTime,
 		ExpirationTime: payload.ExpirationTime,
 		Hash:           payload.CertificateHash,
+		CertType:       payload.CertType,
+	}
+	return proto.Marshal(payloadPb)
+}
+
+// RegisterIssuerPayload is payload type for RegisterIssuerTx
+type RegisterIssuerPayload struct {
+	CertType string
+	SchemaID string
+}
+
+// FromBytes converts bytes to payload.
+func (payload *RegisterIssuerPayload) FromBytes(b []byte) error {
+	payloadPb := &corepb.RegisterIssuerPayload{}
+	if err := proto.Unmarshal(b, payloadPb); err != nil {
+		return err
+	}
+	payload.CertType = payloadPb.CertType
+	payload.SchemaID = payloadPb.SchemaId
+	return nil
+}
+
+// ToBytes returns marshaled RegisterIssuerPayload
+func (payload *RegisterIssuerPayload) ToBytes() ([]byte, error) {
+	payloadPb := &corepb.RegisterIssuerPayload{
+		CertType: payload.CertType,
+		SchemaId: payload.SchemaID,
 	}
 	return proto.Marshal(payloadPb)
 }
@@ -96,6 +179,57 @@ func (payload *RevokeCertificationPayload) ToBytes() ([]byte, error) {
 	return proto.Marshal(payloadPb)
 }
 
+// TransferOutput is a single recipient and amount of BatchTransferPayload
+type TransferOutput struct {
+	To    common.Address
+	Value *util.Uint128
+}
+
+// BatchTransferPayload is payload type for BatchTransferTx
+type BatchTransferPayload struct {
+	Outputs []*TransferOutput
+}
+
+// FromBytes converts bytes to payload.
+func (payload *BatchTransferPayload) FromBytes(b []byte) error {
+	payloadPb := &corepb.BatchTransferPayload{}
+	if err := proto.Unmarshal(b, payloadPb); err != nil {
+		return err
+	}
+	outputs := make([]*TransferOutput, 0, len(payloadPb.Outputs))
+	for _, output := range payloadPb.Outputs {
+		value, err := util.NewUint128FromFixedSizeByteSlice(output.Value)
+		if err != nil {
+			return err
+		}
+		outputs = append(outputs, &TransferOutput{
+			To:    common.BytesToAddress(output.To),
+			Value: value,
+		})
+	}
+	payload.Outputs = outputs
+	return nil
+}
+
+// ToBytes returns marshaled BatchTransferPayload
+func (payload *BatchTransferPayload) ToBytes() ([]byte, error) {
+	outputs := make([]*corepb.TransferOutput, 0, len(payload.Outputs))
+	for _, output := range payload.Outputs {
+		value, err := output.Value.ToFixedSizeByteSlice()
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, &corepb.TransferOutput{
+			To:    output.To.Bytes(),
+			Value: value,
+		})
+	}
+	payloadPb := &corepb.BatchTransferPayload{
+		Outputs: outputs,
+	}
+	return proto.Marshal(payloadPb)
+}
+
 // DefaultPayload is payload type for any type of message
 type DefaultPayload struct {
 	Message string
@@ -126,8 +126,9 @@ func (pool *TransactionPool) Push(tx *Transaction) error {
 
 	if pool.eventEmitter != nil {
 		event := &Event{
-			Topic: TopicPendingTransaction,
-			Data:  byteutils.Bytes2Hex(tx.Hash()),
+			Topic:       TopicPendingTransaction,
+			Data:        byteutils.Bytes2Hex(tx.Hash()),
+			Transaction: tx,
 		}
 		pool.eventEmitter.Trigger(event)
 	}
@@ -173,6 +174,7 @@ func (pool *TransactionPool) push(tx *Transaction) error {
 	pool.buckets.Set(from, bkt)
 
 	pool.replaceCandidate(bkt, from)
+	metricsTxPoolSize.Update(int64(len(pool.all)))
 
 	return nil
 }
@@ -202,6 +204,7 @@ func (pool *TransactionPool) del(tx *Transaction) {
 	}
 
 	pool.replaceCandidate(bkt, from)
+	metricsTxPoolSize.Update(int64(len(pool.all)))
 }
 
 func (pool *TransactionPool) replaceCandidate(bkt *bucket, addr string) {
@@ -238,6 +241,7 @@ func (pool *TransactionPool) evict() {
 	}
 
 	pool.del(tx)
+	metricsTxPoolEvicted.Mark(1)
 }
 
 type ordered struct {
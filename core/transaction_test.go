@@ -16,12 +16,16 @@
 package core_test
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/medibloc/go-medibloc/common/merkle"
 	"github.com/medibloc/go-medibloc/core"
 	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/util"
 	"github.com/medibloc/go-medibloc/util/byteutils"
 	"github.com/medibloc/go-medibloc/util/testutil"
 	"github.com/medibloc/go-medibloc/util/testutil/blockutil"
@@ -46,6 +50,80 @@ func TestSend(t *testing.T) {
 
 }
 
+func TestBatchTransfer(t *testing.T) {
+	bb := blockutil.New(t, testutil.DynastySize).Genesis().Child()
+
+	from := bb.TokenDist[0]
+	to1 := testutil.NewAddrKeyPair(t)
+	to2 := testutil.NewAddrKeyPair(t)
+
+	payload := &core.BatchTransferPayload{
+		Outputs: []*core.TransferOutput{
+			{To: to1.Addr, Value: util.NewUint128FromUint(10)},
+			{To: to2.Addr, Value: util.NewUint128FromUint(20)},
+		},
+	}
+	overPayload := &core.BatchTransferPayload{
+		Outputs: []*core.TransferOutput{
+			{To: to1.Addr, Value: util.NewUint128FromUint(10)},
+			{To: to2.Addr, Value: util.NewUint128FromUint(1000000000000000000)},
+		},
+	}
+
+	bb = bb.Tx().StakeTx(from, 10000000000000000).Execute()
+	acc, err := bb.B.State().GetAccount(from.Addr)
+	require.NoError(t, err)
+	bandwidth := acc.Bandwidth.Uint64()
+
+	bb = bb.
+		Tx().Type(core.TxOpBatchTransfer).Value(30).Payload(&core.BatchTransferPayload{}).SignPair(from).ExecuteErr(core.ErrNoTransferOutputs).
+		Tx().Type(core.TxOpBatchTransfer).Value(40).Payload(payload).SignPair(from).ExecuteErr(core.ErrInvalidAmount).
+		Tx().Type(core.TxOpBatchTransfer).Value(1000000000000000010).Payload(overPayload).SignPair(from).ExecuteErr(core.ErrBalanceNotEnough).
+		Tx().Type(core.TxOpBatchTransfer).Value(30).Payload(payload).SignPair(from).Execute()
+
+	bb.Expect().
+		Balance(to1.Addr, 10).
+		Balance(to2.Addr, 20).
+		Balance(from.Addr, 1000000000000000000-30-10000000000000000).
+		Bandwidth(from.Addr, bandwidth+2*core.TxBaseBandwidth.Uint64())
+
+	acc, err = bb.B.State().GetAccount(to2.Addr)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(acc.TxsToSlice()))
+}
+
+func TestBatchTransferLimits(t *testing.T) {
+	bb := blockutil.New(t, testutil.DynastySize).Genesis().Child()
+	from := bb.TokenDist[0]
+
+	outputs := func(n int) *core.BatchTransferPayload {
+		payload := &core.BatchTransferPayload{}
+		for i := 0; i < n; i++ {
+			payload.Outputs = append(payload.Outputs, &core.TransferOutput{
+				To:    testutil.NewAddrKeyPair(t).Addr,
+				Value: util.NewUint128FromUint(1),
+			})
+		}
+		return payload
+	}
+	full := outputs(core.MaxBatchTransferOutputs)
+	b, err := full.ToBytes()
+	require.NoError(t, err)
+	assert.Equal(t, core.MaxBatchTransferPayloadSize, len(b))
+
+	tooLarge := &core.DefaultPayload{Message: strings.Repeat("a", core.MaxBatchTransferPayloadSize)}
+	b, err = tooLarge.ToBytes()
+	require.NoError(t, err)
+	assert.True(t, len(b) > core.MaxBatchTransferPayloadSize)
+
+	bb.
+		Tx().StakeTx(from, 100000000000000000).Execute().
+		Tx().Type(core.TxOpBatchTransfer).Value(1).Payload(tooLarge).SignPair(from).ExecuteErr(core.ErrTooLargePayload).
+		Tx().Type(core.TxOpBatchTransfer).Value(core.MaxBatchTransferOutputs + 1).
+		Payload(outputs(core.MaxBatchTransferOutputs + 1)).SignPair(from).ExecuteErr(core.ErrTooManyTransferOutputs).
+		Tx().Type(core.TxOpBatchTransfer).Value(core.MaxBatchTransferOutputs).Payload(full).SignPair(from).Execute()
+}
+
 func TestAddRecord(t *testing.T) {
 	bb := blockutil.New(t, testutil.DynastySize).Genesis()
 
@@ -69,6 +147,89 @@ func TestAddRecord(t *testing.T) {
 	assert.Equal(t, recordHash, pbRecord.RecordHash)
 }
 
+func TestAmendRecord(t *testing.T) {
+	bb := blockutil.New(t, testutil.DynastySize).Genesis()
+
+	hash0 := byteutils.Hex2Bytes("03e7b794e1de1851b52ab0b0b995cc87558963265a7b26630f26ea8bb9131a7e")
+	hash1 := byteutils.Hex2Bytes("13e7b794e1de1851b52ab0b0b995cc87558963265a7b26630f26ea8bb9131a7e")
+	hash2 := byteutils.Hex2Bytes("23e7b794e1de1851b52ab0b0b995cc87558963265a7b26630f26ea8bb9131a7e")
+	owner := bb.TokenDist[0]
+	other := bb.TokenDist[1]
+
+	block := bb.
+		Tx().StakeTx(owner, 10000000000000000).Execute().
+		Tx().StakeTx(other, 10000000000000000).Execute().
+		Tx().Type(core.TxOpAddRecord).Payload(&core.AddRecordPayload{RecordHash: hash0}).SignPair(owner).Execute().
+		Tx().Type(core.TxOpAmendRecord).Payload(&core.AmendRecordPayload{PrevRecordHash: hash0, RecordHash: hash1}).SignPair(other).ExecuteErr(core.ErrRecordNotFound).
+		Tx().Type(core.TxOpAmendRecord).Payload(&core.AmendRecordPayload{PrevRecordHash: hash0, RecordHash: hash0}).SignPair(owner).ExecuteErr(core.ErrRecordAlreadyAdded).
+		Tx().Type(core.TxOpAmendRecord).Payload(&core.AmendRecordPayload{PrevRecordHash: hash0, RecordHash: hash1}).SignPair(owner).Execute().
+		Tx().Type(core.TxOpAmendRecord).Payload(&core.AmendRecordPayload{PrevRecordHash: hash0, RecordHash: hash2}).SignPair(owner).ExecuteErr(core.ErrRecordAlreadyAmended).
+		Tx().Type(core.TxOpAmendRecord).Payload(&core.AmendRecordPayload{PrevRecordHash: hash1, RecordHash: hash2}).SignPair(owner).Execute().
+		Build()
+
+	acc, err := block.State().GetAccount(owner.Addr)
+	require.NoError(t, err)
+
+	for _, recordHash := range [][]byte{hash0, hash1, hash2} {
+		history, err := acc.RecordHistory(recordHash)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(history))
+		assert.Equal(t, hash0, history[0].RecordHash)
+		assert.Equal(t, hash1, history[1].RecordHash)
+		assert.Equal(t, hash2, history[2].RecordHash)
+	}
+
+	record, err := acc.GetRecord(hash1)
+	require.NoError(t, err)
+	assert.Equal(t, hash0, record.PrevHash)
+	assert.Equal(t, hash2, record.NextHash)
+
+	records, err := acc.Records()
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(records))
+}
+
+func TestAddRecordBatch(t *testing.T) {
+	bb := blockutil.New(t, testutil.DynastySize).Genesis()
+
+	recordHashes := make([][]byte, 0, 5)
+	for i := 0; i < 5; i++ {
+		recordHashes = append(recordHashes, bytes.Repeat([]byte{byte(i)}, 32))
+	}
+	root, err := merkle.Root(recordHashes)
+	require.NoError(t, err)
+	payload := &core.AddRecordBatchPayload{MerkleRoot: root, RecordCount: uint32(len(recordHashes))}
+	owner := bb.TokenDist[0]
+
+	block := bb.
+		Tx().StakeTx(owner, 10000000000000000).Execute().
+		Tx().Type(core.TxOpAddRecordBatch).Payload(&core.AddRecordBatchPayload{MerkleRoot: root}).SignPair(owner).ExecuteErr(core.ErrNoRecordsInBatch).
+		Tx().Type(core.TxOpAddRecordBatch).Payload(&core.AddRecordBatchPayload{MerkleRoot: root[:16], RecordCount: 5}).SignPair(owner).ExecuteErr(core.ErrInvalidMerkleRoot).
+		Tx().Type(core.TxOpAddRecordBatch).Payload(payload).SignPair(owner).Execute().
+		Tx().Type(core.TxOpAddRecordBatch).Payload(payload).SignPair(owner).ExecuteErr(core.ErrRecordAlreadyAdded).
+		Build()
+
+	acc, err := block.State().GetAccount(owner.Addr)
+	require.NoError(t, err)
+
+	recordBytes, err := acc.GetData(core.RecordsPrefix, root)
+	require.NoError(t, err)
+
+	pbRecord := new(corepb.Record)
+	require.NoError(t, proto.Unmarshal(recordBytes, pbRecord))
+	assert.Equal(t, root, pbRecord.RecordHash)
+	assert.Equal(t, uint32(len(recordHashes)), pbRecord.RecordCount)
+
+	for i, recordHash := range recordHashes {
+		path, err := merkle.Proof(recordHashes, i)
+		require.NoError(t, err)
+		assert.NoError(t, core.VerifyRecordInBatch(pbRecord, recordHash, uint64(i), path))
+	}
+	path, err := merkle.Proof(recordHashes, 0)
+	require.NoError(t, err)
+	assert.Error(t, core.VerifyRecordInBatch(pbRecord, recordHashes[1], 0, path))
+}
+
 func TestVestAndWithdraw(t *testing.T) {
 	bb := blockutil.New(t, testutil.DynastySize).Genesis().Child()
 
@@ -178,6 +339,140 @@ func TestAddAndRevokeCertification(t *testing.T) {
 
 }
 
+func TestRevokeCertificationWithoutExpiration(t *testing.T) {
+	bb := blockutil.New(t, testutil.DynastySize).Genesis().Child()
+
+	issuer := bb.TokenDist[0]
+	certified := bb.TokenDist[1]
+
+	hash := byteutils.Hex2Bytes("22e7b794e1de1851b52ab0b0b995cc87558963265a7b26630f26ea8bb9131a7e")
+	addPayload := &core.AddCertificationPayload{
+		IssueTime:       time.Now().Unix(),
+		ExpirationTime:  0,
+		CertificateHash: hash,
+	}
+	revokeTime := time.Now().Unix() + int64(50000)
+	revokePayload := &core.RevokeCertificationPayload{CertificateHash: hash}
+
+	block := bb.Stake().
+		Tx().Type(core.TxOpAddCertification).To(certified.Addr).Payload(addPayload).CalcHash().SignPair(issuer).Execute().
+		Tx().Type(core.TxOpRevokeCertification).Payload(revokePayload).Timestamp(revokeTime).SignPair(issuer).Execute().
+		Build()
+
+	issuerAcc, err := block.State().GetAccount(issuer.Addr)
+	require.NoError(t, err)
+	certBytes, err := issuerAcc.GetData(core.CertIssuedPrefix, hash)
+	require.NoError(t, err)
+	pbCert := new(corepb.Certification)
+	require.NoError(t, proto.Unmarshal(certBytes, pbCert))
+
+	assert.Equal(t, int64(0), pbCert.ExpirationTime)
+	assert.Equal(t, revokeTime, pbCert.RevocationTime)
+}
+
+func TestRegisterIssuerAndAddTypedCertification(t *testing.T) {
+	bb := blockutil.New(t, testutil.DynastySize).Genesis()
+
+	governance := bb.Dynasties[0]
+	selfIssuer := bb.TokenDist[testutil.DynastySize]
+	issuer := bb.TokenDist[testutil.DynastySize+1]
+	certified := bb.TokenDist[testutil.DynastySize+2]
+
+	vaccination := &core.RegisterIssuerPayload{CertType: "vaccination", SchemaID: "med:vaccination:v1"}
+	license := &core.RegisterIssuerPayload{CertType: "license", SchemaID: "med:license:v1"}
+	hash0 := byteutils.Hex2Bytes("02e7b794e1de1851b52ab0b0b995cc87558963265a7b26630f26ea8bb9131a7e")
+	hash1 := byteutils.Hex2Bytes("12e7b794e1de1851b52ab0b0b995cc87558963265a7b26630f26ea8bb9131a7e")
+
+	block := bb.
+		Tx().StakeTx(governance, 10000000000000000).Execute().
+		Tx().StakeTx(selfIssuer, 10000000000000000).Execute().
+		Tx().StakeTx(issuer, 10000000000000000).Execute().
+		Tx().Type(core.TxOpRegisterIssuer).Payload(&core.RegisterIssuerPayload{CertType: "vaccination"}).SignPair(selfIssuer).ExecuteErr(core.ErrInvalidSchemaID).
+		Tx().Type(core.TxOpRegisterIssuer).Payload(vaccination).SignPair(selfIssuer).Execute().
+		Tx().Type(core.TxOpRegisterIssuer).Payload(vaccination).SignPair(selfIssuer).ExecuteErr(core.ErrIssuerAlreadyRegistered).
+		Tx().Type(core.TxOpRegisterIssuer).To(issuer.Addr).Payload(license).SignPair(selfIssuer).ExecuteErr(core.ErrNotGovernance).
+		Tx().Type(core.TxOpRegisterIssuer).To(issuer.Addr).Payload(license).SignPair(governance).Execute().
+		Tx().Type(core.TxOpAddCertification).To(certified.Addr).
+		Payload(&core.AddCertificationPayload{CertificateHash: hash0, CertType: "license"}).SignPair(selfIssuer).
+		ExecuteErr(core.ErrIssuerNotRegistered).
+		Tx().Type(core.TxOpAddCertification).To(certified.Addr).
+		Payload(&core.AddCertificationPayload{CertificateHash: hash0, CertType: "vaccination"}).SignPair(selfIssuer).Execute().
+		Tx().Type(core.TxOpAddCertification).To(certified.Addr).
+		Payload(&core.AddCertificationPayload{CertificateHash: hash1, CertType: "license"}).SignPair(issuer).Execute().
+		Build()
+
+	issuerAcc, err := block.State().GetAccount(issuer.Addr)
+	require.NoError(t, err)
+	reg, err := issuerAcc.GetIssuerRegistration("license")
+	require.NoError(t, err)
+	assert.Equal(t, "med:license:v1", reg.SchemaId)
+	assert.Equal(t, governance.Addr.Bytes(), reg.Registrar)
+
+	certifiedAcc, err := block.State().GetAccount(certified.Addr)
+	require.NoError(t, err)
+	certBytes, err := certifiedAcc.GetData(core.CertReceivedPrefix, hash0)
+	require.NoError(t, err)
+	pbCert := new(corepb.Certification)
+	require.NoError(t, proto.Unmarshal(certBytes, pbCert))
+	assert.Equal(t, "vaccination", pbCert.CertType)
+	assert.Equal(t, "med:vaccination:v1", pbCert.SchemaId)
+
+	received, err := certifiedAcc.Certifications(core.CertReceivedPrefix)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(received))
+	issued, err := issuerAcc.Certifications(core.CertIssuedPrefix)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(issued))
+	assert.Equal(t, hash1, issued[0].CertificateHash)
+}
+
+func TestAddUntypedCertification(t *testing.T) {
+	bb := blockutil.New(t, testutil.DynastySize).Genesis()
+	issuer := bb.TokenDist[testutil.DynastySize]
+	certified := bb.TokenDist[testutil.DynastySize+1]
+	hash := byteutils.Hex2Bytes("22e7b794e1de1851b52ab0b0b995cc87558963265a7b26630f26ea8bb9131a7e")
+
+	// the legacy certification is added by an issuer which is not registered for any type.
+	block := bb.
+		Tx().StakeTx(issuer, 10000000000000000).Execute().
+		Tx().Type(core.TxOpAddCertification).To(certified.Addr).
+		Payload(&core.AddCertificationPayload{CertificateHash: hash}).SignPair(issuer).Execute().
+		Build()
+
+	certifiedAcc, err := block.State().GetAccount(certified.Addr)
+	require.NoError(t, err)
+	certBytes, err := certifiedAcc.GetData(core.CertReceivedPrefix, hash)
+	require.NoError(t, err)
+	pbCert := new(corepb.Certification)
+	require.NoError(t, proto.Unmarshal(certBytes, pbCert))
+	assert.Empty(t, pbCert.CertType)
+	assert.Empty(t, pbCert.SchemaId)
+}
+
+func TestRequireTypedCertification(t *testing.T) {
+	bb := blockutil.New(t, testutil.DynastySize).GenesisWith(func(conf *corepb.Genesis) {
+		conf.Meta.RequireTypedCertification = true
+	})
+	issuer := bb.TokenDist[testutil.DynastySize]
+	certified := bb.TokenDist[testutil.DynastySize+1]
+	hash := byteutils.Hex2Bytes("32e7b794e1de1851b52ab0b0b995cc87558963265a7b26630f26ea8bb9131a7e")
+	vaccination := &core.RegisterIssuerPayload{CertType: "vaccination", SchemaID: "med:vaccination:v1"}
+
+	required, err := bb.B.State().TypedCertificationRequired()
+	require.NoError(t, err)
+	assert.True(t, required)
+
+	bb.
+		Tx().StakeTx(issuer, 10000000000000000).Execute().
+		Tx().Type(core.TxOpAddCertification).To(certified.Addr).
+		Payload(&core.AddCertificationPayload{CertificateHash: hash}).SignPair(issuer).
+		ExecuteErr(core.ErrUntypedCertification).
+		Tx().Type(core.TxOpRegisterIssuer).Payload(vaccination).SignPair(issuer).Execute().
+		Tx().Type(core.TxOpAddCertification).To(certified.Addr).
+		Payload(&core.AddCertificationPayload{CertificateHash: hash, CertType: "vaccination"}).SignPair(issuer).
+		Execute()
+}
+
 func TestPayerSigner(t *testing.T) {
 	bb := blockutil.New(t, testutil.DynastySize).Genesis().Child()
 
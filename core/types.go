@@ -30,11 +30,15 @@ const (
 	TxTyGenesis             = "genesis"
 	TxTyGenesisVesting      = "genesis_vest"
 	TxOpTransfer            = "transfer"
+	TxOpBatchTransfer       = "batch_transfer"
 	TxOpAddRecord           = "add_record"
+	TxOpAddRecordBatch      = "add_record_batch"
+	TxOpAmendRecord         = "amend_record"
 	TxOpVest                = "vest"
 	TxOpWithdrawVesting     = "withdraw_vesting"
 	TxOpAddCertification    = "add_certification"
 	TxOpRevokeCertification = "revoke_certification"
+	TxOpRegisterIssuer      = "register_issuer"
 )
 
 // Transaction related defaults
@@ -43,6 +47,11 @@ const (
 	UnstakingWaitDuration       = 7 * 24 * time.Hour
 	BandwidthRegenerateDuration = 7 * 24 * time.Hour
 	MaxPayloadSize              = 4096
+	MaxBatchTransferOutputs     = 500
+	MaxBatchTransferPayloadSize = MaxBatchTransferOutputs * 55 // an output takes 55 bytes in the payload
+	RecordBatchRootLength       = 32
+	MaxCertTypeLength           = 64
+	MaxSchemaIDLength           = 256
 )
 
 // Transaction's message types.
@@ -117,12 +126,22 @@ var (
 	ErrCheckPayloadIntegrity            = errors.New("payload has invalid elements")
 	ErrTooLargePayload                  = errors.New("too large payload")
 	ErrRecordAlreadyAdded               = errors.New("record hash already added")
+	ErrRecordNotFound                   = errors.New("record not found")
+	ErrRecordAlreadyAmended             = errors.New("record has already been amended")
+	ErrInvalidMerkleRoot                = errors.New("invalid merkle root of record batch")
+	ErrNoRecordsInBatch                 = errors.New("record batch has no records")
 	ErrRecordReaderAlreadyAdded         = errors.New("record reader hash already added")
 	ErrCertReceivedAlreadyAdded         = errors.New("hash of received cert already added")
 	ErrCertIssuedAlreadyAdded           = errors.New("hash of issued cert already added")
 	ErrCertAlreadyRevoked               = errors.New("cert to revoke has already been revoked")
 	ErrCertAlreadyExpired               = errors.New("cert to revoke has already been expired")
 	ErrInvalidCertificationRevoker      = errors.New("only issuer of the cert can revoke it")
+	ErrInvalidCertType                  = errors.New("invalid certification type")
+	ErrInvalidSchemaID                  = errors.New("invalid certification schema id")
+	ErrIssuerAlreadyRegistered          = errors.New("issuer is already registered for the certification type")
+	ErrIssuerNotRegistered              = errors.New("issuer is not registered for the certification type")
+	ErrUntypedCertification             = errors.New("certification type is required")
+	ErrNotGovernance                    = errors.New("only dynasty members can register other accounts as issuer")
 	ErrTxIsNotFromRecordOwner           = errors.New("adding record reader should be done by record owner")
 	ErrAlreadyInCandidacy               = errors.New("account is already a candidate")
 	ErrAlreadyVoted                     = errors.New("account has already voted for the candidate")
@@ -136,12 +155,25 @@ var (
 	ErrBlockSignatureNotExist           = errors.New("block signature does not exist in the blockheader")
 	ErrPayerSignatureNotExist           = errors.New("payer signature does not exist in the tx")
 	ErrWrongEventTopic                  = errors.New("required event topic doesn't exist in topic list")
+	ErrEventPruned                      = errors.New("requested event is pruned from event store")
 	ErrTransactionHashAlreadyAdded      = errors.New("transaction already added")
 	ErrTypecastFailed                   = errors.New("failed to typecast")
 	ErrAlreadyInVoters                  = errors.New("voter is already in voters")
 	ErrNotInVoters                      = errors.New("voter is not in voters")
 	ErrCannotUseZeroValue               = errors.New("value should be larger than zero")
 	ErrFailedToDirectPush               = errors.New("cannot direct push to chain")
+	ErrNoTransferOutputs                = errors.New("batch transfer has no outputs")
+	ErrTooManyTransferOutputs           = errors.New("too many batch transfer outputs")
+	ErrInvalidGenesisMeta               = errors.New("genesis meta is missing or has zero chain id")
+	ErrInvalidGenesisDynastySize        = errors.New("dynasty size does not match the number of dynasty members")
+	ErrInvalidGenesisAddress            = errors.New("invalid address in genesis")
+	ErrDuplicatedGenesisAddress         = errors.New("duplicated address in genesis")
+	ErrInvalidGenesisAmount             = errors.New("invalid balance or vesting in genesis")
+	ErrGenesisVoteNotInDynasty          = errors.New("genesis vote target is not a dynasty member")
+	ErrNotOnCanonicalChain              = errors.New("block is not on the canonical chain")
+	ErrLIBAboveTail                     = errors.New("LIB cannot be higher than tail")
+	ErrLIBNotFound                      = errors.New("consensus cannot find LIB")
+	ErrInvalidRollbackHeight            = errors.New("rollback height should be lower than tail and not lower than genesis")
 )
 
 // HashableBlock is an interface that can get its own or parent's hash.
@@ -198,6 +230,13 @@ type DposState interface {
 type Event struct {
 	Topic string
 	Data  string
+
+	// Block and Transaction which the event is about. They are nil if not applicable.
+	Block       *Block
+	Transaction *Transaction
+
+	// Seq is the sequence number assigned by the event store. It is 0 if the event is not stored.
+	Seq uint64
 }
 
 //SyncService interface for sync
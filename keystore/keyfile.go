@@ -0,0 +1,338 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package keystore
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/crypto/hash"
+	"github.com/medibloc/go-medibloc/crypto/rand"
+	"github.com/medibloc/go-medibloc/crypto/signature"
+	"github.com/medibloc/go-medibloc/crypto/signature/secp256k1"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Scrypt parameters of key files
+const (
+	// StandardScryptN is the N parameter of scrypt using 256MB memory and taking approximately 1s CPU time.
+	StandardScryptN = 1 << 18
+	// StandardScryptP is the P parameter of scrypt using 256MB memory and taking approximately 1s CPU time.
+	StandardScryptP = 1
+	// LightScryptN is the N parameter of scrypt using 4MB memory and taking approximately 100ms CPU time.
+	LightScryptN = 1 << 12
+	// LightScryptP is the P parameter of scrypt using 4MB memory and taking approximately 100ms CPU time.
+	LightScryptP = 6
+
+	scryptR     = 8
+	scryptDKLen = 32
+
+	keyFileVersion = 3
+	keyFileCipher  = "aes-128-ctr"
+	keyFileKDF     = "scrypt"
+)
+
+// Error types of key files
+var (
+	ErrDecrypt            = errors.New("could not decrypt key with given passphrase")
+	ErrInvalidKeyFile     = errors.New("invalid key file")
+	ErrKeyFileExists      = errors.New("key file of the address already exists")
+	ErrKeyFileNotFound    = errors.New("key file of the address not found")
+	ErrUnsupportedVersion = errors.New("unsupported key file version")
+)
+
+type keyFileJSON struct {
+	Address string     `json:"address"`
+	Crypto  cryptoJSON `json:"crypto"`
+	ID      string     `json:"id"`
+	Version int        `json:"version"`
+}
+
+type cryptoJSON struct {
+	Cipher       string           `json:"cipher"`
+	CipherText   string           `json:"ciphertext"`
+	CipherParams cipherParamsJSON `json:"cipherparams"`
+	KDF          string           `json:"kdf"`
+	KDFParams    scryptParamsJSON `json:"kdfparams"`
+	MAC          string           `json:"mac"`
+}
+
+type cipherParamsJSON struct {
+	IV string `json:"iv"`
+}
+
+type scryptParamsJSON struct {
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+}
+
+// KeyFile is an encrypted key file in a key directory.
+type KeyFile struct {
+	Address common.Address
+	Path    string
+}
+
+// EncryptKey encrypts the private key with the passphrase. The result is a JSON key file whose private key is
+// encrypted by AES-128-CTR with a key derived by scrypt.
+func EncryptKey(key signature.PrivateKey, passphrase string, scryptN, scryptP int) ([]byte, error) {
+	addr, err := common.PublicKeyToAddress(key.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+	keyBytes, err := key.Encoded()
+	if err != nil {
+		return nil, err
+	}
+
+	salt := rand.GetEntropyCSPRNG(32)
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, scryptDKLen)
+	if err != nil {
+		return nil, err
+	}
+	iv := rand.GetEntropyCSPRNG(16)
+	cipherText, err := crypto.AESCTRXOR(derivedKey[:16], keyBytes, iv)
+	if err != nil {
+		return nil, err
+	}
+	mac := hash.Sha3256(derivedKey[16:32], cipherText)
+
+	return json.Marshal(&keyFileJSON{
+		Address: addr.Hex(),
+		Crypto: cryptoJSON{
+			Cipher:     keyFileCipher,
+			CipherText: hex.EncodeToString(cipherText),
+			CipherParams: cipherParamsJSON{
+				IV: hex.EncodeToString(iv),
+			},
+			KDF: keyFileKDF,
+			KDFParams: scryptParamsJSON{
+				N:     scryptN,
+				R:     scryptR,
+				P:     scryptP,
+				DKLen: scryptDKLen,
+				Salt:  hex.EncodeToString(salt),
+			},
+			MAC: hex.EncodeToString(mac),
+		},
+		ID:      newKeyID(),
+		Version: keyFileVersion,
+	})
+}
+
+// DecryptKey decrypts the JSON key file with the passphrase.
+func DecryptKey(keyJSON []byte, passphrase string) (signature.PrivateKey, error) {
+	k := new(keyFileJSON)
+	if err := json.Unmarshal(keyJSON, k); err != nil {
+		return nil, err
+	}
+	if k.Version != keyFileVersion {
+		return nil, ErrUnsupportedVersion
+	}
+	if k.Crypto.Cipher != keyFileCipher || k.Crypto.KDF != keyFileKDF {
+		return nil, ErrInvalidKeyFile
+	}
+
+	salt, err := hex.DecodeString(k.Crypto.KDFParams.Salt)
+	if err != nil {
+		return nil, ErrInvalidKeyFile
+	}
+	iv, err := hex.DecodeString(k.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, ErrInvalidKeyFile
+	}
+	cipherText, err := hex.DecodeString(k.Crypto.CipherText)
+	if err != nil {
+		return nil, ErrInvalidKeyFile
+	}
+	mac, err := hex.DecodeString(k.Crypto.MAC)
+	if err != nil {
+		return nil, ErrInvalidKeyFile
+	}
+
+	params := k.Crypto.KDFParams
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, params.N, params.R, params.P, params.DKLen)
+	if err != nil {
+		return nil, err
+	}
+	if len(derivedKey) < 32 {
+		return nil, ErrInvalidKeyFile
+	}
+	if !bytes.Equal(hash.Sha3256(derivedKey[16:32], cipherText), mac) {
+		return nil, ErrDecrypt
+	}
+	keyBytes, err := crypto.AESCTRXOR(derivedKey[:16], cipherText, iv)
+	if err != nil {
+		return nil, err
+	}
+
+	key := new(secp256k1.PrivateKey)
+	if err := key.Decode(keyBytes); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// StoreKey encrypts the private key and writes it to a new key file in the directory.
+func StoreKey(dir string, key signature.PrivateKey, passphrase string, scryptN, scryptP int) (*KeyFile, error) {
+	addr, err := common.PublicKeyToAddress(key.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := FindKeyFile(dir, addr); err == nil {
+		return nil, ErrKeyFileExists
+	}
+	keyJSON, err := EncryptKey(key, passphrase, scryptN, scryptP)
+	if err != nil {
+		return nil, err
+	}
+	path := filepath.Join(dir, keyFileName(addr))
+	if err := writeKeyFile(path, keyJSON); err != nil {
+		return nil, err
+	}
+	return &KeyFile{
+		Address: addr,
+		Path:    path,
+	}, nil
+}
+
+// UpdateKey re-encrypts the key file of the address with the new passphrase.
+func UpdateKey(dir string, addr common.Address, passphrase, newPassphrase string, scryptN, scryptP int) error {
+	kf, err := FindKeyFile(dir, addr)
+	if err != nil {
+		return err
+	}
+	key, err := kf.Decrypt(passphrase)
+	if err != nil {
+		return err
+	}
+	keyJSON, err := EncryptKey(key, newPassphrase, scryptN, scryptP)
+	if err != nil {
+		return err
+	}
+	return writeKeyFile(kf.Path, keyJSON)
+}
+
+// Decrypt decrypts the key file with the passphrase.
+func (kf *KeyFile) Decrypt(passphrase string) (signature.PrivateKey, error) {
+	keyJSON, err := ioutil.ReadFile(kf.Path)
+	if err != nil {
+		return nil, err
+	}
+	return DecryptKey(keyJSON, passphrase)
+}
+
+// KeyFiles returns key files in the directory ordered by file name. Files which are not key files are skipped.
+func KeyFiles(dir string) ([]*KeyFile, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*KeyFile
+	for _, info := range infos {
+		name := info.Name()
+		if info.IsDir() || strings.HasPrefix(name, ".") || strings.HasSuffix(name, "~") {
+			continue
+		}
+		kf, err := ReadKeyFile(filepath.Join(dir, name))
+		if err != nil {
+			continue
+		}
+		files = append(files, kf)
+	}
+	return files, nil
+}
+
+// ReadKeyFile returns the key file of the path without decrypting it.
+func ReadKeyFile(path string) (*KeyFile, error) {
+	keyJSON, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	k := new(keyFileJSON)
+	if err := json.Unmarshal(keyJSON, k); err != nil || !common.IsHexAddress(k.Address) {
+		return nil, ErrInvalidKeyFile
+	}
+	return &KeyFile{
+		Address: common.HexToAddress(k.Address),
+		Path:    path,
+	}, nil
+}
+
+// FindKeyFile returns the key file of the address in the directory.
+func FindKeyFile(dir string, addr common.Address) (*KeyFile, error) {
+	files, err := KeyFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, kf := range files {
+		if kf.Address.Equals(addr) {
+			return kf, nil
+		}
+	}
+	return nil, ErrKeyFileNotFound
+}
+
+// keyFileName returns the name of a key file. e.g. UTC--2018-06-01T00-00-00.000000000Z--{address}
+func keyFileName(addr common.Address) string {
+	ts := time.Now().UTC().Format("2006-01-02T15-04-05.000000000Z")
+	return fmt.Sprintf("UTC--%s--%s", ts, addr.Hex())
+}
+
+// writeKeyFile writes the key file atomically, so the key file is not broken if writing is interrupted.
+func writeKeyFile(path string, content []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(dir, "."+filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+func newKeyID() string {
+	id := rand.GetEntropyCSPRNG(16)
+	id[6] = (id[6] & 0x0f) | 0x40
+	id[8] = (id[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:])
+}
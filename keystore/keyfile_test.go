@@ -0,0 +1,98 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package keystore_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/crypto/signature/algorithm"
+	"github.com/medibloc/go-medibloc/keystore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptDecryptKey(t *testing.T) {
+	key, err := crypto.GenerateKey(algorithm.SECP256K1)
+	require.NoError(t, err)
+
+	keyJSON, err := keystore.EncryptKey(key, "passphrase", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+
+	_, err = keystore.DecryptKey(keyJSON, "wrong")
+	assert.Equal(t, keystore.ErrDecrypt, err)
+
+	decrypted, err := keystore.DecryptKey(keyJSON, "passphrase")
+	require.NoError(t, err)
+	expected, err := key.Encoded()
+	require.NoError(t, err)
+	actual, err := decrypted.Encoded()
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestKeyFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keydir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	files, err := keystore.KeyFiles(filepath.Join(dir, "not_exist"))
+	require.NoError(t, err)
+	assert.Len(t, files, 0)
+
+	key, err := crypto.GenerateKey(algorithm.SECP256K1)
+	require.NoError(t, err)
+	addr, err := common.PublicKeyToAddress(key.PublicKey())
+	require.NoError(t, err)
+
+	kf, err := keystore.StoreKey(dir, key, "passphrase", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+	assert.Equal(t, addr, kf.Address)
+
+	_, err = keystore.StoreKey(dir, key, "passphrase", keystore.LightScryptN, keystore.LightScryptP)
+	assert.Equal(t, keystore.ErrKeyFileExists, err)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "garbage"), []byte("garbage"), 0600))
+	files, err = keystore.KeyFiles(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, addr, files[0].Address)
+
+	_, err = keystore.ReadKeyFile(filepath.Join(dir, "garbage"))
+	assert.Equal(t, keystore.ErrInvalidKeyFile, err)
+	read, err := keystore.ReadKeyFile(kf.Path)
+	require.NoError(t, err)
+	assert.Equal(t, addr, read.Address)
+
+	require.NoError(t, keystore.UpdateKey(dir, addr, "passphrase", "new", keystore.LightScryptN, keystore.LightScryptP))
+	kf, err = keystore.FindKeyFile(dir, addr)
+	require.NoError(t, err)
+	_, err = kf.Decrypt("passphrase")
+	assert.Equal(t, keystore.ErrDecrypt, err)
+	_, err = kf.Decrypt("new")
+	assert.NoError(t, err)
+
+	other, err := crypto.GenerateKey(algorithm.SECP256K1)
+	require.NoError(t, err)
+	otherAddr, err := common.PublicKeyToAddress(other.PublicKey())
+	require.NoError(t, err)
+	_, err = keystore.FindKeyFile(dir, otherAddr)
+	assert.Equal(t, keystore.ErrKeyFileNotFound, err)
+}
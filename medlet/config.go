@@ -36,6 +36,9 @@ func LoadConfig(file string) (*medletpb.Config, error) {
 		if err != nil {
 			return nil, err
 		}
+		log.Console().WithFields(logrus.Fields{
+			"file": file,
+		}).Warn("Config file does not exist. Created a default config file.")
 	}
 
 	b, err := ioutil.ReadFile(file)
@@ -103,6 +106,21 @@ func DefaultConfig() *medletpb.Config {
 			HttpListen:       []string{"127.0.0.1:9921"},
 			HttpModule:       nil,
 			ConnectionLimits: 0,
+			AdminRpcListen:   nil,
+			AdminHttpListen:  nil,
+		},
+		EventStore: &medletpb.EventStoreConfig{
+			Enabled:   false,
+			Path:      "",
+			Topics:    nil,
+			MaxEvents: 0,
+		},
+		Webhook: &medletpb.WebhookConfig{
+			Endpoints:    nil,
+			QueuePath:    "",
+			MaxAttempts:  0,
+			Timeout:      0,
+			MaxQueueSize: 0,
 		},
 		Stats: &medletpb.StatsConfig{
 			EnableMetrics:   false,
@@ -115,6 +133,9 @@ func DefaultConfig() *medletpb.Config {
 				Password: "",
 			},
 			MetricsTags: nil,
+			Prometheus: &medletpb.PrometheusConfig{
+				Listen: "127.0.0.1:9922",
+			},
 		},
 		Misc: &medletpb.MiscConfig{
 			DefaultKeystoreFileCiper: "",
@@ -0,0 +1,266 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package medlet
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/medibloc/go-medibloc/medlet/pb"
+)
+
+// ConfigOverride is a config field which can be overridden by an environment variable or a command line flag.
+type ConfigOverride struct {
+	// Flag is the name of the command line flag. The field is overridden only by the environment variable if
+	// empty, so secrets are not exposed in the process list.
+	Flag string
+	// Env is the name of the environment variable.
+	Env string
+	// Field is the path of the overridden field in the config.
+	Field string
+	Usage string
+	// Secret is true if the value should not be printed.
+	Secret bool
+
+	set func(cfg *medletpb.Config, value string) error
+}
+
+// Apply sets the value to the field of the config. List values are separated by ','.
+func (o *ConfigOverride) Apply(cfg *medletpb.Config, value string) error {
+	if err := o.set(cfg, value); err != nil {
+		e := &ConfigError{Field: o.Field, Err: ErrInvalidOverrideValue}
+		if !o.Secret {
+			e.Value = value
+		}
+		return e
+	}
+	return nil
+}
+
+// ConfigOverrides are config fields which can be overridden.
+var ConfigOverrides = []*ConfigOverride{
+	{
+		Flag:  "node-datadir",
+		Env:   "MEDI_DATADIR",
+		Field: "global.datadir",
+		Usage: "data directory",
+		set: func(cfg *medletpb.Config, value string) error {
+			globalConfig(cfg).Datadir = value
+			return nil
+		},
+	},
+	{
+		Flag:  "node-chain-id",
+		Env:   "MEDI_CHAIN_ID",
+		Field: "global.chain_id",
+		Usage: "chain id",
+		set: func(cfg *medletpb.Config, value string) error {
+			id, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				return err
+			}
+			globalConfig(cfg).ChainId = uint32(id)
+			return nil
+		},
+	},
+	{
+		Flag:  "listen",
+		Env:   "MEDI_LISTEN",
+		Field: "network.listen",
+		Usage: "comma separated p2p listen addresses",
+		set: func(cfg *medletpb.Config, value string) error {
+			networkConfig(cfg).Listen = splitList(value)
+			return nil
+		},
+	},
+	{
+		Flag:  "seed",
+		Env:   "MEDI_SEED",
+		Field: "network.seed",
+		Usage: "comma separated seed node addresses",
+		set: func(cfg *medletpb.Config, value string) error {
+			networkConfig(cfg).Seed = splitList(value)
+			return nil
+		},
+	},
+	{
+		Flag:  "rpc-listen",
+		Env:   "MEDI_RPC_LISTEN",
+		Field: "rpc.rpc_listen",
+		Usage: "comma separated grpc listen addresses",
+		set: func(cfg *medletpb.Config, value string) error {
+			rpcConfig(cfg).RpcListen = splitList(value)
+			return nil
+		},
+	},
+	{
+		Flag:  "http-listen",
+		Env:   "MEDI_HTTP_LISTEN",
+		Field: "rpc.http_listen",
+		Usage: "comma separated http listen addresses",
+		set: func(cfg *medletpb.Config, value string) error {
+			rpcConfig(cfg).HttpListen = splitList(value)
+			return nil
+		},
+	},
+	{
+		Flag:  "node-genesis",
+		Env:   "MEDI_GENESIS",
+		Field: "chain.genesis",
+		Usage: "genesis conf file",
+		set: func(cfg *medletpb.Config, value string) error {
+			chainConfig(cfg).Genesis = value
+			return nil
+		},
+	},
+	{
+		Flag:  "start-mine",
+		Env:   "MEDI_START_MINE",
+		Field: "chain.start_mine",
+		Usage: "start mining at launch. true or false",
+		set: func(cfg *medletpb.Config, value string) error {
+			startMine, err := strconv.ParseBool(value)
+			if err != nil {
+				return err
+			}
+			chainConfig(cfg).StartMine = startMine
+			return nil
+		},
+	},
+	{
+		Flag:  "coinbase",
+		Env:   "MEDI_COINBASE",
+		Field: "chain.coinbase",
+		Usage: "address receiving block rewards",
+		set: func(cfg *medletpb.Config, value string) error {
+			chainConfig(cfg).Coinbase = value
+			return nil
+		},
+	},
+	{
+		Flag:  "miner",
+		Env:   "MEDI_MINER",
+		Field: "chain.miner",
+		Usage: "address of the block producer",
+		set: func(cfg *medletpb.Config, value string) error {
+			chainConfig(cfg).Miner = value
+			return nil
+		},
+	},
+	{
+		Env:    "MEDI_PRIVKEY",
+		Field:  "chain.privkey",
+		Usage:  "hex encoded private key of the block producer",
+		Secret: true,
+		set: func(cfg *medletpb.Config, value string) error {
+			chainConfig(cfg).Privkey = value
+			return nil
+		},
+	},
+	{
+		Flag:  "log-level",
+		Env:   "MEDI_LOG_LEVEL",
+		Field: "app.log_level",
+		Usage: "log level",
+		set: func(cfg *medletpb.Config, value string) error {
+			appConfig(cfg).LogLevel = value
+			return nil
+		},
+	},
+	{
+		Flag:  "log-file",
+		Env:   "MEDI_LOG_FILE",
+		Field: "app.log_file",
+		Usage: "log directory",
+		set: func(cfg *medletpb.Config, value string) error {
+			appConfig(cfg).LogFile = value
+			return nil
+		},
+	},
+}
+
+// ApplyEnv applies overrides of the environment variables which are set.
+func ApplyEnv(cfg *medletpb.Config) error {
+	return applyEnv(cfg, true)
+}
+
+// ApplyPublicEnv applies overrides of the environment variables which are set, except secrets. It is used for
+// configs which are printed.
+func ApplyPublicEnv(cfg *medletpb.Config) error {
+	return applyEnv(cfg, false)
+}
+
+func applyEnv(cfg *medletpb.Config, secret bool) error {
+	for _, o := range ConfigOverrides {
+		if o.Secret && !secret {
+			continue
+		}
+		value, ok := os.LookupEnv(o.Env)
+		if !ok {
+			continue
+		}
+		if err := o.Apply(cfg, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func splitList(value string) []string {
+	var list []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+func globalConfig(cfg *medletpb.Config) *medletpb.GlobalConfig {
+	if cfg.Global == nil {
+		cfg.Global = &medletpb.GlobalConfig{}
+	}
+	return cfg.Global
+}
+
+func networkConfig(cfg *medletpb.Config) *medletpb.NetworkConfig {
+	if cfg.Network == nil {
+		cfg.Network = &medletpb.NetworkConfig{}
+	}
+	return cfg.Network
+}
+
+func chainConfig(cfg *medletpb.Config) *medletpb.ChainConfig {
+	if cfg.Chain == nil {
+		cfg.Chain = &medletpb.ChainConfig{}
+	}
+	return cfg.Chain
+}
+
+func rpcConfig(cfg *medletpb.Config) *medletpb.RPCConfig {
+	if cfg.Rpc == nil {
+		cfg.Rpc = &medletpb.RPCConfig{}
+	}
+	return cfg.Rpc
+}
+
+func appConfig(cfg *medletpb.Config) *medletpb.AppConfig {
+	if cfg.App == nil {
+		cfg.App = &medletpb.AppConfig{}
+	}
+	return cfg.App
+}
@@ -0,0 +1,353 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package medlet
+
+import (
+	"errors"
+	"fmt"
+	goNet "net"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/crypto/signature/secp256k1"
+	"github.com/medibloc/go-medibloc/medlet/pb"
+	"github.com/medibloc/go-medibloc/rpc"
+	"github.com/medibloc/go-medibloc/webhook"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/sirupsen/logrus"
+)
+
+// Error types of config validation
+var (
+	ErrConfigSectionMissing   = errors.New("section is missing")
+	ErrConfigValueEmpty       = errors.New("should not be empty")
+	ErrConfigValueNotPositive = errors.New("should be positive")
+	ErrConfigFileNotFound     = errors.New("file does not exist")
+	ErrInvalidListenAddress   = errors.New("invalid listen address. use {host}:{port}")
+	ErrInvalidSeedAddress     = errors.New("invalid seed address. use multiaddr such as /ip4/{ip}/tcp/{port}/ipfs/{id}")
+	ErrInvalidConfigAddress   = errors.New("invalid account address")
+	ErrInvalidMinerKey        = errors.New("invalid secp256k1 private key")
+	ErrMinerKeyMismatch       = errors.New("private key does not match chain.miner")
+	ErrMinerKeyRequired       = errors.New("should be set if chain.start_mine is true")
+	ErrTLSKeyFileRequired     = errors.New("should be set if rpc.tls_cert_file is set")
+	ErrTLSCertFileRequired    = errors.New("should be set if rpc.tls_client_ca_file is set")
+	ErrAdminListenRequired    = errors.New("should be set if rpc.admin_http_listen is set")
+	ErrInvalidPermission      = errors.New("invalid permission. use read, send_transaction or admin")
+	ErrInvalidEventTopic      = errors.New("invalid event topic")
+	ErrInvalidWebhookURL      = errors.New("invalid url. use http or https url")
+	ErrInvalidMetricsTag      = errors.New("invalid metrics tag. use {key}:{value}")
+	ErrInvalidLogLevel        = errors.New("invalid log level. use debug, info, warning, error, fatal or panic")
+	ErrInvalidChunkSizeRange  = errors.New("should not be less than sync.seeding_min_chunk_size")
+	ErrInvalidOverrideValue   = errors.New("invalid override value")
+)
+
+// ConfigError is an error of a config field.
+type ConfigError struct {
+	// Field is the path of the field in the config. e.g. rpc.rpc_listen[0]
+	Field string
+	// Value is the invalid value. It is empty for secrets.
+	Value string
+	Err   error
+}
+
+func (e *ConfigError) Error() string {
+	if e.Value == "" {
+		return fmt.Sprintf("%s: %v", e.Field, e.Err)
+	}
+	return fmt.Sprintf("%s: %v (value: %q)", e.Field, e.Err, e.Value)
+}
+
+// ConfigErrors is a list of config errors.
+type ConfigErrors []*ConfigError
+
+func (errs ConfigErrors) Error() string {
+	msgs := make([]string, 0, len(errs))
+	for _, e := range errs {
+		msgs = append(msgs, e.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// configValidator collects errors while validating a config.
+type configValidator struct {
+	errs ConfigErrors
+}
+
+func (v *configValidator) fail(field string, value string, err error) {
+	v.errs = append(v.errs, &ConfigError{Field: field, Value: value, Err: err})
+}
+
+// section returns true if the section exists.
+func (v *configValidator) section(name string, exists bool) bool {
+	if !exists {
+		v.fail(name, "", ErrConfigSectionMissing)
+	}
+	return exists
+}
+
+func (v *configValidator) notEmpty(field string, value string) bool {
+	if value == "" {
+		v.fail(field, "", ErrConfigValueEmpty)
+		return false
+	}
+	return true
+}
+
+func (v *configValidator) positive(field string, value uint64) {
+	if value == 0 {
+		v.fail(field, "", ErrConfigValueNotPositive)
+	}
+}
+
+func (v *configValidator) fileExists(field string, path string) {
+	if path == "" {
+		return
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		v.fail(field, path, ErrConfigFileNotFound)
+	}
+}
+
+func (v *configValidator) listen(field string, addrs []string, required bool) {
+	if required && len(addrs) == 0 {
+		v.fail(field, "", ErrConfigValueEmpty)
+		return
+	}
+	for i, addr := range addrs {
+		if _, err := goNet.ResolveTCPAddr("tcp", addr); err != nil {
+			v.fail(fmt.Sprintf("%s[%d]", field, i), addr, ErrInvalidListenAddress)
+		}
+	}
+}
+
+func (v *configValidator) address(field string, value string) {
+	if value != "" && !common.IsHexAddress(value) {
+		v.fail(field, value, ErrInvalidConfigAddress)
+	}
+}
+
+func (v *configValidator) topics(field string, topics []string, valid func(string) bool) {
+	for i, topic := range topics {
+		if !valid(topic) {
+			v.fail(fmt.Sprintf("%s[%d]", field, i), topic, ErrInvalidEventTopic)
+		}
+	}
+}
+
+// ValidateConfig checks every section of the config. It returns ConfigErrors which has all invalid fields.
+func ValidateConfig(cfg *medletpb.Config) error {
+	v := &configValidator{}
+	if v.section("global", cfg.Global != nil) {
+		v.validateGlobal(cfg.Global)
+	}
+	if v.section("network", cfg.Network != nil) {
+		v.validateNetwork(cfg.Network)
+	}
+	if v.section("chain", cfg.Chain != nil) {
+		v.validateChain(cfg.Chain)
+	}
+	if v.section("rpc", cfg.Rpc != nil) {
+		v.validateRPC(cfg.Rpc)
+	}
+	v.validateEventStore(cfg.EventStore)
+	v.validateWebhook(cfg.Webhook)
+	v.validateStats(cfg.Stats)
+	if v.section("app", cfg.App != nil) {
+		v.validateApp(cfg.App)
+	}
+	if v.section("sync", cfg.Sync != nil) {
+		v.validateSync(cfg.Sync)
+	}
+
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+func (v *configValidator) validateGlobal(cfg *medletpb.GlobalConfig) {
+	v.positive("global.chain_id", uint64(cfg.ChainId))
+	v.notEmpty("global.datadir", cfg.Datadir)
+}
+
+func (v *configValidator) validateNetwork(cfg *medletpb.NetworkConfig) {
+	v.listen("network.listen", cfg.Listen, true)
+	for i, seed := range cfg.Seed {
+		if _, err := multiaddr.NewMultiaddr(seed); err != nil {
+			v.fail(fmt.Sprintf("network.seed[%d]", i), seed, ErrInvalidSeedAddress)
+		}
+	}
+	v.fileExists("network.private_key", cfg.PrivateKey)
+}
+
+func (v *configValidator) validateChain(cfg *medletpb.ChainConfig) {
+	if v.notEmpty("chain.genesis", cfg.Genesis) {
+		v.fileExists("chain.genesis", cfg.Genesis)
+	}
+	v.address("chain.coinbase", cfg.Coinbase)
+	v.address("chain.miner", cfg.Miner)
+
+	if cfg.StartMine {
+		v.notEmpty("chain.coinbase", cfg.Coinbase)
+		v.notEmpty("chain.miner", cfg.Miner)
+		if cfg.Privkey == "" {
+			v.fail("chain.privkey", "", ErrMinerKeyRequired)
+		}
+	}
+	if cfg.Privkey != "" {
+		key, err := secp256k1.NewPrivateKeyFromHex(cfg.Privkey)
+		if err != nil {
+			v.fail("chain.privkey", "", ErrInvalidMinerKey)
+		} else if common.IsHexAddress(cfg.Miner) {
+			addr, err := common.PublicKeyToAddress(key.PublicKey())
+			if err != nil || !addr.Equals(common.HexToAddress(cfg.Miner)) {
+				v.fail("chain.privkey", "", ErrMinerKeyMismatch)
+			}
+		}
+	}
+
+	v.positive("chain.block_cache_size", uint64(cfg.BlockCacheSize))
+	v.positive("chain.tail_cache_size", uint64(cfg.TailCacheSize))
+	v.positive("chain.block_pool_size", uint64(cfg.BlockPoolSize))
+	v.positive("chain.transaction_pool_size", uint64(cfg.TransactionPoolSize))
+}
+
+func (v *configValidator) validateRPC(cfg *medletpb.RPCConfig) {
+	v.listen("rpc.rpc_listen", cfg.RpcListen, true)
+	v.listen("rpc.http_listen", cfg.HttpListen, true)
+	v.listen("rpc.admin_rpc_listen", cfg.AdminRpcListen, false)
+	v.listen("rpc.admin_http_listen", cfg.AdminHttpListen, false)
+	if len(cfg.AdminHttpListen) > 0 && len(cfg.AdminRpcListen) == 0 {
+		v.fail("rpc.admin_rpc_listen", "", ErrAdminListenRequired)
+	}
+
+	if cfg.TlsCertFile != "" && cfg.TlsKeyFile == "" {
+		v.fail("rpc.tls_key_file", "", ErrTLSKeyFileRequired)
+	}
+	if cfg.TlsClientCaFile != "" && cfg.TlsCertFile == "" {
+		v.fail("rpc.tls_cert_file", "", ErrTLSCertFileRequired)
+	}
+	v.fileExists("rpc.tls_cert_file", cfg.TlsCertFile)
+	v.fileExists("rpc.tls_key_file", cfg.TlsKeyFile)
+	v.fileExists("rpc.tls_client_ca_file", cfg.TlsClientCaFile)
+
+	for i, k := range cfg.ApiKeys {
+		field := fmt.Sprintf("rpc.api_keys[%d]", i)
+		v.notEmpty(field+".key", k.Key)
+		for j, p := range k.Permissions {
+			if !validPermission(p) {
+				v.fail(fmt.Sprintf("%s.permissions[%d]", field, j), p, ErrInvalidPermission)
+			}
+		}
+	}
+	for _, err := range rpc.APIKeyErrors(cfg) {
+		v.fail("rpc.api_keys", "", err)
+	}
+	for i, r := range cfg.RateLimits {
+		field := fmt.Sprintf("rpc.rate_limits[%d]", i)
+		if !validPermission(r.MethodClass) {
+			v.fail(field+".method_class", r.MethodClass, ErrInvalidPermission)
+		}
+		if r.Rate <= 0 {
+			v.fail(field+".rate", "", ErrConfigValueNotPositive)
+		}
+	}
+}
+
+func validPermission(p string) bool {
+	switch p {
+	case rpc.PermissionRead, rpc.PermissionSendTransaction, rpc.PermissionAdmin:
+		return true
+	}
+	return false
+}
+
+func (v *configValidator) validateEventStore(cfg *medletpb.EventStoreConfig) {
+	if cfg == nil {
+		return
+	}
+	v.topics("event_store.topics", cfg.Topics, core.IsValidTopic)
+}
+
+func (v *configValidator) validateWebhook(cfg *medletpb.WebhookConfig) {
+	if cfg == nil {
+		return
+	}
+	for i, e := range cfg.Endpoints {
+		field := fmt.Sprintf("webhook.endpoints[%d]", i)
+		u, err := url.Parse(e.Url)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			v.fail(field+".url", e.Url, ErrInvalidWebhookURL)
+		}
+		v.topics(field+".topics", e.Topics, webhook.IsValidTopic)
+	}
+}
+
+func (v *configValidator) validateStats(cfg *medletpb.StatsConfig) {
+	if cfg == nil {
+		return
+	}
+	for i, tag := range cfg.MetricsTags {
+		if kv := strings.Split(tag, ":"); len(kv) != 2 || kv[0] == "" {
+			v.fail(fmt.Sprintf("stats.metrics_tags[%d]", i), tag, ErrInvalidMetricsTag)
+		}
+	}
+	if !cfg.EnableMetrics {
+		return
+	}
+	modules := cfg.ReportingModule
+	if len(modules) == 0 {
+		modules = []medletpb.StatsConfig_ReportingModule{medletpb.StatsConfig_Influxdb}
+	}
+	for _, module := range modules {
+		switch module {
+		case medletpb.StatsConfig_Influxdb:
+			if v.section("stats.influxdb", cfg.Influxdb != nil) {
+				v.notEmpty("stats.influxdb.host", cfg.Influxdb.Host)
+				v.notEmpty("stats.influxdb.db", cfg.Influxdb.Db)
+			}
+		case medletpb.StatsConfig_Prometheus:
+			if v.section("stats.prometheus", cfg.Prometheus != nil) {
+				v.listen("stats.prometheus.listen", []string{cfg.Prometheus.Listen}, true)
+			}
+		}
+	}
+}
+
+func (v *configValidator) validateApp(cfg *medletpb.AppConfig) {
+	if _, err := logrus.ParseLevel(cfg.LogLevel); err != nil {
+		v.fail("app.log_level", cfg.LogLevel, ErrInvalidLogLevel)
+	}
+	v.notEmpty("app.log_file", cfg.LogFile)
+	if cfg.Pprof != nil && cfg.Pprof.HttpListen != "" {
+		v.listen("app.pprof.http_listen", []string{cfg.Pprof.HttpListen}, true)
+	}
+}
+
+func (v *configValidator) validateSync(cfg *medletpb.SyncConfig) {
+	v.positive("sync.seeding_min_chunk_size", cfg.SeedingMinChunkSize)
+	v.positive("sync.seeding_max_chunk_size", cfg.SeedingMaxChunkSize)
+	if cfg.SeedingMaxChunkSize < cfg.SeedingMinChunkSize {
+		v.fail("sync.seeding_max_chunk_size", "", ErrInvalidChunkSizeRange)
+	}
+	v.positive("sync.seeding_max_concurrent_peers", uint64(cfg.SeedingMaxConcurrentPeers))
+	v.positive("sync.download_chunk_size", cfg.DownloadChunkSize)
+	v.positive("sync.download_max_concurrent_tasks", uint64(cfg.DownloadMaxConcurrentTasks))
+	v.positive("sync.download_chunk_cache_size", cfg.DownloadChunkCacheSize)
+}
@@ -0,0 +1,177 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package medlet
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/crypto/signature/algorithm"
+	"github.com/medibloc/go-medibloc/medlet/pb"
+	"github.com/medibloc/go-medibloc/rpc"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func configErrorFields(t *testing.T, err error) []string {
+	require.Error(t, err)
+	errs, ok := err.(ConfigErrors)
+	require.True(t, ok)
+	var fields []string
+	for _, e := range errs {
+		fields = append(fields, e.Field)
+	}
+	return fields
+}
+
+func TestValidateConfig(t *testing.T) {
+	genesis, err := ioutil.TempFile(os.TempDir(), "genesis")
+	require.NoError(t, err)
+	genesis.Close()
+	defer os.Remove(genesis.Name())
+
+	cfg := DefaultConfig()
+	assert.Equal(t, []string{"chain.genesis"}, configErrorFields(t, ValidateConfig(cfg)))
+
+	cfg.Chain.Genesis = genesis.Name()
+	assert.NoError(t, ValidateConfig(cfg))
+
+	cfg.Rpc.RpcListen = nil
+	cfg.Network.Listen = []string{"127.0.0.1"}
+	cfg.Network.Seed = []string{"127.0.0.1:9900"}
+	cfg.Rpc.ApiKeys = []*medletpb.RPCAPIKey{{Key: "key", Permissions: []string{"read", "write"}}}
+	cfg.App.LogLevel = "verbose"
+	cfg.Sync = nil
+	assert.Equal(t, []string{
+		"network.listen[0]",
+		"network.seed[0]",
+		"rpc.rpc_listen",
+		"rpc.api_keys[0].permissions[1]",
+		"app.log_level",
+		"sync",
+	}, configErrorFields(t, ValidateConfig(cfg)))
+}
+
+func TestValidateRPCAuthConfig(t *testing.T) {
+	genesis, err := ioutil.TempFile(os.TempDir(), "genesis")
+	require.NoError(t, err)
+	genesis.Close()
+	defer os.Remove(genesis.Name())
+
+	cfg := DefaultConfig()
+	cfg.Chain.Genesis = genesis.Name()
+	cfg.Rpc.AdminRpcListen = []string{"127.0.0.1:9930"}
+	cfg.Rpc.RequireReadAuth = true
+	err = ValidateConfig(cfg)
+	assert.Equal(t, []string{"rpc.api_keys", "rpc.api_keys"}, configErrorFields(t, err))
+	assert.Equal(t, rpc.ErrAdminAPIKeyRequired, err.(ConfigErrors)[0].Err)
+	assert.Equal(t, rpc.ErrReadAPIKeyRequired, err.(ConfigErrors)[1].Err)
+
+	cfg.Rpc.ApiKeys = []*medletpb.RPCAPIKey{{Key: "admin", Permissions: []string{"admin"}}}
+	assert.Equal(t, rpc.ErrReadAPIKeyRequired, ValidateConfig(cfg).(ConfigErrors)[0].Err)
+
+	cfg.Rpc.ApiKeys = append(cfg.Rpc.ApiKeys, &medletpb.RPCAPIKey{Key: "reader", Permissions: []string{"read"}})
+	assert.NoError(t, ValidateConfig(cfg))
+}
+
+func TestValidateMinerConfig(t *testing.T) {
+	genesis, err := ioutil.TempFile(os.TempDir(), "genesis")
+	require.NoError(t, err)
+	genesis.Close()
+	defer os.Remove(genesis.Name())
+
+	key, err := crypto.GenerateKey(algorithm.SECP256K1)
+	require.NoError(t, err)
+	encoded, err := key.Encoded()
+	require.NoError(t, err)
+	miner, err := common.PublicKeyToAddress(key.PublicKey())
+	require.NoError(t, err)
+
+	cfg := DefaultConfig()
+	cfg.Chain.Genesis = genesis.Name()
+	cfg.Chain.StartMine = true
+	assert.Equal(t, []string{"chain.coinbase", "chain.miner", "chain.privkey"},
+		configErrorFields(t, ValidateConfig(cfg)))
+
+	cfg.Chain.Coinbase = miner.Hex()
+	cfg.Chain.Miner = miner.Hex()
+	cfg.Chain.Privkey = byteutils.Bytes2Hex(encoded)
+	assert.NoError(t, ValidateConfig(cfg))
+
+	other, err := crypto.GenerateKey(algorithm.SECP256K1)
+	require.NoError(t, err)
+	encoded, err = other.Encoded()
+	require.NoError(t, err)
+	cfg.Chain.Privkey = byteutils.Bytes2Hex(encoded)
+	err = ValidateConfig(cfg)
+	assert.Equal(t, []string{"chain.privkey"}, configErrorFields(t, err))
+	assert.Equal(t, ErrMinerKeyMismatch, err.(ConfigErrors)[0].Err)
+	assert.NotContains(t, err.Error(), cfg.Chain.Privkey)
+}
+
+func TestApplyEnv(t *testing.T) {
+	os.Setenv("MEDI_DATADIR", "env.db")
+	os.Setenv("MEDI_RPC_LISTEN", "127.0.0.1:10000, 127.0.0.1:10001")
+	defer os.Unsetenv("MEDI_DATADIR")
+	defer os.Unsetenv("MEDI_RPC_LISTEN")
+
+	cfg := DefaultConfig()
+	require.NoError(t, ApplyEnv(cfg))
+	assert.Equal(t, "env.db", cfg.Global.Datadir)
+	assert.Equal(t, []string{"127.0.0.1:10000", "127.0.0.1:10001"}, cfg.Rpc.RpcListen)
+	assert.Equal(t, DefaultConfig().Network.Listen, cfg.Network.Listen)
+
+	os.Setenv("MEDI_CHAIN_ID", "medi")
+	defer os.Unsetenv("MEDI_CHAIN_ID")
+	err := ApplyEnv(cfg)
+	require.Error(t, err)
+	assert.Equal(t, "global.chain_id", err.(*ConfigError).Field)
+
+	cfg = &medletpb.Config{}
+	for _, o := range ConfigOverrides {
+		if o.Flag == "miner" {
+			require.NoError(t, o.Apply(cfg, "miner"))
+		}
+	}
+	assert.Equal(t, "miner", cfg.Chain.Miner)
+}
+
+func TestSecretOverridesAreEnvOnly(t *testing.T) {
+	for _, o := range ConfigOverrides {
+		if o.Secret {
+			assert.Empty(t, o.Flag, o.Field)
+		}
+	}
+}
+
+func TestApplyPublicEnv(t *testing.T) {
+	os.Setenv("MEDI_PRIVKEY", "secret")
+	defer os.Unsetenv("MEDI_PRIVKEY")
+	os.Setenv("MEDI_DATADIR", "env.db")
+	defer os.Unsetenv("MEDI_DATADIR")
+
+	cfg := DefaultConfig()
+	require.NoError(t, ApplyPublicEnv(cfg))
+	assert.Equal(t, "env.db", cfg.Global.Datadir)
+	assert.Empty(t, cfg.Chain.Privkey)
+
+	require.NoError(t, ApplyEnv(cfg))
+	assert.Equal(t, "secret", cfg.Chain.Privkey)
+}
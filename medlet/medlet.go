@@ -26,27 +26,32 @@ import (
 	"github.com/medibloc/go-medibloc/core"
 	"github.com/medibloc/go-medibloc/core/pb"
 	"github.com/medibloc/go-medibloc/medlet/pb"
+	"github.com/medibloc/go-medibloc/metrics"
 	"github.com/medibloc/go-medibloc/net"
 	"github.com/medibloc/go-medibloc/rpc"
 	"github.com/medibloc/go-medibloc/storage"
 	"github.com/medibloc/go-medibloc/sync"
 	"github.com/medibloc/go-medibloc/util/logging"
-	"github.com/rcrowley/go-metrics"
+	"github.com/medibloc/go-medibloc/webhook"
 	"github.com/sirupsen/logrus"
 )
 
 var (
-	metricsMedstartGauge = metrics.GetOrRegisterGauge("med.start", nil)
+	metricsMedstartGauge = metrics.NewGauge("med.start")
 )
 
 //DefaultTxMap is default map of transactions.
 var DefaultTxMap = core.TxFactory{
 	core.TxOpTransfer:            core.NewTransferTx,
+	core.TxOpBatchTransfer:       core.NewBatchTransferTx,
 	core.TxOpAddRecord:           core.NewAddRecordTx,
+	core.TxOpAddRecordBatch:      core.NewAddRecordBatchTx,
+	core.TxOpAmendRecord:         core.NewAmendRecordTx,
 	core.TxOpVest:                core.NewVestTx,
 	core.TxOpWithdrawVesting:     core.NewWithdrawVestingTx,
 	core.TxOpAddCertification:    core.NewAddCertificationTx,
 	core.TxOpRevokeCertification: core.NewRevokeCertificationTx,
+	core.TxOpRegisterIssuer:      core.NewRegisterIssuerTx,
 
 	dpos.TxOpBecomeCandidate: dpos.NewBecomeCandidateTx,
 	dpos.TxOpQuitCandidacy:   dpos.NewQuitCandidateTx,
@@ -64,6 +69,8 @@ type Medlet struct {
 	transactionManager *core.TransactionManager
 	consensus          *dpos.Dpos
 	eventEmitter       *core.EventEmitter
+	eventStore         *core.EventStore
+	webhook            *webhook.Dispatcher
 	syncService        *sync.Service
 }
 
@@ -86,7 +93,13 @@ func New(cfg *medletpb.Config) (*Medlet, error) {
 		return nil, err
 	}
 
-	rpc := rpc.New(cfg)
+	rpc, err := rpc.New(cfg)
+	if err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to create rpc server.")
+		return nil, err
+	}
 
 	stor, err := storage.NewRocksStorage(cfg.Global.Datadir)
 	if err != nil {
@@ -106,6 +119,22 @@ func New(cfg *medletpb.Config) (*Medlet, error) {
 
 	tm := core.NewTransactionManager(cfg)
 
+	es, err := newEventStore(cfg)
+	if err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to create event store.")
+		return nil, err
+	}
+
+	wh, err := newWebhookDispatcher(cfg)
+	if err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to create webhook dispatcher.")
+		return nil, err
+	}
+
 	consensus := dpos.New(int(genesis.Meta.DynastySize))
 
 	ss := sync.NewService(cfg.Sync)
@@ -120,15 +149,63 @@ func New(cfg *medletpb.Config) (*Medlet, error) {
 		transactionManager: tm,
 		consensus:          consensus,
 		eventEmitter:       core.NewEventEmitter(40960),
+		eventStore:         es,
+		webhook:            wh,
 		syncService:        ss,
 	}, nil
 }
 
+func newEventStore(cfg *medletpb.Config) (*core.EventStore, error) {
+	if !cfg.EventStore.GetEnabled() {
+		return nil, nil
+	}
+	path := cfg.EventStore.Path
+	if path == "" {
+		path = cfg.Global.Datadir + ".events"
+	}
+	stor, err := storage.NewRocksStorage(path)
+	if err != nil {
+		return nil, err
+	}
+	es, err := core.NewEventStore(stor, cfg.EventStore.Topics, cfg.EventStore.MaxEvents)
+	if err != nil {
+		stor.Close()
+		return nil, err
+	}
+	return es, nil
+}
+
+func newWebhookDispatcher(cfg *medletpb.Config) (*webhook.Dispatcher, error) {
+	if len(cfg.Webhook.GetEndpoints()) == 0 {
+		return nil, nil
+	}
+	path := cfg.Webhook.QueuePath
+	if path == "" {
+		path = cfg.Global.Datadir + ".webhook"
+	}
+	stor, err := storage.NewRocksStorage(path)
+	if err != nil {
+		return nil, err
+	}
+	wh, err := webhook.New(cfg.Webhook, stor)
+	if err != nil {
+		stor.Close()
+		return nil, err
+	}
+	return wh, nil
+}
+
 // Setup sets up medlet.
 func (m *Medlet) Setup() error {
 	logging.Console().Info("Setting up Medlet...")
 
+	if m.eventStore != nil {
+		m.eventEmitter.InjectStore(m.eventStore)
+	}
+
 	m.rpc.Setup(m.blockManager, m.transactionManager, m.eventEmitter)
+	m.rpc.SetupHealth(m.netService, m.syncService, m.consensus, m.storage)
+	m.rpc.SetupAdmin(m.blockManager, m.netService, m.consensus, m.syncService, m.config.App.Version)
 
 	err := m.blockManager.Setup(m.genesis, m.storage, m.netService, m.consensus)
 	if err != nil {
@@ -140,6 +217,19 @@ func (m *Medlet) Setup() error {
 
 	m.transactionManager.Setup(m.netService)
 
+	n, err := m.transactionManager.RestoreJournal(m.storage)
+	if err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to restore transaction journal.")
+		return err
+	}
+	if n > 0 {
+		logging.Console().WithFields(logrus.Fields{
+			"count": n,
+		}).Info("Restored transactions from journal.")
+	}
+
 	err = m.consensus.Setup(m.config, m.genesis, m.blockManager, m.transactionManager)
 	if err != nil {
 		logging.Console().WithFields(logrus.Fields{
@@ -157,6 +247,16 @@ func (m *Medlet) Setup() error {
 	m.blockManager.InjectSyncService(m.syncService)
 	m.syncService.Setup(m.netService, m.blockManager)
 
+	if m.webhook != nil {
+		err = m.webhook.Setup(m.eventEmitter)
+		if err != nil {
+			logging.Console().WithFields(logrus.Fields{
+				"err": err,
+			}).Error("Failed to setup webhook dispatcher.")
+			return err
+		}
+	}
+
 	logging.Console().Info("Set up Medlet.")
 	return nil
 }
@@ -212,8 +312,26 @@ func (m *Medlet) Start() error {
 
 	m.syncService.Start()
 
+	if m.webhook != nil {
+		err = m.webhook.Start()
+		if err != nil {
+			logging.Console().WithFields(logrus.Fields{
+				"err": err,
+			}).Error("Failed to start webhook dispatcher.")
+			return err
+		}
+	}
+
 	metricsMedstartGauge.Update(1)
 
+	if m.config.Stats.GetEnableMetrics() {
+		if !metrics.Enabled() {
+			logging.Console().Warnf("Metrics are not collected. Run with --%s flag to collect metrics.",
+				metrics.MetricsEnabledFlag)
+		}
+		metrics.Start(m)
+	}
+
 	logging.Console().Info("Started Medlet.")
 	return nil
 }
@@ -234,6 +352,14 @@ func (m *Medlet) Stop() {
 
 	m.syncService.Stop()
 
+	if m.config.Stats.GetEnableMetrics() {
+		metrics.Stop()
+	}
+
+	if m.webhook != nil {
+		m.webhook.Stop()
+	}
+
 	err := m.storage.Close()
 	if err != nil {
 		logging.Console().WithFields(logrus.Fields{
@@ -241,6 +367,14 @@ func (m *Medlet) Stop() {
 		}).Error("failed to close storage")
 	}
 
+	if m.eventStore != nil {
+		if err := m.eventStore.Close(); err != nil {
+			logging.Console().WithFields(logrus.Fields{
+				"err": err,
+			}).Error("failed to close event store")
+		}
+	}
+
 	logging.Console().Info("Stopped Medlet.")
 }
 
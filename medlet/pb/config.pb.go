@@ -13,10 +13,17 @@ It has these top-level messages:
 	NetworkConfig
 	ChainConfig
 	RPCConfig
+	RPCHealthConfig
+	RPCAPIKey
+	RPCRateLimit
+	EventStoreConfig
+	WebhookConfig
+	WebhookEndpoint
 	AppConfig
 	PprofConfig
 	MiscConfig
 	StatsConfig
+	PrometheusConfig
 	InfluxdbConfig
 	SyncConfig
 */
@@ -41,21 +48,24 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 type StatsConfig_ReportingModule int32
 
 const (
-	StatsConfig_Influxdb StatsConfig_ReportingModule = 0
+	StatsConfig_Influxdb   StatsConfig_ReportingModule = 0
+	StatsConfig_Prometheus StatsConfig_ReportingModule = 1
 )
 
 var StatsConfig_ReportingModule_name = map[int32]string{
 	0: "Influxdb",
+	1: "Prometheus",
 }
 var StatsConfig_ReportingModule_value = map[string]int32{
-	"Influxdb": 0,
+	"Influxdb":   0,
+	"Prometheus": 1,
 }
 
 func (x StatsConfig_ReportingModule) String() string {
 	return proto.EnumName(StatsConfig_ReportingModule_name, int32(x))
 }
 func (StatsConfig_ReportingModule) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorConfig, []int{8, 0}
+	return fileDescriptorConfig, []int{14, 0}
 }
 
 // Med global configurations.
@@ -68,6 +78,10 @@ type Config struct {
 	Chain *ChainConfig `protobuf:"bytes,3,opt,name=chain" json:"chain,omitempty"`
 	// RPC config.
 	Rpc *RPCConfig `protobuf:"bytes,4,opt,name=rpc" json:"rpc,omitempty"`
+	// Event store config.
+	EventStore *EventStoreConfig `protobuf:"bytes,5,opt,name=event_store,json=eventStore" json:"event_store,omitempty"`
+	// Webhook config.
+	Webhook *WebhookConfig `protobuf:"bytes,6,opt,name=webhook" json:"webhook,omitempty"`
 	// Stats config.
 	Stats *StatsConfig `protobuf:"bytes,100,opt,name=stats" json:"stats,omitempty"`
 	// Misc config.
@@ -111,6 +125,20 @@ func (m *Config) GetRpc() *RPCConfig {
 	return nil
 }
 
+func (m *Config) GetEventStore() *EventStoreConfig {
+	if m != nil {
+		return m.EventStore
+	}
+	return nil
+}
+
+func (m *Config) GetWebhook() *WebhookConfig {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
 func (m *Config) GetStats() *StatsConfig {
 	if m != nil {
 		return m.Stats
@@ -342,8 +370,37 @@ type RPCConfig struct {
 	HttpListen []string `protobuf:"bytes,2,rep,name=http_listen,json=httpListen" json:"http_listen,omitempty"`
 	// Enabled HTTP modules.["api", "admin"]
 	HttpModule []string `protobuf:"bytes,3,rep,name=http_module,json=httpModule" json:"http_module,omitempty"`
-	// Connection limit.
+	// Maximum number of in-flight requests of all rpc and http listeners in process, which are rejected with
+	// ResourceExhausted (429 on http) over the limit. Open connections are capped by the same number, not counting
+	// the loopback connections of the http gateway. Unlimited if 0.
 	ConnectionLimits int32 `protobuf:"varint,4,opt,name=connection_limits,json=connectionLimits,proto3" json:"connection_limits,omitempty"`
+	// Admin RPC listen addresses. Admin service is disabled if empty.
+	AdminRpcListen []string `protobuf:"bytes,5,rep,name=admin_rpc_listen,json=adminRpcListen" json:"admin_rpc_listen,omitempty"`
+	// Admin HTTP listen addresses.
+	AdminHttpListen []string `protobuf:"bytes,6,rep,name=admin_http_listen,json=adminHttpListen" json:"admin_http_listen,omitempty"`
+	// TLS certificate file path. TLS is disabled if empty.
+	TlsCertFile string `protobuf:"bytes,7,opt,name=tls_cert_file,json=tlsCertFile,proto3" json:"tls_cert_file,omitempty"`
+	// TLS private key file path.
+	TlsKeyFile string `protobuf:"bytes,8,opt,name=tls_key_file,json=tlsKeyFile,proto3" json:"tls_key_file,omitempty"`
+	// CA certificate file path to verify client certificates. Client certificates are not required if empty.
+	TlsClientCaFile string `protobuf:"bytes,9,opt,name=tls_client_ca_file,json=tlsClientCaFile,proto3" json:"tls_client_ca_file,omitempty"`
+	// Allowed CORS origins. Cross-origin requests are denied if empty.
+	CorsAllowedOrigins []string `protobuf:"bytes,10,rep,name=cors_allowed_origins,json=corsAllowedOrigins" json:"cors_allowed_origins,omitempty"`
+	// API keys. Admin methods always require a key with "admin" permission. SendTransaction requires a key only if
+	// some key has "send_transaction" permission, and is served without a key otherwise.
+	ApiKeys []*RPCAPIKey `protobuf:"bytes,11,rep,name=api_keys,json=apiKeys" json:"api_keys,omitempty"`
+	// If true, read methods also require an API key with "read" permission.
+	RequireReadAuth bool `protobuf:"varint,12,opt,name=require_read_auth,json=requireReadAuth,proto3" json:"require_read_auth,omitempty"`
+	// Rate limits by method class. Requests are not limited if empty.
+	RateLimits []*RPCRateLimit `protobuf:"bytes,13,rep,name=rate_limits,json=rateLimits" json:"rate_limits,omitempty"`
+	// Maximum number of blocks returned by GetBlocks or replayed by Subscribe from_height. Default is used if 0.
+	MaxBlocksRange uint64 `protobuf:"varint,14,opt,name=max_blocks_range,json=maxBlocksRange,proto3" json:"max_blocks_range,omitempty"`
+	// Maximum number of transactions returned by GetAccountTransactions. Default is used if 0.
+	MaxAccountTransactions uint64 `protobuf:"varint,15,opt,name=max_account_transactions,json=maxAccountTransactions,proto3" json:"max_account_transactions,omitempty"`
+	// Health check thresholds.
+	Health *RPCHealthConfig `protobuf:"bytes,16,opt,name=health" json:"health,omitempty"`
+	// Maximum number of stored events replayed by Subscribe from_seq. Default is used if 0.
+	MaxReplayEvents uint64 `protobuf:"varint,17,opt,name=max_replay_events,json=maxReplayEvents,proto3" json:"max_replay_events,omitempty"`
 }
 
 func (m *RPCConfig) Reset()                    { *m = RPCConfig{} }
@@ -379,6 +436,334 @@ func (m *RPCConfig) GetConnectionLimits() int32 {
 	return 0
 }
 
+func (m *RPCConfig) GetAdminRpcListen() []string {
+	if m != nil {
+		return m.AdminRpcListen
+	}
+	return nil
+}
+
+func (m *RPCConfig) GetAdminHttpListen() []string {
+	if m != nil {
+		return m.AdminHttpListen
+	}
+	return nil
+}
+
+func (m *RPCConfig) GetTlsCertFile() string {
+	if m != nil {
+		return m.TlsCertFile
+	}
+	return ""
+}
+
+func (m *RPCConfig) GetTlsKeyFile() string {
+	if m != nil {
+		return m.TlsKeyFile
+	}
+	return ""
+}
+
+func (m *RPCConfig) GetTlsClientCaFile() string {
+	if m != nil {
+		return m.TlsClientCaFile
+	}
+	return ""
+}
+
+func (m *RPCConfig) GetCorsAllowedOrigins() []string {
+	if m != nil {
+		return m.CorsAllowedOrigins
+	}
+	return nil
+}
+
+func (m *RPCConfig) GetApiKeys() []*RPCAPIKey {
+	if m != nil {
+		return m.ApiKeys
+	}
+	return nil
+}
+
+func (m *RPCConfig) GetRequireReadAuth() bool {
+	if m != nil {
+		return m.RequireReadAuth
+	}
+	return false
+}
+
+func (m *RPCConfig) GetRateLimits() []*RPCRateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *RPCConfig) GetMaxBlocksRange() uint64 {
+	if m != nil {
+		return m.MaxBlocksRange
+	}
+	return 0
+}
+
+func (m *RPCConfig) GetMaxAccountTransactions() uint64 {
+	if m != nil {
+		return m.MaxAccountTransactions
+	}
+	return 0
+}
+
+func (m *RPCConfig) GetHealth() *RPCHealthConfig {
+	if m != nil {
+		return m.Health
+	}
+	return nil
+}
+
+func (m *RPCConfig) GetMaxReplayEvents() uint64 {
+	if m != nil {
+		return m.MaxReplayEvents
+	}
+	return 0
+}
+
+type RPCHealthConfig struct {
+	// Maximum age of the tail block in number of block intervals. Default is used if 0.
+	MaxTailDelay uint32 `protobuf:"varint,1,opt,name=max_tail_delay,json=maxTailDelay,proto3" json:"max_tail_delay,omitempty"`
+	// Maximum difference between tail height and LIB height. Default is used if 0.
+	MaxLibLag uint64 `protobuf:"varint,2,opt,name=max_lib_lag,json=maxLibLag,proto3" json:"max_lib_lag,omitempty"`
+	// Minimum number of established peers. The node is ready without peers if 0.
+	MinPeers uint32 `protobuf:"varint,3,opt,name=min_peers,json=minPeers,proto3" json:"min_peers,omitempty"`
+	// Maximum number of missed slots among the recent slots of the miner. Default is used if 0.
+	MaxMissedSlots uint32 `protobuf:"varint,4,opt,name=max_missed_slots,json=maxMissedSlots,proto3" json:"max_missed_slots,omitempty"`
+}
+
+func (m *RPCHealthConfig) Reset()                    { *m = RPCHealthConfig{} }
+func (m *RPCHealthConfig) String() string            { return proto.CompactTextString(m) }
+func (*RPCHealthConfig) ProtoMessage()               {}
+func (*RPCHealthConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{5} }
+
+func (m *RPCHealthConfig) GetMaxTailDelay() uint32 {
+	if m != nil {
+		return m.MaxTailDelay
+	}
+	return 0
+}
+
+func (m *RPCHealthConfig) GetMaxLibLag() uint64 {
+	if m != nil {
+		return m.MaxLibLag
+	}
+	return 0
+}
+
+func (m *RPCHealthConfig) GetMinPeers() uint32 {
+	if m != nil {
+		return m.MinPeers
+	}
+	return 0
+}
+
+func (m *RPCHealthConfig) GetMaxMissedSlots() uint32 {
+	if m != nil {
+		return m.MaxMissedSlots
+	}
+	return 0
+}
+
+type RPCAPIKey struct {
+	// API key sent as "Authorization: Bearer {key}" or "X-Api-Key: {key}".
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Granted permissions.["read", "send_transaction", "admin"]
+	Permissions []string `protobuf:"bytes,2,rep,name=permissions" json:"permissions,omitempty"`
+}
+
+func (m *RPCAPIKey) Reset()                    { *m = RPCAPIKey{} }
+func (m *RPCAPIKey) String() string            { return proto.CompactTextString(m) }
+func (*RPCAPIKey) ProtoMessage()               {}
+func (*RPCAPIKey) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{6} }
+
+func (m *RPCAPIKey) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *RPCAPIKey) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+type RPCRateLimit struct {
+	// Method class.["read", "send_transaction", "admin"]
+	MethodClass string `protobuf:"bytes,1,opt,name=method_class,json=methodClass,proto3" json:"method_class,omitempty"`
+	// Requests per second allowed to each client. A client is identified by api key, or ip address.
+	Rate float64 `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	// Maximum number of requests allowed at once.
+	Burst uint32 `protobuf:"varint,3,opt,name=burst,proto3" json:"burst,omitempty"`
+}
+
+func (m *RPCRateLimit) Reset()                    { *m = RPCRateLimit{} }
+func (m *RPCRateLimit) String() string            { return proto.CompactTextString(m) }
+func (*RPCRateLimit) ProtoMessage()               {}
+func (*RPCRateLimit) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{7} }
+
+func (m *RPCRateLimit) GetMethodClass() string {
+	if m != nil {
+		return m.MethodClass
+	}
+	return ""
+}
+
+func (m *RPCRateLimit) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *RPCRateLimit) GetBurst() uint32 {
+	if m != nil {
+		return m.Burst
+	}
+	return 0
+}
+
+type EventStoreConfig struct {
+	// Persist emitted events if true.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Event store dir. "{datadir}.events" is used if empty.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Stored topics. All topics except pending transactions are stored if empty.
+	Topics []string `protobuf:"bytes,3,rep,name=topics" json:"topics,omitempty"`
+	// Maximum number of stored events. Older events are pruned. Unlimited if 0.
+	MaxEvents uint64 `protobuf:"varint,4,opt,name=max_events,json=maxEvents,proto3" json:"max_events,omitempty"`
+}
+
+func (m *EventStoreConfig) Reset()                    { *m = EventStoreConfig{} }
+func (m *EventStoreConfig) String() string            { return proto.CompactTextString(m) }
+func (*EventStoreConfig) ProtoMessage()               {}
+func (*EventStoreConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{8} }
+
+func (m *EventStoreConfig) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *EventStoreConfig) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *EventStoreConfig) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *EventStoreConfig) GetMaxEvents() uint64 {
+	if m != nil {
+		return m.MaxEvents
+	}
+	return 0
+}
+
+type WebhookConfig struct {
+	// Webhook endpoints. Webhook is disabled if empty.
+	Endpoints []*WebhookEndpoint `protobuf:"bytes,1,rep,name=endpoints" json:"endpoints,omitempty"`
+	// Delivery queue dir. "{datadir}.webhook" is used if empty.
+	QueuePath string `protobuf:"bytes,2,opt,name=queue_path,json=queuePath,proto3" json:"queue_path,omitempty"`
+	// Maximum number of delivery attempts. Default is used if 0.
+	MaxAttempts uint32 `protobuf:"varint,3,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// Request timeout in milliseconds. Default is used if 0.
+	Timeout uint32 `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Maximum number of queued deliveries for each endpoint. Default is used if 0.
+	MaxQueueSize uint32 `protobuf:"varint,5,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
+}
+
+func (m *WebhookConfig) Reset()                    { *m = WebhookConfig{} }
+func (m *WebhookConfig) String() string            { return proto.CompactTextString(m) }
+func (*WebhookConfig) ProtoMessage()               {}
+func (*WebhookConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{9} }
+
+func (m *WebhookConfig) GetEndpoints() []*WebhookEndpoint {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
+func (m *WebhookConfig) GetQueuePath() string {
+	if m != nil {
+		return m.QueuePath
+	}
+	return ""
+}
+
+func (m *WebhookConfig) GetMaxAttempts() uint32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *WebhookConfig) GetTimeout() uint32 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *WebhookConfig) GetMaxQueueSize() uint32 {
+	if m != nil {
+		return m.MaxQueueSize
+	}
+	return 0
+}
+
+type WebhookEndpoint struct {
+	// URL which events are posted to.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Topics posted to the endpoint. All topics are posted if empty.
+	Topics []string `protobuf:"bytes,2,rep,name=topics" json:"topics,omitempty"`
+	// Secret key for HMAC-SHA256 signature of the request. Requests are not signed if empty.
+	Secret string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (m *WebhookEndpoint) Reset()                    { *m = WebhookEndpoint{} }
+func (m *WebhookEndpoint) String() string            { return proto.CompactTextString(m) }
+func (*WebhookEndpoint) ProtoMessage()               {}
+func (*WebhookEndpoint) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{10} }
+
+func (m *WebhookEndpoint) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *WebhookEndpoint) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *WebhookEndpoint) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
 type AppConfig struct {
 	// log level
 	LogLevel string `protobuf:"bytes,1,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
@@ -395,7 +780,7 @@ type AppConfig struct {
 func (m *AppConfig) Reset()                    { *m = AppConfig{} }
 func (m *AppConfig) String() string            { return proto.CompactTextString(m) }
 func (*AppConfig) ProtoMessage()               {}
-func (*AppConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{5} }
+func (*AppConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{11} }
 
 func (m *AppConfig) GetLogLevel() string {
 	if m != nil {
@@ -444,7 +829,7 @@ type PprofConfig struct {
 func (m *PprofConfig) Reset()                    { *m = PprofConfig{} }
 func (m *PprofConfig) String() string            { return proto.CompactTextString(m) }
 func (*PprofConfig) ProtoMessage()               {}
-func (*PprofConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{6} }
+func (*PprofConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{12} }
 
 func (m *PprofConfig) GetHttpListen() string {
 	if m != nil {
@@ -475,7 +860,7 @@ type MiscConfig struct {
 func (m *MiscConfig) Reset()                    { *m = MiscConfig{} }
 func (m *MiscConfig) String() string            { return proto.CompactTextString(m) }
 func (*MiscConfig) ProtoMessage()               {}
-func (*MiscConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{7} }
+func (*MiscConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{13} }
 
 func (m *MiscConfig) GetDefaultKeystoreFileCiper() string {
 	if m != nil {
@@ -491,12 +876,14 @@ type StatsConfig struct {
 	// Influxdb config.
 	Influxdb    *InfluxdbConfig `protobuf:"bytes,11,opt,name=influxdb" json:"influxdb,omitempty"`
 	MetricsTags []string        `protobuf:"bytes,12,rep,name=metrics_tags,json=metricsTags" json:"metrics_tags,omitempty"`
+	// Prometheus config.
+	Prometheus *PrometheusConfig `protobuf:"bytes,13,opt,name=prometheus" json:"prometheus,omitempty"`
 }
 
 func (m *StatsConfig) Reset()                    { *m = StatsConfig{} }
 func (m *StatsConfig) String() string            { return proto.CompactTextString(m) }
 func (*StatsConfig) ProtoMessage()               {}
-func (*StatsConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{8} }
+func (*StatsConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{14} }
 
 func (m *StatsConfig) GetEnableMetrics() bool {
 	if m != nil {
@@ -526,6 +913,30 @@ func (m *StatsConfig) GetMetricsTags() []string {
 	return nil
 }
 
+func (m *StatsConfig) GetPrometheus() *PrometheusConfig {
+	if m != nil {
+		return m.Prometheus
+	}
+	return nil
+}
+
+type PrometheusConfig struct {
+	// Listen address of the prometheus exporter.
+	Listen string `protobuf:"bytes,1,opt,name=listen,proto3" json:"listen,omitempty"`
+}
+
+func (m *PrometheusConfig) Reset()                    { *m = PrometheusConfig{} }
+func (m *PrometheusConfig) String() string            { return proto.CompactTextString(m) }
+func (*PrometheusConfig) ProtoMessage()               {}
+func (*PrometheusConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{15} }
+
+func (m *PrometheusConfig) GetListen() string {
+	if m != nil {
+		return m.Listen
+	}
+	return ""
+}
+
 type InfluxdbConfig struct {
 	// Host.
 	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
@@ -542,7 +953,7 @@ type InfluxdbConfig struct {
 func (m *InfluxdbConfig) Reset()                    { *m = InfluxdbConfig{} }
 func (m *InfluxdbConfig) String() string            { return proto.CompactTextString(m) }
 func (*InfluxdbConfig) ProtoMessage()               {}
-func (*InfluxdbConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{9} }
+func (*InfluxdbConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{16} }
 
 func (m *InfluxdbConfig) GetHost() string {
 	if m != nil {
@@ -605,7 +1016,7 @@ type SyncConfig struct {
 func (m *SyncConfig) Reset()                    { *m = SyncConfig{} }
 func (m *SyncConfig) String() string            { return proto.CompactTextString(m) }
 func (*SyncConfig) ProtoMessage()               {}
-func (*SyncConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{10} }
+func (*SyncConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{17} }
 
 func (m *SyncConfig) GetSeedingMinChunkSize() uint64 {
 	if m != nil {
@@ -683,10 +1094,17 @@ func init() {
 	proto.RegisterType((*NetworkConfig)(nil), "medletpb.NetworkConfig")
 	proto.RegisterType((*ChainConfig)(nil), "medletpb.ChainConfig")
 	proto.RegisterType((*RPCConfig)(nil), "medletpb.RPCConfig")
+	proto.RegisterType((*RPCHealthConfig)(nil), "medletpb.RPCHealthConfig")
+	proto.RegisterType((*RPCAPIKey)(nil), "medletpb.RPCAPIKey")
+	proto.RegisterType((*RPCRateLimit)(nil), "medletpb.RPCRateLimit")
+	proto.RegisterType((*EventStoreConfig)(nil), "medletpb.EventStoreConfig")
+	proto.RegisterType((*WebhookConfig)(nil), "medletpb.WebhookConfig")
+	proto.RegisterType((*WebhookEndpoint)(nil), "medletpb.WebhookEndpoint")
 	proto.RegisterType((*AppConfig)(nil), "medletpb.AppConfig")
 	proto.RegisterType((*PprofConfig)(nil), "medletpb.PprofConfig")
 	proto.RegisterType((*MiscConfig)(nil), "medletpb.MiscConfig")
 	proto.RegisterType((*StatsConfig)(nil), "medletpb.StatsConfig")
+	proto.RegisterType((*PrometheusConfig)(nil), "medletpb.PrometheusConfig")
 	proto.RegisterType((*InfluxdbConfig)(nil), "medletpb.InfluxdbConfig")
 	proto.RegisterType((*SyncConfig)(nil), "medletpb.SyncConfig")
 	proto.RegisterEnum("medletpb.StatsConfig_ReportingModule", StatsConfig_ReportingModule_name, StatsConfig_ReportingModule_value)
@@ -695,79 +1113,122 @@ func init() {
 func init() { proto.RegisterFile("config.proto", fileDescriptorConfig) }
 
 var fileDescriptorConfig = []byte{
-	// 1177 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x96, 0xcd, 0x6e, 0x1b, 0x37,
-	0x10, 0xc7, 0x2b, 0x59, 0xb6, 0xb5, 0x23, 0xc9, 0x76, 0x68, 0xc7, 0x59, 0xe7, 0xbb, 0x2a, 0x52,
-	0x38, 0x08, 0x60, 0xa0, 0x4e, 0x2e, 0x3d, 0x14, 0x85, 0x2b, 0xa0, 0xad, 0x11, 0xbb, 0x30, 0x36,
-	0xbe, 0x2f, 0xa8, 0x5d, 0x6a, 0x45, 0x78, 0x45, 0x6e, 0x49, 0xca, 0x1f, 0x39, 0xf5, 0x29, 0x0a,
-	0xf4, 0x5c, 0xf4, 0x39, 0x7a, 0xed, 0xa3, 0xf4, 0x31, 0x8a, 0x99, 0xe5, 0x7e, 0x48, 0xe8, 0x4d,
-	0xfc, 0xcf, 0x6f, 0x66, 0xb8, 0xc3, 0xe1, 0x50, 0x30, 0x4c, 0xb4, 0x9a, 0xc9, 0xec, 0xa4, 0x30,
-	0xda, 0x69, 0xd6, 0x5f, 0x88, 0x34, 0x17, 0xae, 0x98, 0x8e, 0xff, 0xed, 0xc2, 0xd6, 0x84, 0x4c,
-	0xec, 0x04, 0xb6, 0xb2, 0x5c, 0x4f, 0x79, 0x1e, 0x76, 0x5e, 0x77, 0x8e, 0x07, 0xa7, 0x87, 0x27,
-	0x15, 0x75, 0xf2, 0x13, 0xe9, 0x25, 0x17, 0x79, 0x8a, 0x7d, 0x03, 0xdb, 0x4a, 0xb8, 0x3b, 0x6d,
-	0x6e, 0xc2, 0x2e, 0x39, 0x3c, 0x69, 0x1c, 0x7e, 0x29, 0x0d, 0xde, 0xa3, 0xe2, 0xd8, 0x3b, 0xd8,
-	0x4c, 0xe6, 0x5c, 0xaa, 0x70, 0x83, 0x1c, 0x1e, 0x37, 0x0e, 0x13, 0x94, 0x3d, 0x5e, 0x32, 0xec,
-	0x0d, 0x6c, 0x98, 0x22, 0x09, 0x7b, 0x84, 0xee, 0x37, 0x68, 0x74, 0x35, 0xf1, 0x20, 0xda, 0x31,
-	0xa6, 0x75, 0xdc, 0xd9, 0x30, 0x5d, 0x8f, 0xf9, 0x09, 0xe5, 0x2a, 0x26, 0x31, 0xec, 0x18, 0x7a,
-	0x0b, 0x69, 0x93, 0x50, 0x10, 0x7b, 0xd0, 0xb0, 0x97, 0xd2, 0x26, 0x1e, 0x25, 0x02, 0xb3, 0xf3,
-	0xa2, 0x08, 0x67, 0xeb, 0xd9, 0xcf, 0x8a, 0xa2, 0xca, 0xce, 0x8b, 0x82, 0xbd, 0x85, 0x9e, 0x7d,
-	0x50, 0x49, 0xf8, 0x4f, 0x67, 0x3d, 0xe2, 0xa7, 0x07, 0x55, 0x47, 0x44, 0x64, 0x3c, 0x81, 0x61,
-	0xbb, 0x8e, 0xec, 0x08, 0xfa, 0xf4, 0xa1, 0xb1, 0x4c, 0xa9, 0xe2, 0xa3, 0x68, 0x9b, 0xd6, 0xe7,
-	0x29, 0x0b, 0x61, 0x3b, 0xe5, 0x8e, 0xa7, 0xd2, 0x84, 0x83, 0xd7, 0x9d, 0xe3, 0x20, 0xaa, 0x96,
-	0xe3, 0xbf, 0x3b, 0x30, 0x5a, 0x29, 0x2e, 0x63, 0xd0, 0xb3, 0x42, 0x60, 0x88, 0x8d, 0xe3, 0x20,
-	0xa2, 0xdf, 0xec, 0x10, 0xb6, 0x72, 0x69, 0x9d, 0x50, 0x61, 0x97, 0x54, 0xbf, 0x62, 0xaf, 0x60,
-	0x50, 0x18, 0x79, 0xcb, 0x9d, 0x88, 0x6f, 0xc4, 0x03, 0x9d, 0x42, 0x10, 0x81, 0x97, 0x3e, 0x8a,
-	0x07, 0xf6, 0x02, 0xc0, 0x9f, 0x15, 0xee, 0xaa, 0x47, 0xbb, 0x0a, 0xbc, 0x72, 0x9e, 0xb2, 0x1f,
-	0xe0, 0xa5, 0xd1, 0x4b, 0x27, 0x62, 0xc7, 0xa7, 0xb9, 0x88, 0xf1, 0xb3, 0xe2, 0x5c, 0xeb, 0x22,
-	0x96, 0xca, 0x09, 0x73, 0xcb, 0xf3, 0x70, 0x93, 0x5c, 0x9e, 0x12, 0x75, 0x8d, 0x10, 0x96, 0xe1,
-	0x42, 0xeb, 0xe2, 0xdc, 0x13, 0xe3, 0xbf, 0x36, 0x60, 0xd0, 0x3a, 0x6d, 0xfc, 0xd6, 0x4c, 0x28,
-	0x61, 0xa5, 0xa5, 0x36, 0x0a, 0xa2, 0x6a, 0x89, 0x5f, 0x71, 0x23, 0x1e, 0xb0, 0x08, 0x43, 0x32,
-	0xf8, 0x15, 0x6e, 0xd2, 0x3a, 0x6e, 0x5c, 0xbc, 0x90, 0x4a, 0x84, 0x07, 0xaf, 0x3b, 0xc7, 0xfd,
-	0x28, 0x20, 0xe5, 0x52, 0x2a, 0xc1, 0x9e, 0x42, 0x3f, 0xd1, 0x52, 0x4d, 0xb9, 0x15, 0xe1, 0x63,
-	0x72, 0xac, 0xd7, 0xec, 0x00, 0x36, 0xd1, 0xc9, 0x84, 0x87, 0x64, 0x28, 0x17, 0xec, 0x25, 0x40,
-	0xc1, 0xad, 0x2d, 0xe6, 0x06, 0x7d, 0x9e, 0xf8, 0xaa, 0xd4, 0x0a, 0x7b, 0x07, 0x8f, 0xac, 0xcc,
-	0x14, 0x77, 0x4b, 0x23, 0xe2, 0x44, 0x16, 0x73, 0x61, 0x6c, 0x18, 0x52, 0x65, 0xf7, 0x6a, 0xc3,
-	0xa4, 0xd4, 0xd9, 0x31, 0xec, 0x4d, 0x73, 0x9d, 0xdc, 0xc4, 0x09, 0x4f, 0xe6, 0x22, 0xb6, 0xf2,
-	0xb3, 0x08, 0x8f, 0xa8, 0x2a, 0x3b, 0xa4, 0x4f, 0x50, 0xfe, 0x24, 0x3f, 0x0b, 0xf6, 0x35, 0xec,
-	0x3a, 0x2e, 0xf3, 0x36, 0xf8, 0x94, 0xc0, 0x11, 0xca, 0x2b, 0x5c, 0x19, 0xb1, 0xd0, 0x3a, 0x2f,
-	0xb9, 0x67, 0x25, 0x47, 0xf2, 0x95, 0xd6, 0x39, 0x71, 0xa7, 0xf0, 0xd8, 0x19, 0xae, 0x2c, 0x4f,
-	0x9c, 0xd4, 0xaa, 0x45, 0x3f, 0x27, 0x7a, 0xbf, 0x65, 0xac, 0x7d, 0x42, 0xd8, 0xc6, 0xe3, 0xc7,
-	0x6e, 0x78, 0x51, 0x56, 0xdf, 0x2f, 0xc7, 0x7f, 0x74, 0x20, 0xa8, 0xaf, 0x1a, 0xd6, 0xdc, 0x14,
-	0x49, 0xec, 0xbb, 0xaa, 0xec, 0xb5, 0xc0, 0x14, 0xc9, 0x45, 0xdd, 0x58, 0x73, 0xe7, 0x8a, 0x78,
-	0xa5, 0xeb, 0x00, 0xa5, 0x35, 0x60, 0xa1, 0xd3, 0x65, 0x2e, 0xc2, 0x8d, 0x06, 0xb8, 0x24, 0x05,
-	0x6b, 0x9c, 0x68, 0xa5, 0x44, 0xb9, 0xf7, 0x5c, 0x2e, 0xa4, 0xb3, 0xd4, 0x80, 0x9b, 0xd1, 0x5e,
-	0x63, 0xb8, 0x20, 0x7d, 0xfc, 0x67, 0x07, 0x82, 0xfa, 0x22, 0xb2, 0x67, 0x10, 0xe4, 0x3a, 0x8b,
-	0x73, 0x71, 0x2b, 0xca, 0xd9, 0x15, 0x44, 0xfd, 0x5c, 0x67, 0x17, 0xb8, 0xc6, 0x5b, 0x86, 0xc6,
-	0x99, 0xcc, 0x45, 0xd5, 0x5f, 0xb9, 0xce, 0x7e, 0x94, 0xb9, 0x60, 0x4f, 0x00, 0x7f, 0xc6, 0x3c,
-	0x13, 0x74, 0x13, 0x46, 0xd1, 0x56, 0xae, 0xb3, 0xb3, 0x0c, 0xf7, 0xb2, 0x59, 0x14, 0x46, 0xcf,
-	0xfc, 0xec, 0x69, 0x8d, 0x94, 0x2b, 0x94, 0xab, 0x91, 0x42, 0x0c, 0x56, 0xf0, 0x56, 0x18, 0x2b,
-	0xb5, 0xa2, 0x09, 0x14, 0x44, 0xd5, 0x72, 0xac, 0x60, 0xd0, 0xe2, 0xd7, 0x6b, 0x54, 0x6e, 0xb4,
-	0x5d, 0xa3, 0x97, 0x00, 0x49, 0xb1, 0x44, 0x8f, 0x66, 0xb3, 0x2d, 0x05, 0xed, 0x0b, 0xb1, 0xa8,
-	0xec, 0xfe, 0xf2, 0x36, 0xca, 0xf8, 0x23, 0x40, 0x33, 0xc6, 0xd8, 0x77, 0xf0, 0x2c, 0x15, 0x33,
-	0xbe, 0xcc, 0x1d, 0xde, 0x75, 0xeb, 0xb4, 0x11, 0x54, 0x05, 0x6c, 0x60, 0x61, 0x7c, 0xfa, 0xd0,
-	0x23, 0x1f, 0x3d, 0x81, 0x75, 0x99, 0xa0, 0x7d, 0xfc, 0x5b, 0x17, 0x06, 0xad, 0x01, 0xca, 0xde,
-	0xc0, 0x8e, 0x50, 0x74, 0xeb, 0x17, 0xc2, 0x19, 0x99, 0x58, 0x8a, 0xd0, 0x8f, 0x46, 0xa5, 0x7a,
-	0x59, 0x8a, 0xec, 0x0a, 0xf6, 0x8c, 0x28, 0xb4, 0x71, 0x52, 0x65, 0xd5, 0x61, 0x63, 0x37, 0xec,
-	0x9c, 0xbe, 0xf9, 0xdf, 0xc1, 0x7c, 0x12, 0x55, 0x74, 0xd9, 0x07, 0xd1, 0xae, 0x59, 0x15, 0xd8,
-	0x07, 0xe8, 0x4b, 0x35, 0xcb, 0x97, 0xf7, 0xe9, 0x94, 0x86, 0xe1, 0xe0, 0x34, 0x6c, 0x22, 0x9d,
-	0x7b, 0x8b, 0x3f, 0x92, 0x9a, 0x64, 0x5f, 0xc2, 0xd0, 0xef, 0x33, 0x76, 0x3c, 0xb3, 0xe1, 0x90,
-	0x1a, 0x6e, 0xe0, 0xb5, 0x6b, 0x9e, 0xd9, 0xf1, 0x2b, 0xd8, 0x5d, 0x4b, 0xce, 0x86, 0xd0, 0xaf,
-	0x22, 0xee, 0x7d, 0x31, 0xbe, 0x87, 0x9d, 0xd5, 0xf8, 0x38, 0x6b, 0xe7, 0xda, 0x3a, 0x5f, 0x3c,
-	0xfa, 0x8d, 0x1a, 0x06, 0xa1, 0xf3, 0x1a, 0x45, 0xf4, 0x9b, 0xed, 0x40, 0x37, 0x9d, 0xfa, 0x13,
-	0xea, 0xa6, 0x53, 0x64, 0x96, 0x56, 0x18, 0xea, 0xa7, 0x20, 0xa2, 0xdf, 0x38, 0xa6, 0x70, 0xc4,
-	0xdc, 0x69, 0x93, 0xd2, 0xd4, 0x0c, 0xa2, 0x7a, 0x3d, 0xfe, 0xbd, 0x07, 0xd0, 0xbc, 0x1f, 0xec,
-	0x3d, 0x1c, 0xe2, 0x58, 0xa7, 0x92, 0x4a, 0x15, 0x27, 0xf3, 0xa5, 0xba, 0x29, 0x6f, 0x36, 0x6e,
-	0xa4, 0x17, 0xed, 0x7b, 0xeb, 0xa5, 0x54, 0x13, 0xb4, 0xd1, 0xcd, 0x6e, 0x3b, 0xf1, 0xfb, 0xb6,
-	0x53, 0x77, 0xd5, 0x89, 0xdf, 0x37, 0x4e, 0xdf, 0xc3, 0xf3, 0x15, 0x27, 0xad, 0x92, 0xa5, 0x31,
-	0x42, 0xb9, 0xb8, 0x10, 0x38, 0xf4, 0xca, 0x7b, 0x72, 0xd4, 0x72, 0xad, 0x89, 0x2b, 0x04, 0xd8,
-	0x09, 0xec, 0xa7, 0xfa, 0x4e, 0xe5, 0x9a, 0xa7, 0xed, 0x94, 0x3d, 0x4a, 0xf9, 0xa8, 0x32, 0x35,
-	0x09, 0xcf, 0xe0, 0x45, 0xcd, 0xaf, 0x65, 0x74, 0xdc, 0xde, 0xd8, 0xea, 0x41, 0xa9, 0xa0, 0x95,
-	0x94, 0xd7, 0x48, 0xb0, 0x6f, 0xe1, 0x68, 0x2d, 0x65, 0x6b, 0xa0, 0x6e, 0x51, 0xe2, 0xc3, 0x95,
-	0xc4, 0xcd, 0x64, 0xfd, 0x0a, 0x46, 0x0b, 0xa9, 0xe4, 0x62, 0xb9, 0xf0, 0xdf, 0xb7, 0x4d, 0xd9,
-	0x86, 0x5e, 0x2c, 0x3f, 0xe9, 0x2d, 0xb6, 0xf4, 0xaf, 0x4b, 0x61, 0x5d, 0xf3, 0xcc, 0x01, 0x71,
-	0xbb, 0x5e, 0xaf, 0xde, 0x36, 0x44, 0x67, 0x52, 0x49, 0x3b, 0x17, 0x26, 0x76, 0x72, 0x21, 0xf4,
-	0xd2, 0x51, 0xcf, 0x8e, 0xa2, 0xdd, 0x4a, 0xbf, 0x2e, 0x65, 0xf6, 0x01, 0x0e, 0xe9, 0xf9, 0xc4,
-	0x79, 0x7c, 0xcb, 0x69, 0xe8, 0xcd, 0x85, 0xcc, 0xe6, 0x8e, 0x1e, 0xb4, 0x5e, 0x74, 0x80, 0xd6,
-	0xb3, 0xda, 0xf8, 0x33, 0xd9, 0xa6, 0x5b, 0xf4, 0xff, 0xed, 0xfd, 0x7f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xc9, 0x10, 0xe2, 0x11, 0xcf, 0x09, 0x00, 0x00,
+	// 1858 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x57, 0x5f, 0x6f, 0x23, 0x49,
+	0x11, 0xc7, 0xb1, 0x93, 0xd8, 0xe5, 0x38, 0xf6, 0xf6, 0xee, 0x66, 0x67, 0x77, 0x6f, 0x97, 0x60,
+	0x58, 0x94, 0xbb, 0x95, 0x02, 0xb7, 0x77, 0xd2, 0xf1, 0x47, 0xe8, 0x94, 0x33, 0x07, 0xbb, 0x4a,
+	0x02, 0x61, 0x12, 0x89, 0x07, 0x1e, 0x46, 0xed, 0x99, 0x8e, 0xdd, 0x4a, 0xcf, 0xf4, 0x6c, 0x77,
+	0x4f, 0x62, 0xdf, 0x07, 0xe1, 0x91, 0x17, 0xc4, 0xe7, 0xe0, 0x15, 0x21, 0x3e, 0x00, 0x1f, 0x82,
+	0x0f, 0x81, 0xaa, 0xba, 0xc7, 0x1e, 0x1b, 0xde, 0xa6, 0x7f, 0xf5, 0xab, 0x9e, 0xfa, 0x37, 0x55,
+	0x35, 0x70, 0x90, 0xea, 0xe2, 0x56, 0xce, 0x4e, 0x4b, 0xa3, 0x9d, 0x66, 0xdd, 0x5c, 0x64, 0x4a,
+	0xb8, 0x72, 0x3a, 0xfe, 0x77, 0x1b, 0xf6, 0x26, 0x24, 0x62, 0xa7, 0xb0, 0x37, 0x53, 0x7a, 0xca,
+	0x55, 0xd4, 0x3a, 0x6e, 0x9d, 0xf4, 0xdf, 0x1d, 0x9d, 0xd6, 0xac, 0xd3, 0xdf, 0x12, 0xee, 0x79,
+	0x71, 0x60, 0xb1, 0xcf, 0x61, 0xbf, 0x10, 0xee, 0x41, 0x9b, 0xbb, 0x68, 0x87, 0x14, 0x9e, 0xad,
+	0x15, 0x7e, 0xe7, 0x05, 0x41, 0xa3, 0xe6, 0xb1, 0xb7, 0xb0, 0x9b, 0xce, 0xb9, 0x2c, 0xa2, 0x36,
+	0x29, 0x3c, 0x5d, 0x2b, 0x4c, 0x10, 0x0e, 0x74, 0xcf, 0x61, 0x6f, 0xa0, 0x6d, 0xca, 0x34, 0xea,
+	0x10, 0xf5, 0xf1, 0x9a, 0x1a, 0x5f, 0x4d, 0x02, 0x11, 0xe5, 0xec, 0x97, 0xd0, 0x17, 0xf7, 0xa2,
+	0x70, 0x89, 0x75, 0xda, 0x88, 0x68, 0x97, 0xe8, 0x2f, 0xd6, 0xf4, 0x6f, 0x51, 0x78, 0x8d, 0xb2,
+	0xa0, 0x05, 0x62, 0x85, 0xa0, 0x0f, 0x0f, 0x62, 0x3a, 0xd7, 0xfa, 0x2e, 0xda, 0xdb, 0xf6, 0xe1,
+	0x8f, 0x5e, 0x50, 0xfb, 0x10, 0x78, 0xe8, 0x83, 0x75, 0xdc, 0xd9, 0x28, 0xdb, 0xf6, 0xe1, 0x1a,
+	0xe1, 0xda, 0x07, 0xe2, 0xb0, 0x13, 0xe8, 0xe4, 0xd2, 0xa6, 0x91, 0x20, 0xee, 0x93, 0x35, 0xf7,
+	0x52, 0xda, 0x34, 0x50, 0x89, 0x81, 0xde, 0xf2, 0xb2, 0x8c, 0x6e, 0xb7, 0xbd, 0x3d, 0x2b, 0xcb,
+	0xda, 0x5b, 0x5e, 0x96, 0xec, 0x53, 0xe8, 0xd8, 0x65, 0x91, 0x46, 0xff, 0x68, 0x6d, 0xdf, 0x78,
+	0xbd, 0x2c, 0x56, 0x37, 0x22, 0x65, 0x3c, 0x81, 0x83, 0x66, 0xde, 0xd8, 0x73, 0xe8, 0x52, 0x60,
+	0x13, 0x99, 0x51, 0x86, 0x07, 0xf1, 0x3e, 0x9d, 0x3f, 0x64, 0x2c, 0x82, 0xfd, 0x8c, 0x3b, 0x9e,
+	0x49, 0x13, 0xf5, 0x8f, 0x5b, 0x27, 0xbd, 0xb8, 0x3e, 0x8e, 0xff, 0xde, 0x82, 0xc1, 0x46, 0x32,
+	0x19, 0x83, 0x8e, 0x15, 0x02, 0xaf, 0x68, 0x9f, 0xf4, 0x62, 0x7a, 0x66, 0x47, 0xb0, 0xa7, 0xa4,
+	0x75, 0xa2, 0x88, 0x76, 0x08, 0x0d, 0x27, 0xf6, 0x7d, 0xe8, 0x97, 0x46, 0xde, 0x73, 0x27, 0x92,
+	0x3b, 0xb1, 0xa4, 0xac, 0xf7, 0x62, 0x08, 0xd0, 0xb9, 0x58, 0xb2, 0x57, 0x00, 0xa1, 0x36, 0xd0,
+	0xaa, 0x0e, 0x59, 0xd5, 0x0b, 0xc8, 0x87, 0x8c, 0x7d, 0x03, 0xaf, 0x8d, 0xae, 0x9c, 0x48, 0x1c,
+	0x9f, 0x2a, 0x91, 0xa0, 0x5b, 0x89, 0xd2, 0xba, 0x4c, 0x64, 0xe1, 0x84, 0xb9, 0xe7, 0x8a, 0xd2,
+	0x3d, 0x88, 0x5f, 0x10, 0xeb, 0x06, 0x49, 0x18, 0x86, 0x0b, 0xad, 0xcb, 0x0f, 0x81, 0x31, 0xfe,
+	0x5b, 0x1b, 0xfa, 0x8d, 0xea, 0x42, 0x5f, 0x67, 0xa2, 0x10, 0x56, 0x5a, 0x2a, 0xdb, 0x5e, 0x5c,
+	0x1f, 0xd1, 0x8b, 0x3b, 0xb1, 0xc4, 0x20, 0x1c, 0x90, 0x20, 0x9c, 0xd0, 0x48, 0xeb, 0xb8, 0x71,
+	0x49, 0x2e, 0x0b, 0x11, 0x3d, 0x39, 0x6e, 0x9d, 0x74, 0xe3, 0x1e, 0x21, 0x97, 0xb2, 0x10, 0xec,
+	0x05, 0x74, 0x53, 0x2d, 0x8b, 0x29, 0xb7, 0x22, 0x7a, 0x4a, 0x8a, 0xab, 0x33, 0x7b, 0x02, 0xbb,
+	0xa8, 0x64, 0xa2, 0x23, 0x12, 0xf8, 0x03, 0x7b, 0x0d, 0x50, 0x72, 0x6b, 0xcb, 0xb9, 0x41, 0x9d,
+	0x67, 0x21, 0x2a, 0x2b, 0x84, 0xbd, 0x85, 0x47, 0x56, 0xce, 0x0a, 0xee, 0x2a, 0x23, 0x92, 0x54,
+	0x96, 0x73, 0x61, 0x6c, 0x14, 0x51, 0x64, 0x47, 0x2b, 0xc1, 0xc4, 0xe3, 0xec, 0x04, 0x46, 0x53,
+	0xa5, 0xd3, 0xbb, 0x24, 0xe5, 0xe9, 0x5c, 0x24, 0x56, 0x7e, 0x27, 0xa2, 0xe7, 0x14, 0x95, 0x43,
+	0xc2, 0x27, 0x08, 0x5f, 0xcb, 0xef, 0x04, 0xfb, 0x31, 0x0c, 0x1d, 0x97, 0xaa, 0x49, 0x7c, 0x41,
+	0xc4, 0x01, 0xc2, 0x1b, 0x3c, 0x7f, 0x63, 0xa9, 0xb5, 0xf2, 0xbc, 0x97, 0x9e, 0x47, 0xf0, 0x95,
+	0xd6, 0x8a, 0x78, 0xef, 0xe0, 0xa9, 0x33, 0xbc, 0xb0, 0x3c, 0x75, 0x52, 0x17, 0x0d, 0xf6, 0x27,
+	0xc4, 0x7e, 0xdc, 0x10, 0xae, 0x74, 0x22, 0xd8, 0xc7, 0xf4, 0x63, 0x35, 0xbc, 0xf2, 0xd1, 0x0f,
+	0xc7, 0xf1, 0x7f, 0x76, 0xa1, 0xb7, 0xfa, 0xb4, 0x31, 0xe6, 0xa6, 0x4c, 0x93, 0x50, 0x55, 0xbe,
+	0xd6, 0x7a, 0xa6, 0x4c, 0x2f, 0x56, 0x85, 0x35, 0x77, 0xae, 0x4c, 0x36, 0xaa, 0x0e, 0x10, 0xda,
+	0x22, 0xe4, 0x3a, 0xab, 0x94, 0x88, 0xda, 0x6b, 0xc2, 0x25, 0x21, 0x18, 0xe3, 0x54, 0x17, 0x85,
+	0xf0, 0xb6, 0x2b, 0x99, 0x4b, 0x67, 0xa9, 0x00, 0x77, 0xe3, 0xd1, 0x5a, 0x70, 0x41, 0x38, 0xc6,
+	0x98, 0x67, 0xb9, 0x2c, 0x92, 0x86, 0x4d, 0xbb, 0x74, 0xe5, 0x21, 0xe1, 0xf1, 0xca, 0xb0, 0xcf,
+	0xe0, 0x91, 0x67, 0x36, 0xcd, 0xdb, 0x23, 0xea, 0x90, 0x04, 0xef, 0xd7, 0x36, 0x8e, 0x61, 0xe0,
+	0x94, 0x4d, 0x52, 0x61, 0x5c, 0x72, 0x2b, 0x95, 0x88, 0xf6, 0x29, 0x22, 0x7d, 0xa7, 0xec, 0x44,
+	0x18, 0xf7, 0x1b, 0xa9, 0x04, 0x3b, 0x86, 0x03, 0xe4, 0xdc, 0x89, 0xa5, 0xa7, 0x74, 0x7d, 0xb1,
+	0x38, 0x65, 0xcf, 0xc5, 0x92, 0x18, 0x6f, 0x81, 0xd1, 0x2d, 0x4a, 0x62, 0x13, 0x4c, 0xb9, 0xe7,
+	0xf5, 0x88, 0x37, 0xc4, 0xab, 0x48, 0x30, 0xe1, 0x44, 0xfe, 0x29, 0x3c, 0x49, 0xb5, 0xb1, 0x09,
+	0x57, 0x4a, 0x3f, 0x88, 0x2c, 0xd1, 0x46, 0xce, 0x64, 0x61, 0x23, 0x20, 0x0b, 0x19, 0xca, 0xce,
+	0xbc, 0xe8, 0xf7, 0x5e, 0xc2, 0x4e, 0xa1, 0xcb, 0x4b, 0x89, 0x06, 0xd8, 0xa8, 0x7f, 0xdc, 0xfe,
+	0x9f, 0x56, 0x7c, 0x76, 0xf5, 0xe1, 0x5c, 0x2c, 0xe3, 0x7d, 0x5e, 0xca, 0x73, 0xb1, 0xb4, 0x18,
+	0x00, 0x23, 0x3e, 0x56, 0xd2, 0x88, 0xc4, 0x08, 0x9e, 0x25, 0xbc, 0x72, 0x73, 0xfa, 0x9e, 0xba,
+	0xf1, 0x30, 0x08, 0x62, 0xc1, 0xb3, 0xb3, 0xca, 0xcd, 0xd9, 0x57, 0xd0, 0x37, 0xd8, 0x1b, 0x42,
+	0xf4, 0x07, 0xc7, 0xed, 0xcd, 0xb1, 0x13, 0x5f, 0x4d, 0x62, 0xee, 0x04, 0x25, 0x21, 0x06, 0x53,
+	0x3f, 0x52, 0x3e, 0x72, 0xbe, 0x48, 0xa8, 0x1c, 0x6d, 0x62, 0x78, 0x31, 0x13, 0xd1, 0xe1, 0x71,
+	0xeb, 0xa4, 0x13, 0x1f, 0xe6, 0x7c, 0xf1, 0x0d, 0xc1, 0x31, 0xa2, 0xec, 0x67, 0x10, 0x21, 0x93,
+	0xa7, 0xa9, 0xae, 0x0a, 0x97, 0x34, 0x4a, 0xd2, 0x46, 0x43, 0xd2, 0x38, 0xca, 0xf9, 0xe2, 0xcc,
+	0x8b, 0x6f, 0x1a, 0x52, 0xf6, 0x39, 0xec, 0xcd, 0x05, 0x57, 0x6e, 0x1e, 0x8d, 0xa8, 0xd5, 0x3e,
+	0xdf, 0xb0, 0xeb, 0x3d, 0x89, 0xea, 0x89, 0xe8, 0x89, 0xe8, 0x3b, 0xbe, 0xcc, 0x88, 0x52, 0xf1,
+	0x65, 0x42, 0x63, 0xc6, 0x46, 0x8f, 0xe8, 0x2d, 0xc3, 0x9c, 0x2f, 0x62, 0xc2, 0x69, 0x1e, 0xd9,
+	0xf1, 0x5f, 0x5a, 0x30, 0xdc, 0xba, 0x87, 0xfd, 0x08, 0xd0, 0xfc, 0x84, 0x3e, 0xd2, 0x4c, 0x28,
+	0xbe, 0x0c, 0x7d, 0xfa, 0x20, 0xe7, 0x8b, 0x1b, 0x2e, 0xd5, 0xaf, 0x11, 0x63, 0xaf, 0xa1, 0x8f,
+	0x2c, 0x25, 0xa7, 0x89, 0xe2, 0x33, 0x6a, 0x62, 0x9d, 0xb8, 0x97, 0xf3, 0xc5, 0x85, 0x9c, 0x5e,
+	0xf0, 0x19, 0x7b, 0x09, 0x3d, 0x2c, 0xc0, 0x52, 0x60, 0xd7, 0x68, 0xd3, 0x05, 0xdd, 0x5c, 0x16,
+	0x57, 0x22, 0x74, 0x0b, 0x54, 0xce, 0xa5, 0xb5, 0x22, 0x4b, 0xac, 0xd2, 0xa1, 0xea, 0x07, 0x14,
+	0xb9, 0x4b, 0x82, 0xaf, 0x11, 0x1d, 0x7f, 0x0d, 0xbd, 0x55, 0x7a, 0xd9, 0x08, 0xda, 0x77, 0xc2,
+	0x9b, 0xd3, 0x8b, 0xf1, 0x91, 0x1d, 0x43, 0xbf, 0x14, 0x06, 0xef, 0xa1, 0x58, 0xfa, 0x2f, 0xb0,
+	0x09, 0x8d, 0xff, 0x04, 0x07, 0xcd, 0x04, 0xb2, 0x1f, 0xc0, 0x41, 0x2e, 0xdc, 0x5c, 0x67, 0x49,
+	0xaa, 0xb8, 0xb5, 0xe1, 0xb2, 0xbe, 0xc7, 0x26, 0x08, 0xe1, 0x6c, 0xc1, 0x2c, 0x93, 0x4f, 0xad,
+	0x98, 0x9e, 0xb1, 0x85, 0x4e, 0x2b, 0x63, 0x5d, 0x70, 0xc5, 0x1f, 0xc6, 0x0f, 0x30, 0xda, 0x1e,
+	0xec, 0xd8, 0x5b, 0x44, 0x81, 0x33, 0xc0, 0xcf, 0xb7, 0x6e, 0x5c, 0x1f, 0xf1, 0xde, 0x92, 0xbb,
+	0x79, 0x68, 0xf8, 0xf4, 0x8c, 0xdd, 0xde, 0xe9, 0x52, 0xa6, 0x36, 0x34, 0x87, 0x70, 0xc2, 0xce,
+	0x83, 0x11, 0x0a, 0xd9, 0xeb, 0xac, 0xa2, 0x1b, 0xf2, 0xf6, 0xcf, 0x16, 0x0c, 0x36, 0x36, 0x03,
+	0xf6, 0x15, 0xf4, 0x44, 0x91, 0x95, 0x5a, 0x22, 0xbf, 0x75, 0xdc, 0xde, 0xac, 0x95, 0xc0, 0xfd,
+	0x36, 0x30, 0xe2, 0x35, 0x17, 0xdf, 0xf4, 0xb1, 0x12, 0x95, 0x48, 0x1a, 0xb6, 0xf5, 0x08, 0xb9,
+	0x42, 0x03, 0x31, 0x5e, 0x58, 0xba, 0xce, 0x89, 0xbc, 0x74, 0x75, 0x2a, 0x31, 0xf7, 0x67, 0x01,
+	0x42, 0x8f, 0x9d, 0xcc, 0x85, 0xae, 0x5c, 0x48, 0x62, 0x7d, 0xac, 0x4b, 0xc9, 0xdf, 0x4f, 0x4d,
+	0x79, 0x77, 0x55, 0x4a, 0x7f, 0x40, 0x10, 0xbb, 0xf1, 0xf8, 0x1a, 0x86, 0x5b, 0xf6, 0x61, 0xa6,
+	0x2b, 0xa3, 0xea, 0x4c, 0x57, 0x46, 0x35, 0x02, 0xb5, 0xb3, 0x11, 0xa8, 0x23, 0xd8, 0xb3, 0x22,
+	0x35, 0xc2, 0x85, 0xb9, 0x1e, 0x4e, 0xe3, 0xbf, 0xb6, 0xa0, 0xb7, 0xda, 0x5a, 0xb0, 0x1a, 0x95,
+	0x9e, 0x25, 0x4a, 0xdc, 0x8b, 0xfa, 0xd6, 0xae, 0xd2, 0xb3, 0x0b, 0x3c, 0xe3, 0x4a, 0x82, 0x42,
+	0xea, 0x58, 0x61, 0x18, 0x2b, 0x3d, 0xa3, 0x4e, 0xf5, 0x0c, 0xf0, 0x31, 0xe1, 0x33, 0x11, 0x1c,
+	0xdf, 0x53, 0x7a, 0x76, 0x36, 0xc3, 0x7e, 0xb7, 0x5b, 0x96, 0x46, 0xdf, 0x46, 0x9d, 0xed, 0xfd,
+	0xeb, 0x0a, 0xe1, 0x7a, 0xff, 0x22, 0x0e, 0x06, 0xe8, 0x5e, 0x18, 0xac, 0x47, 0x5a, 0xd7, 0x7a,
+	0x71, 0x7d, 0x1c, 0x17, 0xd0, 0x6f, 0xf0, 0xb7, 0x07, 0x8a, 0x37, 0xb4, 0x39, 0x50, 0x5e, 0x03,
+	0xa4, 0x65, 0x85, 0x1a, 0x6b, 0x63, 0x1b, 0x08, 0xca, 0x73, 0x91, 0xd7, 0xf2, 0xb0, 0xe9, 0xac,
+	0x91, 0xf1, 0x39, 0xc0, 0x7a, 0xe7, 0x63, 0xbf, 0x82, 0x97, 0x99, 0xb8, 0xe5, 0x95, 0x72, 0xd4,
+	0x59, 0xb1, 0x88, 0x29, 0x0a, 0x38, 0xed, 0x85, 0x09, 0xaf, 0x8f, 0x02, 0xe5, 0x3c, 0x30, 0x30,
+	0x2e, 0x13, 0x94, 0x8f, 0xff, 0xb5, 0x03, 0xfd, 0xc6, 0xb6, 0xc9, 0xde, 0xc0, 0xa1, 0x2f, 0xf5,
+	0x24, 0x17, 0xce, 0x60, 0xaa, 0xfc, 0x07, 0x30, 0xf0, 0xe8, 0xa5, 0x07, 0xd9, 0x15, 0x8c, 0x8c,
+	0x28, 0xb5, 0x71, 0xb2, 0x98, 0xd5, 0x93, 0x11, 0x73, 0x7a, 0xf8, 0xee, 0xcd, 0xff, 0xdd, 0x62,
+	0x4f, 0xe3, 0x9a, 0xed, 0x87, 0x26, 0x76, 0xf0, 0x0d, 0x80, 0x7d, 0x09, 0x5d, 0x59, 0xdc, 0xaa,
+	0x6a, 0x91, 0x4d, 0x69, 0x73, 0xec, 0xbf, 0x8b, 0xd6, 0x37, 0x7d, 0x08, 0x92, 0x90, 0x92, 0x15,
+	0x33, 0x74, 0x02, 0x34, 0x29, 0x71, 0x7c, 0x66, 0xa3, 0x03, 0xdf, 0x3c, 0x02, 0x76, 0xc3, 0x67,
+	0x96, 0xfd, 0x02, 0xa0, 0x34, 0x1a, 0x7b, 0x83, 0xa8, 0x70, 0x32, 0x6c, 0x2d, 0xf5, 0x57, 0x2b,
+	0x59, 0xb8, 0xbc, 0xc1, 0x1e, 0xff, 0x04, 0x86, 0x5b, 0x86, 0xb3, 0x03, 0xe8, 0xd6, 0xd6, 0x8c,
+	0xbe, 0xc7, 0x0e, 0x01, 0xd6, 0x17, 0x8c, 0x5a, 0xe3, 0xcf, 0x60, 0xb4, 0x7d, 0x61, 0x63, 0xa5,
+	0xf5, 0xc9, 0x08, 0xa7, 0xf1, 0x02, 0x0e, 0x37, 0xfd, 0xc2, 0xe6, 0x32, 0xd7, 0xd6, 0x05, 0x1e,
+	0x3d, 0x23, 0x86, 0x06, 0x50, 0x9d, 0x0c, 0x62, 0x7a, 0x66, 0x87, 0xb0, 0x93, 0x4d, 0x43, 0x65,
+	0xec, 0x64, 0x53, 0xe4, 0x54, 0x56, 0x18, 0xaa, 0xe3, 0x5e, 0x4c, 0xcf, 0xb8, 0x4b, 0xe2, 0x1e,
+	0xf8, 0xa0, 0x4d, 0x46, 0x1f, 0x6c, 0x2f, 0x5e, 0x9d, 0xc7, 0x7f, 0xee, 0x00, 0xac, 0x97, 0x7c,
+	0xf6, 0x05, 0x1c, 0xe1, 0xee, 0x4d, 0xa9, 0x94, 0x45, 0x92, 0xce, 0xab, 0xe2, 0xce, 0x7f, 0xe9,
+	0x2d, 0xea, 0x59, 0x8f, 0x83, 0xf4, 0x52, 0x16, 0x13, 0x94, 0xd1, 0xfa, 0xd5, 0x54, 0xe2, 0x8b,
+	0xa6, 0xd2, 0xce, 0xa6, 0x12, 0x5f, 0xac, 0x95, 0xbe, 0x86, 0x4f, 0x36, 0x94, 0x74, 0x91, 0x56,
+	0xc6, 0xe0, 0xb6, 0xd1, 0x9c, 0x31, 0xcf, 0x1b, 0xaa, 0x2b, 0x86, 0x1f, 0x3a, 0xa7, 0xf0, 0x38,
+	0xd3, 0x0f, 0x85, 0xd2, 0x3c, 0x6b, 0xbe, 0xd2, 0xf7, 0xd6, 0x47, 0xb5, 0x68, 0xfd, 0xc2, 0x33,
+	0x78, 0xb5, 0xe2, 0x6f, 0xbd, 0xd1, 0x71, 0x7b, 0x67, 0xeb, 0xad, 0xbf, 0x26, 0x6d, 0xbc, 0xf2,
+	0x06, 0x19, 0xec, 0xe7, 0xf0, 0x7c, 0xeb, 0x95, 0x8d, 0xad, 0x77, 0xcf, 0x0f, 0xfe, 0x8d, 0x17,
+	0xaf, 0xd7, 0xdf, 0x1f, 0xc2, 0x20, 0x97, 0x85, 0xcc, 0xab, 0x3c, 0xf8, 0xb7, 0x1f, 0x3a, 0xa7,
+	0x07, 0xbd, 0x4b, 0x9f, 0xe2, 0xa7, 0xf4, 0xb1, 0x12, 0xd6, 0xad, 0xff, 0x45, 0x80, 0x78, 0xc3,
+	0x80, 0xd7, 0x3f, 0x20, 0x48, 0xbd, 0x95, 0x85, 0xb4, 0x73, 0x61, 0x92, 0xba, 0x5b, 0xf7, 0x3d,
+	0xb5, 0xc6, 0x6f, 0x3c, 0xcc, 0xbe, 0x84, 0x23, 0xfa, 0xc7, 0xc1, 0x1d, 0xe4, 0x9e, 0xd3, 0x66,
+	0x3a, 0x17, 0x72, 0x36, 0x77, 0xf4, 0xd7, 0xd1, 0x89, 0x9f, 0xa0, 0xf4, 0x6c, 0x25, 0x7c, 0x4f,
+	0xb2, 0xe9, 0x1e, 0xfd, 0xd4, 0x7f, 0xf1, 0xdf, 0x01, 0x00, 0x58, 0x49, 0x14, 0xa6, 0xe4, 0x0f,
+	0x00, 0x00,
 }
@@ -17,6 +17,7 @@ package metrics
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"runtime"
 	"strings"
@@ -26,6 +27,7 @@ import (
 	"github.com/medibloc/go-medibloc/util/logging"
 	metrics "github.com/rcrowley/go-metrics"
 	"github.com/rcrowley/go-metrics/exp"
+	"github.com/sirupsen/logrus"
 )
 
 const (
@@ -38,6 +40,8 @@ const (
 var (
 	enable = false
 	quitCh chan (bool)
+
+	prometheusServer *http.Server
 )
 
 // Medlet interface breaks cycle import dependency.
@@ -60,31 +64,60 @@ func EnableMetrics() {
 	exp.Exp(metrics.DefaultRegistry)
 }
 
+// Enabled returns true if metrics are collected.
+func Enabled() bool {
+	return enable
+}
+
 // Start metrics monitor
 func Start(med Medlet) {
 	logging.Info("Starting Metrics...")
 
-	go (func() {
-		tags := make(map[string]string)
-		metricsConfig := med.Config().Stats.MetricsTags
-		for _, v := range metricsConfig {
-			values := strings.Split(v, ":")
-			if len(values) != 2 {
-				continue
-			}
-			tags[values[0]] = values[1]
+	cfg := med.Config().Stats
+	tags := make(map[string]string)
+	for _, v := range cfg.MetricsTags {
+		values := strings.Split(v, ":")
+		if len(values) != 2 {
+			continue
 		}
-		tags[chainID] = fmt.Sprintf("%d", med.Config().Global.ChainId)
-		go collectSystemMetrics()
-		InfluxDBWithTags(metrics.DefaultRegistry, interval, med.Config().Stats.Influxdb.Host, med.Config().Stats.Influxdb.Db, med.Config().Stats.Influxdb.User, med.Config().Stats.Influxdb.Password, tags)
-
-		logging.Info("Started Metrics.")
-
-	})()
+		tags[values[0]] = values[1]
+	}
+	tags[chainID] = fmt.Sprintf("%d", med.Config().Global.ChainId)
+
+	quitCh = make(chan bool, 1)
+	go collectSystemMetrics()
+
+	for _, module := range reportingModules(cfg) {
+		switch module {
+		case medletpb.StatsConfig_Influxdb:
+			go InfluxDBWithTags(metrics.DefaultRegistry, interval, cfg.Influxdb.Host, cfg.Influxdb.Db, cfg.Influxdb.User, cfg.Influxdb.Password, tags)
+		case medletpb.StatsConfig_Prometheus:
+			prometheusServer = newPrometheusServer(cfg.Prometheus.GetListen(), tags)
+			go func(server *http.Server) {
+				logging.Console().WithFields(logrus.Fields{
+					"listen": server.Addr,
+				}).Info("Starting prometheus exporter...")
+				if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+					logging.Console().WithFields(logrus.Fields{
+						"listen": server.Addr,
+						"err":    err,
+					}).Error("Failed to start prometheus exporter.")
+				}
+			}(prometheusServer)
+		}
+	}
 
 	logging.Info("Started Metrics.")
 }
 
+// reportingModules returns reporting modules of the config. Influxdb is used if no module is configured.
+func reportingModules(cfg *medletpb.StatsConfig) []medletpb.StatsConfig_ReportingModule {
+	if len(cfg.ReportingModule) == 0 {
+		return []medletpb.StatsConfig_ReportingModule{medletpb.StatsConfig_Influxdb}
+	}
+	return cfg.ReportingModule
+}
+
 func collectSystemMetrics() {
 	memstats := make([]*runtime.MemStats, 2)
 	for i := 0; i < len(memstats); i++ {
@@ -119,6 +152,10 @@ func Stop() {
 	logging.Info("Stopping Metrics...")
 
 	quitCh <- true
+	if prometheusServer != nil {
+		prometheusServer.Close()
+		prometheusServer = nil
+	}
 }
 
 // NewCounter create a new metrics Counter
@@ -0,0 +1,144 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rcrowley/go-metrics"
+)
+
+// PrometheusPath is the http path of the prometheus exporter.
+const PrometheusPath = "/metrics"
+
+var quantiles = []float64{0.5, 0.75, 0.95, 0.99, 0.999}
+
+// PrometheusHandler returns a http handler which exports metrics of the registry in the prometheus text format.
+// Tags are added to every sample as labels.
+func PrometheusHandler(r metrics.Registry, tags map[string]string) http.Handler {
+	labels := prometheusLabels(tags)
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		w.Write(prometheusText(r, labels))
+	})
+}
+
+func newPrometheusServer(listen string, tags map[string]string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle(PrometheusPath, PrometheusHandler(metrics.DefaultRegistry, tags))
+	return &http.Server{
+		Addr:    listen,
+		Handler: mux,
+	}
+}
+
+func prometheusText(r metrics.Registry, labels string) []byte {
+	var names []string
+	all := make(map[string]interface{})
+	r.Each(func(name string, i interface{}) {
+		names = append(names, name)
+		all[name] = i
+	})
+	sort.Strings(names)
+
+	buf := new(bytes.Buffer)
+	for _, name := range names {
+		pname := prometheusName(name)
+		switch metric := all[name].(type) {
+		case metrics.Counter:
+			writeSample(buf, pname, "gauge", labels, float64(metric.Count()))
+		case metrics.Gauge:
+			writeSample(buf, pname, "gauge", labels, float64(metric.Value()))
+		case metrics.GaugeFloat64:
+			writeSample(buf, pname, "gauge", labels, metric.Value())
+		case metrics.Meter:
+			writeSample(buf, pname, "counter", labels, float64(metric.Count()))
+		case metrics.Histogram:
+			ms := metric.Snapshot()
+			writeSummary(buf, pname, labels, ms.Percentiles(quantiles), float64(ms.Sum()), ms.Count(), 1)
+		case metrics.Timer:
+			ms := metric.Snapshot()
+			writeSummary(buf, pname+"_seconds", labels, ms.Percentiles(quantiles), float64(ms.Sum()), ms.Count(),
+				float64(time.Second))
+		}
+	}
+	return buf.Bytes()
+}
+
+func writeSample(buf *bytes.Buffer, name, typ, labels string, value float64) {
+	fmt.Fprintf(buf, "# TYPE %s %s\n", name, typ)
+	fmt.Fprintf(buf, "%s%s %s\n", name, wrapLabels(labels), formatFloat(value))
+}
+
+// writeSummary writes a summary. Values are divided by unit.
+func writeSummary(buf *bytes.Buffer, name, labels string, ps []float64, sum float64, count int64, unit float64) {
+	fmt.Fprintf(buf, "# TYPE %s summary\n", name)
+	for i, q := range quantiles {
+		quantile := "quantile=\"" + formatFloat(q) + "\""
+		if labels != "" {
+			quantile = labels + "," + quantile
+		}
+		fmt.Fprintf(buf, "%s%s %s\n", name, wrapLabels(quantile), formatFloat(ps[i]/unit))
+	}
+	fmt.Fprintf(buf, "%s_sum%s %s\n", name, wrapLabels(labels), formatFloat(sum/unit))
+	fmt.Fprintf(buf, "%s_count%s %d\n", name, wrapLabels(labels), count)
+}
+
+func wrapLabels(labels string) string {
+	if labels == "" {
+		return ""
+	}
+	return "{" + labels + "}"
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// prometheusName converts a metric name to a valid prometheus name. e.g. med.net.peers -> med_net_peers
+func prometheusName(name string) string {
+	b := []byte(name)
+	for i, c := range b {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == ':') {
+			b[i] = '_'
+		}
+	}
+	if len(b) > 0 && b[0] >= '0' && b[0] <= '9' {
+		return "_" + string(b)
+	}
+	return string(b)
+}
+
+func prometheusLabels(tags map[string]string) string {
+	var keys []string
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var labels []string
+	for _, k := range keys {
+		value := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(tags[k])
+		labels = append(labels, fmt.Sprintf("%s=\"%s\"", strings.Replace(prometheusName(k), ":", "_", -1), value))
+	}
+	return strings.Join(labels, ",")
+}
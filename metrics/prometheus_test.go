@@ -0,0 +1,67 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package metrics
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrometheusHandler(t *testing.T) {
+	r := metrics.NewRegistry()
+	metrics.GetOrRegisterGauge("med.chain.height", r).Update(10)
+	metrics.GetOrRegisterMeter("med.chain.txs", r).Mark(3)
+	metrics.GetOrRegisterCounter("med.webhook.queued", r).Inc(2)
+	metrics.GetOrRegisterTimer("med.dpos.block.produce", r).Update(2 * time.Second)
+
+	server := httptest.NewServer(PrometheusHandler(r, map[string]string{"chainID": "1", "node": `a"b`}))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	expected := `# TYPE med_chain_height gauge
+med_chain_height{chainID="1",node="a\"b"} 10
+# TYPE med_chain_txs counter
+med_chain_txs{chainID="1",node="a\"b"} 3
+# TYPE med_dpos_block_produce_seconds summary
+med_dpos_block_produce_seconds{chainID="1",node="a\"b",quantile="0.5"} 2
+med_dpos_block_produce_seconds{chainID="1",node="a\"b",quantile="0.75"} 2
+med_dpos_block_produce_seconds{chainID="1",node="a\"b",quantile="0.95"} 2
+med_dpos_block_produce_seconds{chainID="1",node="a\"b",quantile="0.99"} 2
+med_dpos_block_produce_seconds{chainID="1",node="a\"b",quantile="0.999"} 2
+med_dpos_block_produce_seconds_sum{chainID="1",node="a\"b"} 2
+med_dpos_block_produce_seconds_count{chainID="1",node="a\"b"} 1
+# TYPE med_webhook_queued gauge
+med_webhook_queued{chainID="1",node="a\"b"} 2
+`
+	assert.Equal(t, expected, string(body))
+}
+
+func TestPrometheusName(t *testing.T) {
+	assert.Equal(t, "med_net_packets_in_sync_meta", prometheusName("med.net.packets.in.sync-meta"))
+	assert.Equal(t, "_1st", prometheusName("1st"))
+}
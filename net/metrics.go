@@ -28,6 +28,9 @@ var (
 
 	metricsPacketsOut = metrics.NewMeter("med.net.packets.out")
 	metricsBytesOut   = metrics.NewMeter("med.net.bytes.out")
+
+	metricsPeers            = metrics.NewGauge("med.net.peers")
+	metricsEstablishedPeers = metrics.NewGauge("med.net.peers.established")
 )
 
 func metricsPacketsInByMessageName(messageName string, size uint64) {
@@ -17,6 +17,7 @@ package net
 
 import (
 	"context"
+	"time"
 
 	"errors"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/libp2p/go-libp2p-swarm"
 	"github.com/libp2p/go-libp2p/p2p/host/basic"
 	"github.com/medibloc/go-medibloc/util/logging"
+	ma "github.com/multiformats/go-multiaddr"
 	"github.com/sirupsen/logrus"
 )
 
@@ -188,6 +190,55 @@ func (node *Node) EstablishedPeersCount() int32 {
 	return node.streamManager.EstablishedCount()
 }
 
+// ListenAddrs return listening addresses of the node.
+func (node *Node) ListenAddrs() []string {
+	if node.host == nil {
+		return node.config.Listen
+	}
+	var addrs []string
+	for _, addr := range node.host.Addrs() {
+		addrs = append(addrs, addr.String())
+	}
+	return addrs
+}
+
+// PeerStats return statistics of connected streams.
+func (node *Node) PeerStats() []*StreamStats {
+	return node.streamManager.Stats()
+}
+
+// AddPeer add a peer with ipfs address and connect to it.
+func (node *Node) AddPeer(ipfsAddr string) error {
+	addr, err := ma.NewMultiaddr(ipfsAddr)
+	if err != nil {
+		return err
+	}
+	pid, _, err := ParseFromIPFSAddr(addr)
+	if err != nil {
+		return err
+	}
+	if node.streamManager.IsBanned(pid.Pretty()) {
+		return ErrPeerBanned
+	}
+	node.routeTable.AddIPFSPeerAddr(addr)
+	node.routeTable.SyncWithPeer(pid)
+	return nil
+}
+
+// RemovePeer close the stream to a peer.
+func (node *Node) RemovePeer(peerID string) error {
+	if node.streamManager.FindByPeerID(peerID) == nil {
+		return ErrPeerIsNotConnected
+	}
+	node.streamManager.CloseStream(peerID, ErrPeerRemoved)
+	return nil
+}
+
+// BanPeer ban a peer for the duration. If duration is 0, the peer is banned until restart.
+func (node *Node) BanPeer(peerID string, duration time.Duration) {
+	node.streamManager.Ban(peerID, duration)
+}
+
 // RouteTable return route table.
 func (node *Node) RouteTable() *RouteTable {
 	return node.routeTable
@@ -163,6 +163,30 @@ func (s *Stream) String() string {
 	return fmt.Sprintf("Peer Stream: %s,%s", s.pid.Pretty(), addrStr)
 }
 
+// Stats returns statistics of the stream
+func (s *Stream) Stats() *StreamStats {
+	s.syncMutex.Lock()
+	defer s.syncMutex.Unlock()
+
+	addrStr := ""
+	if s.addr != nil {
+		addrStr = s.addr.String()
+	}
+	msgCount := make(map[string]int)
+	for t, c := range s.msgCount {
+		msgCount[t] = c
+	}
+	return &StreamStats{
+		PeerID:        s.pid.Pretty(),
+		Addr:          addrStr,
+		Established:   s.status == streamStatusHandshakeSucceed,
+		ConnectedAt:   s.connectedAt,
+		LatestReadAt:  s.latestReadAt,
+		LatestWriteAt: s.latestWriteAt,
+		MsgCount:      msgCount,
+	}
+}
+
 // SendProtoMessage send proto msg to buffer
 func (s *Stream) SendProtoMessage(messageName string, pb proto.Message, priority int) error {
 	data, err := proto.Marshal(pb)
@@ -482,7 +506,9 @@ func (s *Stream) writeLoop() {
 
 func (s *Stream) handleMessage(message *MedMessage) error {
 	messageName := message.MessageName()
+	s.syncMutex.Lock()
 	s.msgCount[messageName]++
+	s.syncMutex.Unlock()
 
 	switch messageName {
 	case HELLO:
@@ -44,20 +44,35 @@ const (
 var (
 	ErrExceedMaxStreamNum = errors.New("too many streams connected")
 	ErrElimination        = errors.New("eliminated for low value")
+	ErrPeerBanned         = errors.New("peer is banned")
+	ErrPeerRemoved        = errors.New("peer is removed by admin")
 )
 
 // StreamManager manages all streams
 type StreamManager struct {
 	quitCh           chan bool
 	allStreams       *sync.Map
+	bannedPeers      *sync.Map
 	activePeersCount int32
 }
 
+// StreamStats is a snapshot of a stream's statistics
+type StreamStats struct {
+	PeerID        string
+	Addr          string
+	Established   bool
+	ConnectedAt   int64
+	LatestReadAt  int64
+	LatestWriteAt int64
+	MsgCount      map[string]int
+}
+
 // NewStreamManager return a new stream manager
 func NewStreamManager() *StreamManager {
 	return &StreamManager{
 		quitCh:           make(chan bool, 1),
 		allStreams:       new(sync.Map),
+		bannedPeers:      new(sync.Map),
 		activePeersCount: 0,
 	}
 }
@@ -107,12 +122,16 @@ func (sm *StreamManager) AddStream(stream *Stream) {
 		stream.Close(ErrExceedMaxStreamNum)
 		return
 	}
+	if sm.IsBanned(stream.pid.Pretty()) {
+		stream.Close(ErrPeerBanned)
+		return
+	}
 
 	logging.WithFields(logrus.Fields{
 		"steam": stream.String(),
 	}).Debug("Added a new stream.")
 
-	atomic.AddInt32(&sm.activePeersCount, 1)
+	metricsPeers.Update(int64(atomic.AddInt32(&sm.activePeersCount, 1)))
 	sm.allStreams.Store(stream.pid.Pretty(), stream)
 	stream.StartLoop()
 }
@@ -127,7 +146,7 @@ func (sm *StreamManager) Remove(pid peer.ID) {
 		// caused by close in AddStream
 		return
 	}
-	atomic.AddInt32(&sm.activePeersCount, -1)
+	metricsPeers.Update(int64(atomic.AddInt32(&sm.activePeersCount, -1)))
 	sm.allStreams.Delete(pid.Pretty())
 }
 
@@ -161,6 +180,7 @@ func (sm *StreamManager) loop() {
 			return
 		case <-ticker.C:
 			sm.cleanup()
+			metricsEstablishedPeers.Update(int64(sm.EstablishedCount()))
 		}
 	}
 }
@@ -228,6 +248,41 @@ func (sm *StreamManager) SendMessageToPeers(messageName string, data []byte, pri
 	return selectedPeersPrettyID
 }
 
+// Stats returns statistics of all streams
+func (sm *StreamManager) Stats() []*StreamStats {
+	stats := make([]*StreamStats, 0)
+	sm.allStreams.Range(func(key, value interface{}) bool {
+		stream := value.(*Stream)
+		stats = append(stats, stream.Stats())
+		return true
+	})
+	return stats
+}
+
+// Ban bans the peer for the duration. If duration is 0, the peer is banned permanently.
+func (sm *StreamManager) Ban(peerID string, duration time.Duration) {
+	var until time.Time
+	if duration > 0 {
+		until = time.Now().Add(duration)
+	}
+	sm.bannedPeers.Store(peerID, until)
+	sm.CloseStream(peerID, ErrPeerBanned)
+}
+
+// IsBanned returns true if the peer is banned
+func (sm *StreamManager) IsBanned(peerID string) bool {
+	v, ok := sm.bannedPeers.Load(peerID)
+	if !ok {
+		return false
+	}
+	until := v.(time.Time)
+	if !until.IsZero() && time.Now().After(until) {
+		sm.bannedPeers.Delete(peerID)
+		return false
+	}
+	return true
+}
+
 // CloseStream with the given pid and reason
 func (sm *StreamManager) CloseStream(peerID string, reason error) {
 	stream := sm.FindByPeerID(peerID)
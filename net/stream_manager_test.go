@@ -27,6 +27,7 @@ import (
 	"github.com/medibloc/go-medibloc/util/logging"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
 )
 
 const (
@@ -57,6 +58,20 @@ var (
 	MsgTypes []string
 )
 
+func TestBan(t *testing.T) {
+	sm := NewStreamManager()
+	sm.Ban("permanent", 0)
+	sm.Ban("temporary", 10*time.Millisecond)
+
+	assert.True(t, sm.IsBanned("permanent"))
+	assert.True(t, sm.IsBanned("temporary"))
+	assert.False(t, sm.IsBanned("other"))
+
+	time.Sleep(20 * time.Millisecond)
+	assert.True(t, sm.IsBanned("permanent"))
+	assert.False(t, sm.IsBanned("temporary"))
+}
+
 func TestAllMsg(t *testing.T) {
 	msgtypes := []string{HELLO, OK, BYE, SYNCROUTE, ROUTETABLE,
 		SyncMetaRequest, SyncMeta, SyncBlockChunkRequest, SyncBlockChunk,
@@ -0,0 +1,164 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package rpc
+
+import (
+	"time"
+
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/net"
+	"github.com/medibloc/go-medibloc/rpc/pb"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/medibloc/go-medibloc/util/logging"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Miner is an interface for controlling block production.
+type Miner interface {
+	StartMining() error
+	StopMining() error
+	IsMining() bool
+}
+
+// Syncer is an interface for triggering block download.
+type Syncer interface {
+	ActiveDownload(targetHeight uint64) error
+	IsDownloadActivated() bool
+}
+
+// AdminService is node management rpc service.
+type AdminService struct {
+	bm      *core.BlockManager
+	ns      net.Service
+	miner   Miner
+	syncer  Syncer
+	version string
+}
+
+func newAdminService(bm *core.BlockManager, ns net.Service, miner Miner, syncer Syncer, version string) *AdminService {
+	return &AdminService{
+		bm:      bm,
+		ns:      ns,
+		miner:   miner,
+		syncer:  syncer,
+		version: version,
+	}
+}
+
+// NodeInfo returns node information.
+func (s *AdminService) NodeInfo(ctx context.Context, req *rpcpb.AdminRequest) (*rpcpb.NodeInfoResponse, error) {
+	node := s.ns.Node()
+	return &rpcpb.NodeInfoResponse{
+		Id:            node.ID(),
+		ListenAddrs:   node.ListenAddrs(),
+		Version:       s.version,
+		ClientVersion: net.ClientVersion,
+		ChainId:       s.bm.ChainID(),
+		PeerCount:     node.PeersCount(),
+		Mining:        s.miner.IsMining(),
+		Syncing:       s.syncer.IsDownloadActivated(),
+	}, nil
+}
+
+// GetPeers returns connected peers with stream statistics.
+func (s *AdminService) GetPeers(ctx context.Context, req *rpcpb.AdminRequest) (*rpcpb.GetPeersResponse, error) {
+	var peers []*rpcpb.PeerInfo
+	for _, stats := range s.ns.Node().PeerStats() {
+		peers = append(peers, netStats2rpcPeer(stats))
+	}
+	return &rpcpb.GetPeersResponse{
+		Peers: peers,
+	}, nil
+}
+
+// AddPeer connects to a peer.
+func (s *AdminService) AddPeer(ctx context.Context, req *rpcpb.AddPeerRequest) (*rpcpb.AdminResponse, error) {
+	if err := s.ns.Node().AddPeer(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &rpcpb.AdminResponse{Ok: true}, nil
+}
+
+// RemovePeer disconnects a peer.
+func (s *AdminService) RemovePeer(ctx context.Context, req *rpcpb.PeerRequest) (*rpcpb.AdminResponse, error) {
+	if err := s.ns.Node().RemovePeer(req.Id); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &rpcpb.AdminResponse{Ok: true}, nil
+}
+
+// BanPeer disconnects a peer and refuses its connection for the duration.
+func (s *AdminService) BanPeer(ctx context.Context, req *rpcpb.BanPeerRequest) (*rpcpb.AdminResponse, error) {
+	if req.Id == "" || req.Duration < 0 {
+		return nil, status.Error(codes.InvalidArgument, ErrMsgInvalidRequest)
+	}
+	s.ns.Node().BanPeer(req.Id, time.Duration(req.Duration)*time.Second)
+	return &rpcpb.AdminResponse{Ok: true}, nil
+}
+
+// StartMining starts block production.
+func (s *AdminService) StartMining(ctx context.Context, req *rpcpb.AdminRequest) (*rpcpb.AdminResponse, error) {
+	if err := s.miner.StartMining(); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &rpcpb.AdminResponse{Ok: true}, nil
+}
+
+// StopMining stops block production.
+func (s *AdminService) StopMining(ctx context.Context, req *rpcpb.AdminRequest) (*rpcpb.AdminResponse, error) {
+	if err := s.miner.StopMining(); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &rpcpb.AdminResponse{Ok: true}, nil
+}
+
+// TriggerSync starts block download up to the target height.
+func (s *AdminService) TriggerSync(ctx context.Context, req *rpcpb.TriggerSyncRequest) (*rpcpb.AdminResponse, error) {
+	if req.TargetHeight <= s.bm.TailBlock().Height() {
+		return nil, status.Error(codes.InvalidArgument, ErrMsgInvalidBlockHeight)
+	}
+	if err := s.syncer.ActiveDownload(req.TargetHeight); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &rpcpb.AdminResponse{Ok: true}, nil
+}
+
+// InspectLIB finds LIB by consensus and updates it.
+func (s *AdminService) InspectLIB(ctx context.Context, req *rpcpb.AdminRequest) (*rpcpb.InspectLIBResponse, error) {
+	prevLIB, newLIB, err := s.bm.InspectLIB()
+	if err == core.ErrLIBNotFound {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, ErrMsgInternalError)
+	}
+	return &rpcpb.InspectLIBResponse{
+		PrevHash:   byteutils.Bytes2Hex(prevLIB.Hash()),
+		PrevHeight: prevLIB.Height(),
+		Hash:       byteutils.Bytes2Hex(newLIB.Hash()),
+		Height:     newLIB.Height(),
+	}, nil
+}
+
+// SetLogLevel changes log level.
+func (s *AdminService) SetLogLevel(ctx context.Context, req *rpcpb.SetLogLevelRequest) (*rpcpb.AdminResponse, error) {
+	if err := logging.SetLevel(req.Level); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &rpcpb.AdminResponse{Ok: true}, nil
+}
@@ -21,7 +21,9 @@ import (
 	"github.com/medibloc/go-medibloc/common"
 	"github.com/medibloc/go-medibloc/common/trie"
 	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/core/pb"
 	"github.com/medibloc/go-medibloc/crypto/signature/algorithm"
+	"github.com/medibloc/go-medibloc/medlet/pb"
 	"github.com/medibloc/go-medibloc/rpc/pb"
 	"github.com/medibloc/go-medibloc/util"
 	"github.com/medibloc/go-medibloc/util/byteutils"
@@ -35,14 +37,33 @@ type APIService struct {
 	bm *core.BlockManager
 	tm *core.TransactionManager
 	ee *core.EventEmitter
+
+	health *healthChecker
+
+	maxBlocksRange         uint64
+	maxAccountTransactions uint64
+	maxReplayEvents        uint64
 }
 
-func newAPIService(bm *core.BlockManager, tm *core.TransactionManager, ee *core.EventEmitter) *APIService {
-	return &APIService{
-		bm: bm,
-		tm: tm,
-		ee: ee,
+func newAPIService(bm *core.BlockManager, tm *core.TransactionManager, ee *core.EventEmitter, cfg *medletpb.RPCConfig) *APIService {
+	s := &APIService{
+		bm:                     bm,
+		tm:                     tm,
+		ee:                     ee,
+		maxBlocksRange:         cfg.MaxBlocksRange,
+		maxAccountTransactions: cfg.MaxAccountTransactions,
+		maxReplayEvents:        cfg.MaxReplayEvents,
+	}
+	if s.maxBlocksRange == 0 {
+		s.maxBlocksRange = DefaultMaxBlocksRange
+	}
+	if s.maxAccountTransactions == 0 {
+		s.maxAccountTransactions = DefaultMaxAccountTransactions
+	}
+	if s.maxReplayEvents == 0 {
+		s.maxReplayEvents = DefaultMaxReplayEvents
 	}
+	return s
 }
 
 // GetAccount handles GetAccount rpc.
@@ -124,6 +145,10 @@ func (s *APIService) GetBlocks(ctx context.Context, req *rpcpb.GetBlocksRequest)
 	if s.bm.TailBlock().Height() < req.To {
 		req.To = s.bm.TailBlock().Height()
 	}
+	if req.From <= req.To && req.To-req.From+1 > s.maxBlocksRange {
+		metricsRangeExceeded.Mark(1)
+		return nil, status.Error(codes.ResourceExhausted, ErrMsgRangeTooLarge)
+	}
 
 	for i := req.From; i <= req.To; i++ {
 		block, err := s.bm.BlockByHeight(i)
@@ -237,7 +262,7 @@ func (s *APIService) GetTransaction(ctx context.Context, req *rpcpb.GetTransacti
 // GetAccountTransactions returns transactions of the account
 func (s *APIService) GetAccountTransactions(ctx context.Context,
 	req *rpcpb.GetAccountTransactionsRequest) (*rpcpb.GetTransactionsResponse, error) {
-	var txs []*rpcpb.GetTransactionResponse
+	var pending []*rpcpb.GetTransactionResponse
 
 	address := common.HexToAddress(req.Address)
 
@@ -248,10 +273,10 @@ func (s *APIService) GetAccountTransactions(ctx context.Context,
 			if err != nil {
 				return nil, err
 			}
-			txs = append(txs, tx)
+			pending = append(pending, tx)
 			// Add send transaction twice if the address of from is as same as the address of to
 			if tx.TxType == core.TxOpTransfer && tx.From == tx.To {
-				txs = append(txs, tx)
+				pending = append(pending, tx)
 			}
 		}
 	}
@@ -265,28 +290,52 @@ func (s *APIService) GetAccountTransactions(ctx context.Context,
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, ErrMsgInternalError)
 	}
+	var txList [][]byte
 	if acc != nil {
-		txList := append(acc.TxsToSlice(), acc.TxsFromSlice()...)
-		for _, hash := range txList {
-			tx, err := tailBlock.State().GetTx(hash)
-			if err != nil {
-				return nil, status.Error(codes.InvalidArgument, ErrMsgInternalError)
-			}
-			rpcTx, err := coreTx2rpcTx(tx, true)
-			if err != nil {
-				return nil, err
-			}
-			txs = append(txs, rpcTx)
+		txList = append(acc.TxsToSlice(), acc.TxsFromSlice()...)
+	}
+
+	total := uint64(len(pending) + len(txList))
+	limit := req.Limit
+	if limit == 0 || limit > s.maxAccountTransactions {
+		limit = s.maxAccountTransactions
+	}
+	from, to := paginate(total, req.Offset, limit)
+
+	var txs []*rpcpb.GetTransactionResponse
+	for i := from; i < to; i++ {
+		if i < uint64(len(pending)) {
+			txs = append(txs, pending[i])
+			continue
 		}
+		tx, err := tailBlock.State().GetTx(txList[i-uint64(len(pending))])
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, ErrMsgInternalError)
+		}
+		rpcTx, err := coreTx2rpcTx(tx, true)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, rpcTx)
 	}
 
 	return &rpcpb.GetTransactionsResponse{
 		Transactions: txs,
+		NextOffset:   nextOffset(total, to),
 	}, nil
 }
 
 // SendTransaction sends transaction
 func (s *APIService) SendTransaction(ctx context.Context, req *rpcpb.SendTransactionRequest) (*rpcpb.SendTransactionResponse, error) {
+	resp, err := s.sendTransaction(req)
+	if _, ok := status.FromError(err); !ok {
+		return nil, status.Error(codes.InvalidArgument, ErrMsgInvalidTransaction)
+	}
+	return resp, err
+}
+
+// sendTransaction sends transaction. It returns the error of transaction manager as it is if the transaction is rejected.
+func (s *APIService) sendTransaction(req *rpcpb.SendTransactionRequest) (*rpcpb.SendTransactionResponse, error) {
 	value, err := util.NewUint128FromString(req.Value)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, ErrMsgInvalidTxValue)
@@ -313,44 +362,573 @@ func (s *APIService) SendTransaction(ctx context.Context, req *rpcpb.SendTransac
 		return nil, status.Error(codes.InvalidArgument, ErrMsgBuildTransactionFail)
 	}
 	if err = s.tm.PushAndRelay(tx); err != nil {
-		return nil, status.Error(codes.InvalidArgument, ErrMsgInvalidTransaction)
+		return nil, err
 	}
 	return &rpcpb.SendTransactionResponse{
 		Hash: byteutils.Bytes2Hex(tx.Hash()),
 	}, nil
 }
 
+// GetRecord returns record of the account
+func (s *APIService) GetRecord(ctx context.Context, req *rpcpb.GetRecordRequest) (*rpcpb.Record, error) {
+	recordHash, err := hex.DecodeString(req.RecordHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, ErrMsgInvalidRequest)
+	}
+
+	tailBlock := s.bm.TailBlock()
+	if tailBlock == nil {
+		return nil, status.Error(codes.NotFound, ErrMsgInternalError)
+	}
+	acc, err := tailBlock.State().GetAccount(common.HexToAddress(req.Address))
+	if err != nil {
+		return nil, status.Error(codes.Internal, ErrMsgInternalError)
+	}
+	record, err := acc.GetRecord(recordHash)
+	if err == trie.ErrNotFound {
+		return nil, status.Error(codes.NotFound, ErrMsgRecordNotFound)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, ErrMsgInternalError)
+	}
+	return coreRecord2rpcRecord(record), nil
+}
+
+// ListAccountRecords returns records of the account
+func (s *APIService) ListAccountRecords(ctx context.Context,
+	req *rpcpb.ListAccountRecordsRequest) (*rpcpb.ListAccountRecordsResponse, error) {
+	limit, err := listLimit(req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	tailBlock := s.bm.TailBlock()
+	if tailBlock == nil {
+		return nil, status.Error(codes.NotFound, ErrMsgInternalError)
+	}
+	acc, err := tailBlock.State().GetAccount(common.HexToAddress(req.Address))
+	if err != nil {
+		return nil, status.Error(codes.Internal, ErrMsgInternalError)
+	}
+	records, err := acc.Records()
+	if err != nil {
+		return nil, status.Error(codes.Internal, ErrMsgInternalError)
+	}
+
+	total := uint64(len(records))
+	from, to := paginate(total, req.Offset, limit)
+	return &rpcpb.ListAccountRecordsResponse{
+		Records: coreRecords2rpcRecords(records[from:to]),
+		Total:   total,
+	}, nil
+}
+
+// GetCertification returns certification received or issued by the account
+func (s *APIService) GetCertification(ctx context.Context, req *rpcpb.GetCertificationRequest) (*rpcpb.Certification, error) {
+	certHash, err := hex.DecodeString(req.CertificateHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, ErrMsgInvalidRequest)
+	}
+
+	tailBlock := s.bm.TailBlock()
+	if tailBlock == nil {
+		return nil, status.Error(codes.NotFound, ErrMsgInternalError)
+	}
+	acc, err := tailBlock.State().GetAccount(common.HexToAddress(req.Address))
+	if err != nil {
+		return nil, status.Error(codes.Internal, ErrMsgInternalError)
+	}
+	cert, err := acc.GetCertification(core.CertReceivedPrefix, certHash)
+	if err == trie.ErrNotFound {
+		cert, err = acc.GetCertification(core.CertIssuedPrefix, certHash)
+	}
+	if err == trie.ErrNotFound {
+		return nil, status.Error(codes.NotFound, ErrMsgCertificationNotFound)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, ErrMsgInternalError)
+	}
+	return coreCert2rpcCert(cert, tailBlock.Timestamp()), nil
+}
+
+// ListAccountCertifications returns certifications received or issued by the account
+func (s *APIService) ListAccountCertifications(ctx context.Context,
+	req *rpcpb.ListAccountCertificationsRequest) (*rpcpb.ListAccountCertificationsResponse, error) {
+	var prefix string
+	switch req.Direction {
+	case CertReceived, "":
+		prefix = core.CertReceivedPrefix
+	case CertIssued:
+		prefix = core.CertIssuedPrefix
+	default:
+		return nil, status.Error(codes.InvalidArgument, ErrMsgInvalidRequest)
+	}
+	switch req.Status {
+	case CertStatusValid, CertStatusNotYetValid, CertStatusExpired, CertStatusRevoked, "":
+	default:
+		return nil, status.Error(codes.InvalidArgument, ErrMsgInvalidRequest)
+	}
+	limit, err := listLimit(req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	tailBlock := s.bm.TailBlock()
+	if tailBlock == nil {
+		return nil, status.Error(codes.NotFound, ErrMsgInternalError)
+	}
+	acc, err := tailBlock.State().GetAccount(common.HexToAddress(req.Address))
+	if err != nil {
+		return nil, status.Error(codes.Internal, ErrMsgInternalError)
+	}
+	certs, err := acc.Certifications(prefix)
+	if err != nil {
+		return nil, status.Error(codes.Internal, ErrMsgInternalError)
+	}
+
+	var rpcCerts []*rpcpb.Certification
+	for _, cert := range certs {
+		rpcCert := coreCert2rpcCert(cert, tailBlock.Timestamp())
+		if req.Issuer != "" && !common.HexToAddress(req.Issuer).Equals(common.BytesToAddress(cert.Issuer)) {
+			continue
+		}
+		if req.CertType != "" && req.CertType != rpcCert.CertType {
+			continue
+		}
+		if req.Status != "" && req.Status != rpcCert.Status {
+			continue
+		}
+		rpcCerts = append(rpcCerts, rpcCert)
+	}
+
+	total := uint64(len(rpcCerts))
+	from, to := paginate(total, req.Offset, limit)
+	return &rpcpb.ListAccountCertificationsResponse{
+		Certifications: rpcCerts[from:to],
+		Total:          total,
+	}, nil
+}
+
+// GetIssuer returns issuer registration of the certification type
+func (s *APIService) GetIssuer(ctx context.Context, req *rpcpb.GetIssuerRequest) (*rpcpb.Issuer, error) {
+	tailBlock := s.bm.TailBlock()
+	if tailBlock == nil {
+		return nil, status.Error(codes.NotFound, ErrMsgInternalError)
+	}
+	acc, err := tailBlock.State().GetAccount(common.HexToAddress(req.Address))
+	if err != nil {
+		return nil, status.Error(codes.Internal, ErrMsgInternalError)
+	}
+	reg, err := acc.GetIssuerRegistration(req.CertType)
+	if err == trie.ErrNotFound {
+		return nil, status.Error(codes.NotFound, ErrMsgIssuerNotFound)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, ErrMsgInternalError)
+	}
+	return coreIssuer2rpcIssuer(reg), nil
+}
+
+// GetRecordHistory returns amendment history of the record
+func (s *APIService) GetRecordHistory(ctx context.Context, req *rpcpb.GetRecordHistoryRequest) (*rpcpb.GetRecordHistoryResponse, error) {
+	recordHash, err := hex.DecodeString(req.RecordHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, ErrMsgInvalidRequest)
+	}
+
+	tailBlock := s.bm.TailBlock()
+	if tailBlock == nil {
+		return nil, status.Error(codes.NotFound, ErrMsgInternalError)
+	}
+	acc, err := tailBlock.State().GetAccount(common.HexToAddress(req.Address))
+	if err != nil {
+		return nil, status.Error(codes.Internal, ErrMsgInternalError)
+	}
+	records, err := acc.RecordHistory(recordHash)
+	if err == trie.ErrNotFound {
+		return nil, status.Error(codes.NotFound, ErrMsgRecordNotFound)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, ErrMsgInternalError)
+	}
+
+	rpcRecords := coreRecords2rpcRecords(records)
+	return &rpcpb.GetRecordHistoryResponse{
+		Records: rpcRecords,
+		Latest:  rpcRecords[len(rpcRecords)-1],
+	}, nil
+}
+
+// VerifyRecordProof checks that record hash is included in the record batch anchored by owner.
+func (s *APIService) VerifyRecordProof(ctx context.Context, req *rpcpb.VerifyRecordProofRequest) (*rpcpb.VerifyRecordProofResponse, error) {
+	root, err := hex.DecodeString(req.MerkleRoot)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, ErrMsgInvalidRequest)
+	}
+	recordHash, err := hex.DecodeString(req.RecordHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, ErrMsgInvalidRequest)
+	}
+	path := make([][]byte, 0, len(req.Path))
+	for _, p := range req.Path {
+		sibling, err := hex.DecodeString(p)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, ErrMsgInvalidRequest)
+		}
+		path = append(path, sibling)
+	}
+
+	tailBlock := s.bm.TailBlock()
+	if tailBlock == nil {
+		return nil, status.Error(codes.NotFound, ErrMsgInternalError)
+	}
+	acc, err := tailBlock.State().GetAccount(common.HexToAddress(req.Owner))
+	if err != nil {
+		return nil, status.Error(codes.Internal, ErrMsgInternalError)
+	}
+	pbRecord, err := acc.GetRecord(root)
+	if err == trie.ErrNotFound {
+		return nil, status.Error(codes.NotFound, ErrMsgRecordNotFound)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, ErrMsgInternalError)
+	}
+	return &rpcpb.VerifyRecordProofResponse{
+		Valid:       core.VerifyRecordInBatch(pbRecord, recordHash, req.Index, path) == nil,
+		Timestamp:   pbRecord.Timestamp,
+		RecordCount: pbRecord.RecordCount,
+	}, nil
+}
+
 // Subscribe to listen event
 func (s *APIService) Subscribe(req *rpcpb.SubscribeRequest, stream rpcpb.ApiService_SubscribeServer) error {
+	w := newSubscriptionWriter(stream)
+	err := s.subscribe(w.ctx, req, w.send)
+	if sendErr := w.close(); sendErr != nil {
+		return sendErr
+	}
+	return err
+}
 
-	eventSub, err := core.NewEventSubscriber(1024, req.Topics)
+// subscribe streams events matching the request by send until ctx is done or send fails.
+func (s *APIService) subscribe(ctx context.Context, req *rpcpb.SubscribeRequest, send func(*rpcpb.SubscribeResponse) error) error {
+	filter, err := newEventFilter(req)
 	if err != nil {
 		return err
 	}
 
+	eventSub, err := core.NewEventSubscriber(SubscribeBufferSize, req.Topics)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	s.ee.Register(eventSub)
 	defer s.ee.Deregister(eventSub)
 
+	fromSeq, err := s.replaySeq(req)
+	if err != nil {
+		return err
+	}
+	replayed := make(map[string]bool)
+	var nextSeq uint64
+	if fromSeq > 0 {
+		nextSeq, err = s.replayStored(req, fromSeq, eventSub, filter, send)
+	} else {
+		replayed, err = s.replay(req, eventSub, filter, send)
+	}
+	if err != nil {
+		return err
+	}
+
+	// If drop is allowed, overflow is reported by dropped count of the response.
+	overflowCh := eventSub.Overflowed()
+	if req.AllowDrop {
+		overflowCh = nil
+	}
+	var dropped uint64
+
 	for {
 		select {
-		case <-stream.Context().Done():
-			return stream.Context().Err()
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-overflowCh:
+			return status.Error(codes.ResourceExhausted, ErrMsgSubscriberTooSlow)
 		case event := <-eventSub.EventChan():
-			err := stream.Send(&rpcpb.SubscribeResponse{
-				Topic: event.Topic,
-				Hash:  event.Data,
-			})
-			// TODO : Send timeout
+			if event.Seq != 0 && event.Seq < nextSeq {
+				continue
+			}
+			if event.Block != nil && replayed[byteutils.Bytes2Hex(event.Block.Hash())] &&
+				(event.Topic == core.TopicNewTailBlock || event.Topic == core.TopicTransactionExecutionResult) {
+				continue
+			}
+			if !filter.matchEvent(event) {
+				continue
+			}
+			resp, err := coreEvent2rpcEvent(event, req.IncludePayload)
 			if err != nil {
 				return err
 			}
+			total := eventSub.Dropped()
+			resp.Dropped = total - dropped
+			dropped = total
+
+			if err := send(resp); err != nil {
+				return err
+			}
 		}
 	}
 }
 
-// HealthCheck returns success.
+// replay sends events of blocks from the requested height to the tail, and returns hashes of replayed blocks.
+// Events are sent as blocks are read, so the number of replayed events is limited only by maxBlocksRange.
+func (s *APIService) replay(req *rpcpb.SubscribeRequest, eventSub *core.EventSubscriber, filter *eventFilter,
+	send func(*rpcpb.SubscribeResponse) error) (map[string]bool, error) {
+	replayed := make(map[string]bool)
+	if req.FromHeight == 0 {
+		return replayed, nil
+	}
+
+	tailHeight := s.bm.TailBlock().Height()
+	if req.FromHeight > tailHeight {
+		return replayed, nil
+	}
+	if tailHeight-req.FromHeight+1 > s.maxBlocksRange {
+		metricsRangeExceeded.Mark(1)
+		return nil, status.Error(codes.ResourceExhausted, ErrMsgRangeTooLarge)
+	}
+
+	topics := make(map[string]bool)
+	for _, topic := range eventSub.Topics() {
+		topics[topic] = true
+	}
+	for height := req.FromHeight; height <= tailHeight; height++ {
+		block, err := s.bm.BlockByHeight(height)
+		if err != nil {
+			return nil, status.Error(codes.Internal, ErrMsgBlockNotFound)
+		}
+		replayed[byteutils.Bytes2Hex(block.Hash())] = true
+
+		for _, event := range replayEvents(block, topics) {
+			if !filter.matchEvent(event) {
+				continue
+			}
+			resp, err := coreEvent2rpcEvent(event, req.IncludePayload)
+			if err != nil {
+				return nil, err
+			}
+			resp.Replayed = true
+			if err := send(resp); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return replayed, nil
+}
+
+// replaySeq returns the sequence number to replay stored events from. It returns 0 if stored events are not
+// replayed. Blocks are replayed instead if the requested height is not in the event store.
+func (s *APIService) replaySeq(req *rpcpb.SubscribeRequest) (uint64, error) {
+	store := s.eventStore()
+	if req.FromSeq > 0 {
+		if store == nil {
+			return 0, status.Error(codes.FailedPrecondition, ErrMsgEventStoreDisabled)
+		}
+		return req.FromSeq, nil
+	}
+	if req.FromHeight == 0 || store == nil {
+		return 0, nil
+	}
+	seq, err := store.SeqByHeight(req.FromHeight)
+	if err == core.ErrNotFound {
+		return 0, nil
+	}
+	if err == core.ErrEventPruned {
+		return 0, status.Error(codes.OutOfRange, ErrMsgEventPruned)
+	}
+	if err != nil {
+		return 0, status.Error(codes.Internal, ErrMsgInternalError)
+	}
+	return seq, nil
+}
+
+// replayStored sends stored events from the sequence number to the latest, and returns the sequence number next
+// to the replayed events. Events are sent as they are read, so the number of replayed events is limited only by
+// maxReplayEvents.
+func (s *APIService) replayStored(req *rpcpb.SubscribeRequest, fromSeq uint64, eventSub *core.EventSubscriber,
+	filter *eventFilter, send func(*rpcpb.SubscribeResponse) error) (uint64, error) {
+	store := s.eventStore()
+	lastSeq := store.LastSeq()
+	if fromSeq > lastSeq {
+		return fromSeq, nil
+	}
+	if lastSeq-fromSeq+1 > s.maxReplayEvents {
+		metricsRangeExceeded.Mark(1)
+		return 0, status.Error(codes.ResourceExhausted, ErrMsgRangeTooLarge)
+	}
+
+	topics := make(map[string]bool)
+	for _, topic := range eventSub.Topics() {
+		topics[topic] = true
+	}
+	seq := fromSeq
+	for seq <= lastSeq {
+		events, err := store.Events(seq, MaxListLimit)
+		if err == core.ErrEventPruned {
+			return 0, status.Error(codes.OutOfRange, ErrMsgEventPruned)
+		}
+		if err != nil {
+			return 0, status.Error(codes.Internal, ErrMsgInternalError)
+		}
+		if len(events) == 0 {
+			break
+		}
+		for _, pb := range events {
+			if pb.Seq > lastSeq {
+				break
+			}
+			seq = pb.Seq + 1
+			if !topics[pb.Topic] {
+				continue
+			}
+			event := s.storedEvent2coreEvent(pb)
+			if !filter.matchEvent(event) {
+				continue
+			}
+			resp, err := coreEvent2rpcEvent(event, req.IncludePayload)
+			if err != nil {
+				return 0, err
+			}
+			resp.Replayed = true
+			if err := send(resp); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return lastSeq + 1, nil
+}
+
+// storedEvent2coreEvent restores the event with its block and transaction if they are found.
+func (s *APIService) storedEvent2coreEvent(pb *corepb.Event) *core.Event {
+	event := &core.Event{
+		Topic: pb.Topic,
+		Data:  pb.Data,
+		Seq:   pb.Seq,
+	}
+	if len(pb.BlockHash) == 0 {
+		return event
+	}
+	event.Block = s.bm.BlockByHash(pb.BlockHash)
+	if event.Block == nil || pb.Topic != core.TopicTransactionExecutionResult {
+		return event
+	}
+	for _, tx := range event.Block.Transactions() {
+		if byteutils.Bytes2Hex(tx.Hash()) == pb.Data {
+			event.Transaction = tx
+			break
+		}
+	}
+	return event
+}
+
+// GetEvents returns stored events.
+func (s *APIService) GetEvents(ctx context.Context, req *rpcpb.GetEventsRequest) (*rpcpb.GetEventsResponse, error) {
+	store := s.eventStore()
+	if store == nil {
+		return nil, status.Error(codes.FailedPrecondition, ErrMsgEventStoreDisabled)
+	}
+	limit, err := listLimit(req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	topics := make(map[string]bool)
+	for _, topic := range req.Topics {
+		topics[topic] = true
+	}
+
+	resp := &rpcpb.GetEventsResponse{
+		FirstSeq: store.FirstSeq(),
+		LastSeq:  store.LastSeq(),
+	}
+	fromSeq := req.FromSeq
+	if fromSeq == 0 && req.FromHeight > 0 {
+		fromSeq, err = store.SeqByHeight(req.FromHeight)
+		if err == core.ErrNotFound {
+			resp.NextSeq = resp.LastSeq + 1
+			return resp, nil
+		}
+		if err == core.ErrEventPruned {
+			return nil, status.Error(codes.OutOfRange, ErrMsgEventPruned)
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, ErrMsgInternalError)
+		}
+	}
+	if fromSeq == 0 {
+		fromSeq = resp.FirstSeq
+	}
+
+	events, err := store.Events(fromSeq, limit)
+	if err == core.ErrEventPruned {
+		return nil, status.Error(codes.OutOfRange, ErrMsgEventPruned)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, ErrMsgInternalError)
+	}
+	resp.NextSeq = fromSeq
+	for _, event := range events {
+		resp.NextSeq = event.Seq + 1
+		if len(topics) > 0 && !topics[event.Topic] {
+			continue
+		}
+		resp.Events = append(resp.Events, coreEvent2rpcStoredEvent(event))
+	}
+	return resp, nil
+}
+
+func (s *APIService) eventStore() *core.EventStore {
+	if s.ee == nil {
+		return nil
+	}
+	return s.ee.Store()
+}
+
+// HealthCheck returns health of the node. It returns success if health check is not set up.
 func (s *APIService) HealthCheck(ctx context.Context, req *rpcpb.NonParamRequest) (*rpcpb.HealthCheckResponse, error) {
-	return &rpcpb.HealthCheckResponse{
-		Ok: true,
-	}, nil
+	if s.health == nil {
+		return &rpcpb.HealthCheckResponse{
+			Ok:    true,
+			Live:  true,
+			Ready: true,
+		}, nil
+	}
+	return s.health.check(), nil
+}
+
+func listLimit(limit uint64) (uint64, error) {
+	if limit == 0 {
+		return DefaultListLimit, nil
+	}
+	if limit > MaxListLimit {
+		return 0, status.Error(codes.InvalidArgument, ErrMsgInvalidRequest)
+	}
+	return limit, nil
+}
+
+// nextOffset returns the offset of the next page, or 0 if the page ending at to is the last one.
+func nextOffset(total, to uint64) uint64 {
+	if to >= total {
+		return 0
+	}
+	return to
+}
+
+func paginate(total, offset, limit uint64) (from, to uint64) {
+	if offset > total {
+		return total, total
+	}
+	to = offset + limit
+	if to > total {
+		to = total
+	}
+	return offset, to
 }
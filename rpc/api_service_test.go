@@ -0,0 +1,142 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/medlet/pb"
+	"github.com/medibloc/go-medibloc/rpc/pb"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetEvents(t *testing.T) {
+	cfg := &medletpb.RPCConfig{}
+	ee := core.NewEventEmitter(1024)
+
+	api := newAPIService(nil, nil, ee, cfg)
+	_, err := api.GetEvents(context.Background(), &rpcpb.GetEventsRequest{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	store, err := core.NewEventStore(stor, nil, 3)
+	require.NoError(t, err)
+	ee.InjectStore(store)
+	for _, topic := range []string{core.TopicNewTailBlock, core.TopicLibBlock, core.TopicNewTailBlock, core.TopicLibBlock} {
+		require.NoError(t, store.Append(&core.Event{Topic: topic, Data: "0x01"}))
+	}
+
+	resp, err := api.GetEvents(context.Background(), &rpcpb.GetEventsRequest{
+		FromSeq: 2,
+		Limit:   2,
+		Topics:  []string{core.TopicLibBlock},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Events, 1)
+	assert.Equal(t, uint64(2), resp.Events[0].Seq)
+	assert.Equal(t, core.TopicLibBlock, resp.Events[0].Topic)
+	assert.Equal(t, uint64(4), resp.NextSeq)
+	assert.Equal(t, uint64(2), resp.FirstSeq)
+	assert.Equal(t, uint64(4), resp.LastSeq)
+
+	_, err = api.GetEvents(context.Background(), &rpcpb.GetEventsRequest{FromSeq: 1})
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+}
+
+func TestReplayStoredStreamsEvents(t *testing.T) {
+	ee := core.NewEventEmitter(1024)
+	api := newAPIService(nil, nil, ee, &medletpb.RPCConfig{})
+
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	store, err := core.NewEventStore(stor, nil, 0)
+	require.NoError(t, err)
+	ee.InjectStore(store)
+	const n = 2 * SubscribeBufferSize
+	for i := 0; i < n; i++ {
+		require.NoError(t, store.Append(&core.Event{Topic: core.TopicLibBlock, Data: "0x01"}))
+	}
+
+	req := &rpcpb.SubscribeRequest{Topics: []string{core.TopicLibBlock}, FromSeq: 1}
+	eventSub, err := core.NewEventSubscriber(SubscribeBufferSize, req.Topics)
+	require.NoError(t, err)
+	filter, err := newEventFilter(req)
+	require.NoError(t, err)
+
+	var sent []*rpcpb.SubscribeResponse
+	nextSeq, err := api.replayStored(req, 1, eventSub, filter, func(resp *rpcpb.SubscribeResponse) error {
+		sent = append(sent, resp)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(n+1), nextSeq)
+	require.Len(t, sent, n)
+	assert.True(t, sent[n-1].Replayed)
+	assert.Equal(t, uint64(n), sent[n-1].Seq)
+
+	api = newAPIService(nil, nil, ee, &medletpb.RPCConfig{MaxReplayEvents: n - 1})
+	_, err = api.replayStored(req, 1, eventSub, filter, func(resp *rpcpb.SubscribeResponse) error { return nil })
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+type countingStorage struct {
+	storage.Storage
+	puts int
+}
+
+func (s *countingStorage) Put(key []byte, value []byte) error {
+	s.puts++
+	return s.Storage.Put(key, value)
+}
+
+func TestStorageWritableCached(t *testing.T) {
+	mem, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	stor := &countingStorage{Storage: mem}
+	h := newHealthChecker(nil, nil, nil, nil, stor, &medletpb.RPCHealthConfig{})
+	now := time.Now()
+	h.now = func() time.Time { return now }
+
+	for i := 0; i < 10; i++ {
+		assert.True(t, h.storageWritable())
+	}
+	assert.Equal(t, 1, stor.puts)
+
+	now = now.Add(HealthStorageCheckInterval)
+	assert.True(t, h.storageWritable())
+	assert.Equal(t, 2, stor.puts)
+}
+
+func TestNextOffset(t *testing.T) {
+	from, to := paginate(2500, 0, DefaultMaxAccountTransactions)
+	assert.Equal(t, uint64(0), from)
+	assert.Equal(t, uint64(1000), nextOffset(2500, to))
+
+	from, to = paginate(2500, 2000, DefaultMaxAccountTransactions)
+	assert.Equal(t, uint64(2000), from)
+	assert.Equal(t, uint64(0), nextOffset(2500, to))
+
+	_, to = paginate(2500, 3000, DefaultMaxAccountTransactions)
+	assert.Equal(t, uint64(0), nextOffset(2500, to))
+}
@@ -0,0 +1,191 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package rpc
+
+import (
+	"crypto/subtle"
+	"errors"
+	"strings"
+
+	"github.com/medibloc/go-medibloc/medlet/pb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Permissions granted to api keys.
+const (
+	PermissionRead            = "read"
+	PermissionSendTransaction = "send_transaction"
+	PermissionAdmin           = "admin"
+)
+
+// Errors of api key configuration
+var (
+	ErrAdminAPIKeyRequired = errors.New("api key with admin permission is required if rpc.admin_rpc_listen is set")
+	ErrReadAPIKeyRequired  = errors.New("api key with read permission is required if rpc.require_read_auth is true")
+)
+
+const (
+	adminServicePrefix    = "/rpcpb.AdminService/"
+	sendTransactionMethod = "/rpcpb.ApiService/SendTransaction"
+
+	authorizationHeader = "authorization"
+	apiKeyHeader        = "x-api-key"
+	bearerPrefix        = "bearer "
+)
+
+// methodPermission returns the permission required to call the grpc method.
+func methodPermission(fullMethod string) string {
+	if strings.HasPrefix(fullMethod, adminServicePrefix) {
+		return PermissionAdmin
+	}
+	if fullMethod == sendTransactionMethod {
+		return PermissionSendTransaction
+	}
+	return PermissionRead
+}
+
+type apiKey struct {
+	key         []byte
+	permissions map[string]bool
+}
+
+type authorizer struct {
+	keys            []*apiKey
+	requireReadAuth bool
+}
+
+func newAuthorizer(cfg *medletpb.RPCConfig) *authorizer {
+	a := &authorizer{
+		requireReadAuth: cfg.RequireReadAuth,
+	}
+	for _, k := range cfg.ApiKeys {
+		if k.Key == "" {
+			continue
+		}
+		permissions := make(map[string]bool)
+		for _, p := range k.Permissions {
+			permissions[p] = true
+		}
+		a.keys = append(a.keys, &apiKey{
+			key:         []byte(k.Key),
+			permissions: permissions,
+		})
+	}
+	return a
+}
+
+func (a *authorizer) hasPermission(permission string) bool {
+	for _, k := range a.keys {
+		if k.permissions[permission] {
+			return true
+		}
+	}
+	return false
+}
+
+// APIKeyErrors returns errors of the api key configuration if some methods would require a key which is not
+// granted to any key. See required for the methods requiring a key.
+func APIKeyErrors(cfg *medletpb.RPCConfig) []error {
+	a := newAuthorizer(cfg)
+	var errs []error
+	if len(cfg.AdminRpcListen) > 0 && !a.hasPermission(PermissionAdmin) {
+		errs = append(errs, ErrAdminAPIKeyRequired)
+	}
+	if a.requireReadAuth && !a.hasPermission(PermissionRead) {
+		errs = append(errs, ErrReadAPIKeyRequired)
+	}
+	return errs
+}
+
+// required returns whether an api key is required for the permission. Admin methods always require a key, and
+// send methods require a key if any key is granted the permission.
+func (a *authorizer) required(permission string) bool {
+	switch permission {
+	case PermissionAdmin:
+		return true
+	case PermissionRead:
+		return a.requireReadAuth
+	default:
+		return a.hasPermission(permission)
+	}
+}
+
+func (a *authorizer) lookup(key string) *apiKey {
+	var found *apiKey
+	for _, k := range a.keys {
+		if subtle.ConstantTimeCompare(k.key, []byte(key)) == 1 {
+			found = k
+		}
+	}
+	return found
+}
+
+func (a *authorizer) authorize(ctx context.Context, fullMethod string) error {
+	permission := methodPermission(fullMethod)
+	if !a.required(permission) {
+		return nil
+	}
+
+	key := apiKeyFromContext(ctx)
+	if key == "" {
+		return status.Error(codes.Unauthenticated, ErrMsgAPIKeyRequired)
+	}
+	k := a.lookup(key)
+	if k == nil {
+		return status.Error(codes.Unauthenticated, ErrMsgInvalidAPIKey)
+	}
+	if !k.permissions[permission] {
+		return status.Error(codes.PermissionDenied, ErrMsgPermissionDenied)
+	}
+	return nil
+}
+
+func (a *authorizer) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := a.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *authorizer) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// apiKeyFromContext returns api key from bearer token or api key header.
+func apiKeyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, v := range md[authorizationHeader] {
+		if len(v) > len(bearerPrefix) && strings.ToLower(v[:len(bearerPrefix)]) == bearerPrefix {
+			return strings.TrimSpace(v[len(bearerPrefix):])
+		}
+	}
+	for _, v := range md[apiKeyHeader] {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
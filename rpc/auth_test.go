@@ -0,0 +1,92 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/medibloc/go-medibloc/medlet/pb"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func ctxWithHeader(kv ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(kv...))
+}
+
+func TestAuthorize(t *testing.T) {
+	const (
+		getAccount = "/rpcpb.ApiService/GetAccount"
+		sendTx     = "/rpcpb.ApiService/SendTransaction"
+		nodeInfo   = "/rpcpb.AdminService/NodeInfo"
+	)
+
+	disabled := newAuthorizer(&medletpb.RPCConfig{})
+	assert.NoError(t, disabled.authorize(context.Background(), getAccount))
+	assert.NoError(t, disabled.authorize(context.Background(), sendTx))
+	assert.Equal(t, codes.Unauthenticated, status.Code(disabled.authorize(context.Background(), nodeInfo)))
+
+	adminOnly := newAuthorizer(&medletpb.RPCConfig{
+		ApiKeys: []*medletpb.RPCAPIKey{{Key: "admin", Permissions: []string{PermissionAdmin}}},
+	})
+	assert.NoError(t, adminOnly.authorize(context.Background(), sendTx))
+	assert.NoError(t, adminOnly.authorize(ctxWithHeader("x-api-key", "admin"), nodeInfo))
+
+	cfg := &medletpb.RPCConfig{
+		ApiKeys: []*medletpb.RPCAPIKey{
+			{Key: "sender", Permissions: []string{PermissionSendTransaction}},
+			{Key: "admin", Permissions: []string{PermissionRead, PermissionAdmin}},
+		},
+	}
+	a := newAuthorizer(cfg)
+
+	assert.NoError(t, a.authorize(context.Background(), getAccount))
+	assert.Equal(t, codes.Unauthenticated, status.Code(a.authorize(context.Background(), sendTx)))
+	assert.Equal(t, codes.Unauthenticated, status.Code(a.authorize(ctxWithHeader("authorization", "Bearer wrong"), sendTx)))
+	assert.NoError(t, a.authorize(ctxWithHeader("authorization", "Bearer sender"), sendTx))
+	assert.NoError(t, a.authorize(ctxWithHeader("x-api-key", "sender"), sendTx))
+	assert.Equal(t, codes.PermissionDenied, status.Code(a.authorize(ctxWithHeader("x-api-key", "sender"), nodeInfo)))
+	assert.NoError(t, a.authorize(ctxWithHeader("authorization", "bearer admin"), nodeInfo))
+
+	cfg.RequireReadAuth = true
+	a = newAuthorizer(cfg)
+	assert.Equal(t, codes.Unauthenticated, status.Code(a.authorize(context.Background(), getAccount)))
+	assert.Equal(t, codes.PermissionDenied, status.Code(a.authorize(ctxWithHeader("x-api-key", "sender"), getAccount)))
+	assert.NoError(t, a.authorize(ctxWithHeader("x-api-key", "admin"), getAccount))
+}
+
+func TestAPIKeyErrors(t *testing.T) {
+	cfg := &medletpb.RPCConfig{}
+	assert.Empty(t, APIKeyErrors(cfg))
+	cfg.AdminRpcListen = []string{"127.0.0.1:9930"}
+	assert.Equal(t, []error{ErrAdminAPIKeyRequired}, APIKeyErrors(cfg))
+
+	cfg.RequireReadAuth = true
+	assert.Equal(t, []error{ErrAdminAPIKeyRequired, ErrReadAPIKeyRequired}, APIKeyErrors(cfg))
+
+	cfg.ApiKeys = []*medletpb.RPCAPIKey{{Key: "admin", Permissions: []string{PermissionAdmin}}}
+	assert.Equal(t, []error{ErrReadAPIKeyRequired}, APIKeyErrors(cfg))
+
+	// keys without key string are ignored.
+	cfg.ApiKeys = append(cfg.ApiKeys, &medletpb.RPCAPIKey{Permissions: []string{PermissionRead}})
+	assert.Equal(t, []error{ErrReadAPIKeyRequired}, APIKeyErrors(cfg))
+
+	cfg.ApiKeys[0].Permissions = append(cfg.ApiKeys[0].Permissions, PermissionRead)
+	assert.Empty(t, APIKeyErrors(cfg))
+}
@@ -19,8 +19,10 @@ import (
 	"google.golang.org/grpc"
 )
 
-// Dial dials
-func Dial(target string) *grpc.ClientConn {
-	conn, _ := grpc.Dial(target, grpc.WithInsecure())
-	return conn
+// Dial dials the rpc server. The connection is insecure if no option is given.
+func Dial(target string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	if len(opts) == 0 {
+		opts = []grpc.DialOption{grpc.WithInsecure()}
+	}
+	return grpc.Dial(target, opts...)
 }
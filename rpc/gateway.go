@@ -16,7 +16,9 @@
 package rpc
 
 import (
+	"crypto/tls"
 	"fmt"
+	"net"
 	"net/http"
 
 	"encoding/json"
@@ -25,6 +27,7 @@ import (
 	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/medibloc/go-medibloc/medlet/pb"
 	"github.com/medibloc/go-medibloc/rpc/pb"
 	pb "github.com/medibloc/go-medibloc/rpc/pb"
 	"github.com/medibloc/go-medibloc/util/logging"
@@ -38,44 +41,110 @@ import (
 
 // HTTPServer is a rest gateway wrapping the grpc server.
 type HTTPServer struct {
-	handler  http.Handler
-	httpAddr string
-	grpcAddr string
-	cancel   context.CancelFunc
+	handler   http.Handler
+	httpAddr  string
+	grpcAddr  string
+	tlsConfig *tls.Config
+	conns     *connLimiter
+	limit     *limiter
+	cancel    context.CancelFunc
 }
 
-// NewHTTPServer creates HTTPServer.
-func NewHTTPServer(httpAddr string, grpcAddr string) (*HTTPServer, error) {
+type registerHandlerFunc func(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error
+
+// NewHTTPServer creates HTTPServer. Routes are served in addition to the gateway.
+func NewHTTPServer(httpAddr string, grpcAddr string, cfg *medletpb.RPCConfig, routes map[string]http.Handler) (*HTTPServer, error) {
+	return newHTTPServer(httpAddr, grpcAddr, cfg, routes, rpcpb.RegisterApiServiceHandlerFromEndpoint, pb.Swagger)
+}
+
+// NewAdminHTTPServer creates HTTPServer for admin service.
+func NewAdminHTTPServer(httpAddr string, grpcAddr string, cfg *medletpb.RPCConfig, routes map[string]http.Handler) (*HTTPServer, error) {
+	return newHTTPServer(httpAddr, grpcAddr, cfg, routes, rpcpb.RegisterAdminServiceHandlerFromEndpoint, pb.AdminSwagger)
+}
+
+func newHTTPServer(httpAddr string, grpcAddr string, cfg *medletpb.RPCConfig, routes map[string]http.Handler,
+	register registerHandlerFunc, swagger string) (*HTTPServer, error) {
+	tlsConfig, err := serverTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	dialOpt, err := gatewayDialOption(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
 	mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard,
 		&runtime.JSONPb{OrigName: true, EmitDefaults: true}),
-		runtime.WithProtoErrorHandler(errorHandler))
-	opts := []grpc.DialOption{grpc.WithInsecure()}
-	err := rpcpb.RegisterApiServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts)
+		runtime.WithProtoErrorHandler(errorHandler),
+		runtime.WithIncomingHeaderMatcher(headerMatcher))
+	opts := []grpc.DialOption{dialOpt}
+	err = register(ctx, mux, grpcAddr, opts)
 	if err != nil {
+		cancel()
 		return nil, err
 	}
 
 	httpMux := http.NewServeMux()
 	httpMux.HandleFunc("/swagger.json", func(w http.ResponseWriter, req *http.Request) {
-		io.Copy(w, strings.NewReader(pb.Swagger))
+		io.Copy(w, strings.NewReader(swagger))
 	})
 
+	for pattern, handler := range routes {
+		httpMux.Handle(pattern, handler)
+	}
 	httpMux.Handle("/", mux)
 
 	return &HTTPServer{
-		handler:  cors.Default().Handler(httpMux),
-		httpAddr: httpAddr,
-		grpcAddr: grpcAddr,
-		cancel:   cancel,
+		handler:   newCors(cfg.CorsAllowedOrigins).Handler(httpMux),
+		httpAddr:  httpAddr,
+		grpcAddr:  grpcAddr,
+		tlsConfig: tlsConfig,
+		cancel:    cancel,
 	}, nil
 }
 
 // Run starts the server.
 func (srv *HTTPServer) Run() error {
 	defer srv.cancel()
-	return http.ListenAndServe(srv.httpAddr, srv.handler)
+	lis, err := net.Listen("tcp", srv.httpAddr)
+	if err != nil {
+		return err
+	}
+	lis = srv.conns.listener(lis)
+	server := &http.Server{
+		Addr:      srv.httpAddr,
+		Handler:   srv.limit.handler(srv.handler),
+		TLSConfig: srv.tlsConfig,
+	}
+	if srv.tlsConfig == nil {
+		return server.Serve(lis)
+	}
+	return server.ServeTLS(lis, "", "")
+}
+
+// newCors returns cors handler. Cross-origin requests are denied if origins is empty.
+func newCors(origins []string) *cors.Cors {
+	if len(origins) == 0 {
+		return cors.New(cors.Options{
+			AllowOriginFunc: func(origin string) bool { return false },
+		})
+	}
+	return cors.New(cors.Options{
+		AllowedOrigins:   origins,
+		AllowedMethods:   []string{"HEAD", "GET", "POST", "PUT", "PATCH", "DELETE"},
+		AllowedHeaders:   []string{"Authorization", "Content-Type", "X-Api-Key"},
+		AllowCredentials: true,
+	})
+}
+
+// headerMatcher forwards api key header to grpc metadata in addition to the default headers.
+func headerMatcher(key string) (string, bool) {
+	if strings.ToLower(key) == apiKeyHeader {
+		return apiKeyHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
 }
 
 type errorBody struct {
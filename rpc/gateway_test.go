@@ -0,0 +1,45 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCors(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	request := func(origins []string, origin string) string {
+		req := httptest.NewRequest("GET", "http://localhost:9921/v1/node/medstate", nil)
+		req.Header.Set("Origin", origin)
+		w := httptest.NewRecorder()
+		newCors(origins).Handler(handler).ServeHTTP(w, req)
+		return w.Header().Get("Access-Control-Allow-Origin")
+	}
+
+	assert.Empty(t, request(nil, "http://example.com"))
+	assert.Empty(t, request([]string{"http://medibloc.org"}, "http://example.com"))
+	assert.Equal(t, "http://medibloc.org", request([]string{"http://medibloc.org"}, "http://medibloc.org"))
+}
+
+func TestSameOrigin(t *testing.T) {
+	assert.True(t, sameOrigin("http://localhost:9921", "localhost:9921"))
+	assert.False(t, sameOrigin("http://example.com", "localhost:9921"))
+	assert.False(t, sameOrigin("://", "localhost:9921"))
+}
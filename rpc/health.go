@@ -0,0 +1,221 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package rpc
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/medlet/pb"
+	"github.com/medibloc/go-medibloc/net"
+	"github.com/medibloc/go-medibloc/rpc/pb"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+)
+
+// Paths of health check endpoints
+const (
+	LivenessPath  = "/v1/health/live"
+	ReadinessPath = "/v1/health/ready"
+)
+
+// HealthStorageCheckInterval is the interval of writing to the storage. Health checks in the interval report the
+// result of the last write.
+const HealthStorageCheckInterval = 5 * time.Second
+
+// Producer is an interface for checking block production of the node.
+type Producer interface {
+	BlockInterval() time.Duration
+	MissedSlots(now time.Time, n int) (member bool, missed int, err error)
+}
+
+// healthChecker checks whether the node is alive and ready.
+type healthChecker struct {
+	bm       *core.BlockManager
+	ns       net.Service
+	syncer   Syncer
+	producer Producer
+	storage  storage.Storage
+
+	maxTailDelay   uint32
+	maxLIBLag      uint64
+	minPeers       uint32
+	maxMissedSlots uint32
+
+	mu              sync.Mutex
+	writable        bool
+	writableChecked time.Time
+
+	now func() time.Time
+}
+
+func newHealthChecker(bm *core.BlockManager, ns net.Service, syncer Syncer, producer Producer, stor storage.Storage,
+	cfg *medletpb.RPCHealthConfig) *healthChecker {
+	h := &healthChecker{
+		bm:             bm,
+		ns:             ns,
+		syncer:         syncer,
+		producer:       producer,
+		storage:        stor,
+		maxTailDelay:   cfg.GetMaxTailDelay(),
+		maxLIBLag:      cfg.GetMaxLibLag(),
+		minPeers:       cfg.GetMinPeers(),
+		maxMissedSlots: cfg.GetMaxMissedSlots(),
+		now:            time.Now,
+	}
+	if h.maxTailDelay == 0 {
+		h.maxTailDelay = DefaultHealthMaxTailDelay
+	}
+	if h.maxLIBLag == 0 {
+		h.maxLIBLag = DefaultHealthMaxLIBLag
+	}
+	if h.maxMissedSlots == 0 {
+		h.maxMissedSlots = DefaultHealthMaxMissedSlots
+	}
+	return h
+}
+
+// check returns the health of the node. The node is alive if its storage is writable, and it is ready if all
+// checks pass.
+func (h *healthChecker) check() *rpcpb.HealthCheckResponse {
+	now := h.now()
+	resp := &rpcpb.HealthCheckResponse{
+		StorageWritable: h.storageWritable(),
+	}
+	if !resp.StorageWritable {
+		resp.Failures = append(resp.Failures, HealthStorageNotWritable)
+	}
+	resp.Live = resp.StorageWritable
+
+	if h.syncer != nil && h.syncer.IsDownloadActivated() {
+		resp.Syncing = true
+		resp.Failures = append(resp.Failures, HealthSyncing)
+	}
+
+	tail := h.bm.TailBlock()
+	lib := h.bm.LIB()
+	resp.TailHeight = tail.Height()
+	resp.TailAge = now.Unix() - tail.Timestamp()
+	resp.LibHeight = lib.Height()
+	resp.LibLag = tail.Height() - lib.Height()
+	if h.producer != nil {
+		maxAge := time.Duration(h.maxTailDelay) * h.producer.BlockInterval()
+		if time.Duration(resp.TailAge)*time.Second > maxAge {
+			resp.Failures = append(resp.Failures, HealthTailTooOld)
+		}
+	}
+	if resp.LibLag > h.maxLIBLag {
+		resp.Failures = append(resp.Failures, HealthLIBLagTooLarge)
+	}
+
+	if h.ns != nil {
+		resp.Peers = uint32(h.ns.Node().EstablishedPeersCount())
+		if resp.Peers < h.minPeers {
+			resp.Failures = append(resp.Failures, HealthNotEnoughPeers)
+		}
+	}
+
+	if h.producer != nil {
+		member, missed, err := h.producer.MissedSlots(now, HealthRecentSlots)
+		if err != nil {
+			resp.Failures = append(resp.Failures, HealthMissingSlots)
+		}
+		resp.DynastyMember = member
+		resp.MissedSlots = uint32(missed)
+		if member && resp.MissedSlots > h.maxMissedSlots {
+			resp.Failures = append(resp.Failures, HealthMissingSlots)
+		}
+	}
+
+	resp.Ready = resp.Live && len(resp.Failures) == 0
+	resp.Ok = resp.Ready
+	return resp
+}
+
+// storageWritable returns whether the storage is writable. The storage is written at most once in
+// HealthStorageCheckInterval, so frequent probes do not load the storage.
+func (h *healthChecker) storageWritable() bool {
+	if h.storage == nil {
+		return true
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	now := h.now()
+	if !h.writableChecked.IsZero() && now.Sub(h.writableChecked) < HealthStorageCheckInterval {
+		return h.writable
+	}
+	h.writable = h.checkStorage()
+	h.writableChecked = now
+	return h.writable
+}
+
+func (h *healthChecker) checkStorage() bool {
+	key := []byte(healthCheckKey)
+	value := byteutils.FromInt64(h.now().UnixNano())
+	if err := h.storage.Put(key, value); err != nil {
+		return false
+	}
+	stored, err := h.storage.Get(key)
+	if err != nil || !byteutils.Equal(stored, value) {
+		return false
+	}
+	return h.storage.Delete(key) == nil
+}
+
+// healthHandler serves liveness or readiness of the node. It responds 503 if the node is not alive or not ready.
+type healthHandler struct {
+	api       *APIService
+	readiness bool
+	marshaler runtime.Marshaler
+}
+
+func newHealthHandler(api *APIService, readiness bool) *healthHandler {
+	return &healthHandler{
+		api:       api,
+		readiness: readiness,
+		marshaler: &runtime.JSONPb{OrigName: true, EmitDefaults: true},
+	}
+}
+
+func (h *healthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	resp, err := h.api.HealthCheck(r.Context(), &rpcpb.NonParamRequest{})
+	if err != nil {
+		http.Error(w, ErrMsgInternalError, http.StatusInternalServerError)
+		return
+	}
+	body, err := h.marshaler.Marshal(resp)
+	if err != nil {
+		http.Error(w, ErrMsgInternalError, http.StatusInternalServerError)
+		return
+	}
+
+	ok := resp.Live
+	if h.readiness {
+		ok = resp.Ready
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if !ok {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	w.Write(body)
+}
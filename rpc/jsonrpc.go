@@ -0,0 +1,580 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/gorilla/websocket"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/rpc/pb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// JSON-RPC parameters
+const (
+	JSONRPCPath         = "/v1/jsonrpc"
+	JSONRPCVersion      = "2.0"
+	JSONRPCMaxBodySize  = 1024 * 1024
+	JSONRPCMaxBatchSize = 100
+)
+
+// JSON-RPC error codes
+const (
+	JSONRPCParseError     = -32700
+	JSONRPCInvalidRequest = -32600
+	JSONRPCMethodNotFound = -32601
+	JSONRPCInvalidParams  = -32602
+	JSONRPCInternalError  = -32603
+
+	JSONRPCNotFound               = -32001
+	JSONRPCUnavailable            = -32002
+	JSONRPCTransactionRejected    = -32003
+	JSONRPCNotSupported           = -32004
+	JSONRPCLimitExceeded          = -32005
+	JSONRPCUnauthorized           = -32006
+	JSONRPCDuplicatedTransaction  = -32010
+	JSONRPCInvalidChainID         = -32011
+	JSONRPCInvalidTransactionHash = -32012
+	JSONRPCInvalidSigner          = -32013
+)
+
+// JSON-RPC subscription methods
+const (
+	JSONRPCSubscribe    = "med_subscribe"
+	JSONRPCUnsubscribe  = "med_unsubscribe"
+	JSONRPCSubscription = "med_subscription"
+)
+
+// coreErrorCodes maps errors of transaction manager to JSON-RPC error codes.
+var coreErrorCodes = map[error]int{
+	core.ErrDuplicatedTransaction:    JSONRPCDuplicatedTransaction,
+	core.ErrInvalidChainID:           JSONRPCInvalidChainID,
+	core.ErrInvalidTransactionHash:   JSONRPCInvalidTransactionHash,
+	core.ErrInvalidTransactionSigner: JSONRPCInvalidSigner,
+}
+
+type jsonRPCRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// isNotification returns true if the request has no id. Notifications are not responded.
+func (req *jsonRPCRequest) isNotification() bool {
+	return len(req.ID) == 0
+}
+
+type jsonRPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *jsonRPCError   `json:"error,omitempty"`
+}
+
+type jsonRPCError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+type jsonRPCNotification struct {
+	JSONRPC string                     `json:"jsonrpc"`
+	Method  string                     `json:"method"`
+	Params  *jsonRPCSubscriptionResult `json:"params"`
+}
+
+type jsonRPCSubscriptionResult struct {
+	Subscription string          `json:"subscription"`
+	Result       json.RawMessage `json:"result,omitempty"`
+	Error        *jsonRPCError   `json:"error,omitempty"`
+}
+
+// jsonRPCMethod maps a JSON-RPC method to the api service. Positional params are mapped to the fields
+// of the request in order of params.
+type jsonRPCMethod struct {
+	grpcMethod string
+	params     []string
+	newRequest func() proto.Message
+	call       func(ctx context.Context, s *APIService, req proto.Message) (proto.Message, error)
+}
+
+var jsonRPCMethods = map[string]*jsonRPCMethod{
+	"med_getAccount": {
+		grpcMethod: "/rpcpb.ApiService/GetAccount",
+		params:     []string{"address", "type", "height"},
+		newRequest: func() proto.Message { return new(rpcpb.GetAccountRequest) },
+		call: func(ctx context.Context, s *APIService, req proto.Message) (proto.Message, error) {
+			return s.GetAccount(ctx, req.(*rpcpb.GetAccountRequest))
+		},
+	},
+	"med_getBlock": {
+		grpcMethod: "/rpcpb.ApiService/GetBlock",
+		params:     []string{"hash", "type", "height"},
+		newRequest: func() proto.Message { return new(rpcpb.GetBlockRequest) },
+		call: func(ctx context.Context, s *APIService, req proto.Message) (proto.Message, error) {
+			return s.GetBlock(ctx, req.(*rpcpb.GetBlockRequest))
+		},
+	},
+	"med_getBlockByHeight": {
+		grpcMethod: "/rpcpb.ApiService/GetBlock",
+		params:     []string{"height"},
+		newRequest: func() proto.Message { return new(rpcpb.GetBlockRequest) },
+		call: func(ctx context.Context, s *APIService, req proto.Message) (proto.Message, error) {
+			r := req.(*rpcpb.GetBlockRequest)
+			return s.GetBlock(ctx, &rpcpb.GetBlockRequest{Height: r.Height})
+		},
+	},
+	"med_getBlockByHash": {
+		grpcMethod: "/rpcpb.ApiService/GetBlock",
+		params:     []string{"hash"},
+		newRequest: func() proto.Message { return new(rpcpb.GetBlockRequest) },
+		call: func(ctx context.Context, s *APIService, req proto.Message) (proto.Message, error) {
+			r := req.(*rpcpb.GetBlockRequest)
+			if r.Hash == "" {
+				return nil, status.Error(codes.InvalidArgument, ErrMsgInvalidRequest)
+			}
+			return s.GetBlock(ctx, &rpcpb.GetBlockRequest{Hash: r.Hash})
+		},
+	},
+	"med_getBlocks": {
+		grpcMethod: "/rpcpb.ApiService/GetBlocks",
+		params:     []string{"from", "to"},
+		newRequest: func() proto.Message { return new(rpcpb.GetBlocksRequest) },
+		call: func(ctx context.Context, s *APIService, req proto.Message) (proto.Message, error) {
+			return s.GetBlocks(ctx, req.(*rpcpb.GetBlocksRequest))
+		},
+	},
+	"med_getCandidates": {
+		grpcMethod: "/rpcpb.ApiService/GetCandidates",
+		newRequest: func() proto.Message { return new(rpcpb.NonParamRequest) },
+		call: func(ctx context.Context, s *APIService, req proto.Message) (proto.Message, error) {
+			return s.GetCandidates(ctx, req.(*rpcpb.NonParamRequest))
+		},
+	},
+	"med_getDynasty": {
+		grpcMethod: "/rpcpb.ApiService/GetDynasty",
+		newRequest: func() proto.Message { return new(rpcpb.NonParamRequest) },
+		call: func(ctx context.Context, s *APIService, req proto.Message) (proto.Message, error) {
+			return s.GetDynasty(ctx, req.(*rpcpb.NonParamRequest))
+		},
+	},
+	"med_getMedState": {
+		grpcMethod: "/rpcpb.ApiService/GetMedState",
+		newRequest: func() proto.Message { return new(rpcpb.NonParamRequest) },
+		call: func(ctx context.Context, s *APIService, req proto.Message) (proto.Message, error) {
+			return s.GetMedState(ctx, req.(*rpcpb.NonParamRequest))
+		},
+	},
+	"med_getPendingTransactions": {
+		grpcMethod: "/rpcpb.ApiService/GetPendingTransactions",
+		newRequest: func() proto.Message { return new(rpcpb.NonParamRequest) },
+		call: func(ctx context.Context, s *APIService, req proto.Message) (proto.Message, error) {
+			return s.GetPendingTransactions(ctx, req.(*rpcpb.NonParamRequest))
+		},
+	},
+	"med_getTransaction": {
+		grpcMethod: "/rpcpb.ApiService/GetTransaction",
+		params:     []string{"hash"},
+		newRequest: func() proto.Message { return new(rpcpb.GetTransactionRequest) },
+		call: func(ctx context.Context, s *APIService, req proto.Message) (proto.Message, error) {
+			return s.GetTransaction(ctx, req.(*rpcpb.GetTransactionRequest))
+		},
+	},
+	"med_getAccountTransactions": {
+		grpcMethod: "/rpcpb.ApiService/GetAccountTransactions",
+		params:     []string{"address", "include_pending", "offset", "limit"},
+		newRequest: func() proto.Message { return new(rpcpb.GetAccountTransactionsRequest) },
+		call: func(ctx context.Context, s *APIService, req proto.Message) (proto.Message, error) {
+			return s.GetAccountTransactions(ctx, req.(*rpcpb.GetAccountTransactionsRequest))
+		},
+	},
+	"med_sendTransaction": {
+		grpcMethod: "/rpcpb.ApiService/SendTransaction",
+		newRequest: func() proto.Message { return new(rpcpb.SendTransactionRequest) },
+		call: func(ctx context.Context, s *APIService, req proto.Message) (proto.Message, error) {
+			return s.sendTransaction(req.(*rpcpb.SendTransactionRequest))
+		},
+	},
+	"med_getRecord": {
+		grpcMethod: "/rpcpb.ApiService/GetRecord",
+		params:     []string{"address", "record_hash"},
+		newRequest: func() proto.Message { return new(rpcpb.GetRecordRequest) },
+		call: func(ctx context.Context, s *APIService, req proto.Message) (proto.Message, error) {
+			return s.GetRecord(ctx, req.(*rpcpb.GetRecordRequest))
+		},
+	},
+	"med_listAccountRecords": {
+		grpcMethod: "/rpcpb.ApiService/ListAccountRecords",
+		params:     []string{"address", "offset", "limit"},
+		newRequest: func() proto.Message { return new(rpcpb.ListAccountRecordsRequest) },
+		call: func(ctx context.Context, s *APIService, req proto.Message) (proto.Message, error) {
+			return s.ListAccountRecords(ctx, req.(*rpcpb.ListAccountRecordsRequest))
+		},
+	},
+	"med_getCertification": {
+		grpcMethod: "/rpcpb.ApiService/GetCertification",
+		params:     []string{"address", "certificate_hash"},
+		newRequest: func() proto.Message { return new(rpcpb.GetCertificationRequest) },
+		call: func(ctx context.Context, s *APIService, req proto.Message) (proto.Message, error) {
+			return s.GetCertification(ctx, req.(*rpcpb.GetCertificationRequest))
+		},
+	},
+	"med_listAccountCertifications": {
+		grpcMethod: "/rpcpb.ApiService/ListAccountCertifications",
+		params:     []string{"address", "direction", "issuer", "cert_type", "status", "offset", "limit"},
+		newRequest: func() proto.Message { return new(rpcpb.ListAccountCertificationsRequest) },
+		call: func(ctx context.Context, s *APIService, req proto.Message) (proto.Message, error) {
+			return s.ListAccountCertifications(ctx, req.(*rpcpb.ListAccountCertificationsRequest))
+		},
+	},
+	"med_getIssuer": {
+		grpcMethod: "/rpcpb.ApiService/GetIssuer",
+		params:     []string{"address", "cert_type"},
+		newRequest: func() proto.Message { return new(rpcpb.GetIssuerRequest) },
+		call: func(ctx context.Context, s *APIService, req proto.Message) (proto.Message, error) {
+			return s.GetIssuer(ctx, req.(*rpcpb.GetIssuerRequest))
+		},
+	},
+	"med_getRecordHistory": {
+		grpcMethod: "/rpcpb.ApiService/GetRecordHistory",
+		params:     []string{"address", "record_hash"},
+		newRequest: func() proto.Message { return new(rpcpb.GetRecordHistoryRequest) },
+		call: func(ctx context.Context, s *APIService, req proto.Message) (proto.Message, error) {
+			return s.GetRecordHistory(ctx, req.(*rpcpb.GetRecordHistoryRequest))
+		},
+	},
+	"med_verifyRecordProof": {
+		grpcMethod: "/rpcpb.ApiService/VerifyRecordProof",
+		params:     []string{"owner", "merkle_root", "record_hash", "index", "path"},
+		newRequest: func() proto.Message { return new(rpcpb.VerifyRecordProofRequest) },
+		call: func(ctx context.Context, s *APIService, req proto.Message) (proto.Message, error) {
+			return s.VerifyRecordProof(ctx, req.(*rpcpb.VerifyRecordProofRequest))
+		},
+	},
+	"med_getEvents": {
+		grpcMethod: "/rpcpb.ApiService/GetEvents",
+		params:     []string{"from_seq", "from_height", "limit", "topics"},
+		newRequest: func() proto.Message { return new(rpcpb.GetEventsRequest) },
+		call: func(ctx context.Context, s *APIService, req proto.Message) (proto.Message, error) {
+			return s.GetEvents(ctx, req.(*rpcpb.GetEventsRequest))
+		},
+	},
+	"med_healthCheck": {
+		grpcMethod: "/rpcpb.ApiService/HealthCheck",
+		newRequest: func() proto.Message { return new(rpcpb.NonParamRequest) },
+		call: func(ctx context.Context, s *APIService, req proto.Message) (proto.Message, error) {
+			return s.HealthCheck(ctx, req.(*rpcpb.NonParamRequest))
+		},
+	},
+}
+
+// jsonRPCSubscribeParams are positional params of med_subscribe.
+var jsonRPCSubscribeParams = []string{"topics", "addresses", "tx_types", "record_hashes", "include_payload",
+	"from_height", "allow_drop", "from_seq"}
+
+// jsonRPCHandler serves JSON-RPC 2.0 over http post and websocket.
+type jsonRPCHandler struct {
+	api       *APIService
+	auth      *authorizer
+	limit     *limiter
+	ws        *wsHandler
+	marshaler *runtime.JSONPb
+}
+
+func newJSONRPCHandler(api *APIService, auth *authorizer, limit *limiter, origins []string) *jsonRPCHandler {
+	h := &jsonRPCHandler{
+		api:       api,
+		auth:      auth,
+		limit:     limit,
+		marshaler: &runtime.JSONPb{OrigName: true, EmitDefaults: true},
+	}
+	h.ws = newWSHandler(api, auth, limit, origins, h.handleWSMessage)
+	return h
+}
+
+func (h *jsonRPCHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if websocket.IsWebSocketUpgrade(r) {
+		h.ws.ServeHTTP(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	data, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, JSONRPCMaxBodySize))
+	if err != nil {
+		writeJSONRPC(w, newJSONRPCErrorResponse(nil, &jsonRPCError{Code: JSONRPCInvalidRequest, Message: ErrMsgRequestTooLarge}))
+		return
+	}
+	resp := h.handleMessage(requestContext(r), data, nil)
+	if resp == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	writeJSONRPC(w, resp)
+}
+
+func writeJSONRPC(w http.ResponseWriter, resp interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// jsonRPCConn is a websocket connection handling a message. Subscriptions made by the message start
+// sending events after ready is closed.
+type jsonRPCConn struct {
+	conn  *wsConn
+	ready chan struct{}
+}
+
+// handleMessage handles a single request or a batch. It returns nil if nothing should be responded.
+// Subscriptions are available only if the message is received from websocket connection c.
+func (h *jsonRPCHandler) handleMessage(ctx context.Context, data []byte, c *jsonRPCConn) interface{} {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '[' {
+		resp := h.handleRequest(ctx, data, c)
+		if resp == nil {
+			return nil
+		}
+		return resp
+	}
+
+	var batch []json.RawMessage
+	if err := json.Unmarshal(data, &batch); err != nil {
+		return newJSONRPCErrorResponse(nil, &jsonRPCError{Code: JSONRPCParseError, Message: ErrMsgParseError})
+	}
+	if len(batch) == 0 {
+		return newJSONRPCErrorResponse(nil, &jsonRPCError{Code: JSONRPCInvalidRequest, Message: ErrMsgInvalidRequest})
+	}
+	if len(batch) > JSONRPCMaxBatchSize {
+		return newJSONRPCErrorResponse(nil, &jsonRPCError{Code: JSONRPCLimitExceeded, Message: ErrMsgBatchTooLarge})
+	}
+
+	var resps []*jsonRPCResponse
+	for _, raw := range batch {
+		if resp := h.handleRequest(ctx, raw, c); resp != nil {
+			resps = append(resps, resp)
+		}
+	}
+	if len(resps) == 0 {
+		return nil
+	}
+	return resps
+}
+
+func (h *jsonRPCHandler) handleRequest(ctx context.Context, data []byte, c *jsonRPCConn) *jsonRPCResponse {
+	req := new(jsonRPCRequest)
+	if err := json.Unmarshal(data, req); err != nil {
+		if json.Valid(data) {
+			return newJSONRPCErrorResponse(nil, &jsonRPCError{Code: JSONRPCInvalidRequest, Message: ErrMsgInvalidRequest})
+		}
+		return newJSONRPCErrorResponse(nil, &jsonRPCError{Code: JSONRPCParseError, Message: ErrMsgParseError})
+	}
+	if req.JSONRPC != JSONRPCVersion || req.Method == "" {
+		return newJSONRPCErrorResponse(req.ID, &jsonRPCError{Code: JSONRPCInvalidRequest, Message: ErrMsgInvalidRequest})
+	}
+
+	result, rpcErr := h.call(ctx, req, c)
+	if req.isNotification() {
+		return nil
+	}
+	if rpcErr != nil {
+		return newJSONRPCErrorResponse(req.ID, rpcErr)
+	}
+	return &jsonRPCResponse{JSONRPC: JSONRPCVersion, ID: req.ID, Result: result}
+}
+
+func (h *jsonRPCHandler) call(ctx context.Context, req *jsonRPCRequest, c *jsonRPCConn) (json.RawMessage, *jsonRPCError) {
+	switch req.Method {
+	case JSONRPCSubscribe, JSONRPCUnsubscribe:
+		if c == nil {
+			return nil, &jsonRPCError{Code: JSONRPCNotSupported, Message: ErrMsgWebSocketRequired}
+		}
+		if req.Method == JSONRPCSubscribe {
+			return h.subscribe(ctx, req, c)
+		}
+		return h.unsubscribe(req, c)
+	}
+
+	m, ok := jsonRPCMethods[req.Method]
+	if !ok {
+		return nil, &jsonRPCError{Code: JSONRPCMethodNotFound, Message: ErrMsgUnknownMethod}
+	}
+	if err := h.authorize(ctx, m.grpcMethod); err != nil {
+		return nil, toJSONRPCError(err)
+	}
+
+	in := m.newRequest()
+	if err := decodeJSONRPCParams(req.Params, m.params, in); err != nil {
+		return nil, &jsonRPCError{Code: JSONRPCInvalidParams, Message: ErrMsgInvalidParams}
+	}
+	out, err := m.call(ctx, h.api, in)
+	if err != nil {
+		return nil, toJSONRPCError(err)
+	}
+	result, err := h.marshaler.Marshal(out)
+	if err != nil {
+		return nil, &jsonRPCError{Code: JSONRPCInternalError, Message: ErrMsgInternalError}
+	}
+	return result, nil
+}
+
+func (h *jsonRPCHandler) authorize(ctx context.Context, fullMethod string) error {
+	if err := h.limit.limitRate(ctx, fullMethod); err != nil {
+		return err
+	}
+	return h.auth.authorize(ctx, fullMethod)
+}
+
+func (h *jsonRPCHandler) subscribe(ctx context.Context, req *jsonRPCRequest, c *jsonRPCConn) (json.RawMessage, *jsonRPCError) {
+	if err := h.authorize(ctx, subscribeMethod); err != nil {
+		return nil, toJSONRPCError(err)
+	}
+	subReq := new(rpcpb.SubscribeRequest)
+	if err := decodeJSONRPCParams(req.Params, jsonRPCSubscribeParams, subReq); err != nil {
+		return nil, &jsonRPCError{Code: JSONRPCInvalidParams, Message: ErrMsgInvalidParams}
+	}
+
+	id, err := c.conn.subscribe(ctx, subReq, c.ready, func(id string, event json.RawMessage) interface{} {
+		return &jsonRPCNotification{
+			JSONRPC: JSONRPCVersion,
+			Method:  JSONRPCSubscription,
+			Params:  &jsonRPCSubscriptionResult{Subscription: id, Result: event},
+		}
+	}, func(id string, err error) interface{} {
+		return &jsonRPCNotification{
+			JSONRPC: JSONRPCVersion,
+			Method:  JSONRPCSubscription,
+			Params:  &jsonRPCSubscriptionResult{Subscription: id, Error: toJSONRPCError(err)},
+		}
+	})
+	if err != nil {
+		return nil, toJSONRPCError(err)
+	}
+	result, _ := json.Marshal(id)
+	return result, nil
+}
+
+func (h *jsonRPCHandler) unsubscribe(req *jsonRPCRequest, c *jsonRPCConn) (json.RawMessage, *jsonRPCError) {
+	var id string
+	var ids []string
+	params := new(wsUnsubscribeParams)
+	switch {
+	case json.Unmarshal(req.Params, &ids) == nil && len(ids) == 1:
+		id = ids[0]
+	case json.Unmarshal(req.Params, params) == nil:
+		id = params.Subscription
+	default:
+		return nil, &jsonRPCError{Code: JSONRPCInvalidParams, Message: ErrMsgInvalidParams}
+	}
+	if !c.conn.unsubscribe(id) {
+		return nil, &jsonRPCError{Code: JSONRPCNotFound, Message: ErrMsgSubscriptionNotFound}
+	}
+	return json.RawMessage("true"), nil
+}
+
+func (h *jsonRPCHandler) handleWSMessage(ctx context.Context, c *wsConn, data []byte) {
+	conn := &jsonRPCConn{conn: c, ready: make(chan struct{})}
+	if resp := h.handleMessage(ctx, data, conn); resp != nil {
+		c.write(resp)
+	}
+	close(conn.ready)
+}
+
+func newJSONRPCErrorResponse(id json.RawMessage, err *jsonRPCError) *jsonRPCResponse {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	return &jsonRPCResponse{JSONRPC: JSONRPCVersion, ID: id, Error: err}
+}
+
+// decodeJSONRPCParams decodes params into msg. Params are either an object of the request, an array
+// containing the object or an array of values in order of names.
+func decodeJSONRPCParams(params json.RawMessage, names []string, msg proto.Message) error {
+	params = bytes.TrimSpace(params)
+	if len(params) == 0 || bytes.Equal(params, []byte("null")) {
+		return nil
+	}
+
+	obj := params
+	if params[0] == '[' {
+		var values []json.RawMessage
+		if err := json.Unmarshal(params, &values); err != nil {
+			return err
+		}
+		switch {
+		case len(values) == 0:
+			return nil
+		case len(values) == 1 && bytes.HasPrefix(bytes.TrimSpace(values[0]), []byte("{")):
+			obj = values[0]
+		case len(values) > len(names):
+			return status.Error(codes.InvalidArgument, ErrMsgInvalidParams)
+		default:
+			fields := make(map[string]json.RawMessage)
+			for i, v := range values {
+				fields[names[i]] = v
+			}
+			b, err := json.Marshal(fields)
+			if err != nil {
+				return err
+			}
+			obj = b
+		}
+	}
+	return (&runtime.JSONPb{OrigName: true}).Unmarshal(obj, msg)
+}
+
+// toJSONRPCError converts error of the api service to JSON-RPC error. Errors which are not grpc status
+// are the reasons why transaction manager rejected the transaction.
+func toJSONRPCError(err error) *jsonRPCError {
+	s, ok := status.FromError(err)
+	if !ok {
+		code, ok := coreErrorCodes[err]
+		if !ok {
+			code = JSONRPCTransactionRejected
+		}
+		return &jsonRPCError{Code: code, Message: ErrMsgInvalidTransaction, Data: err.Error()}
+	}
+
+	var code int
+	switch s.Code() {
+	case codes.InvalidArgument, codes.OutOfRange:
+		code = JSONRPCInvalidParams
+	case codes.NotFound:
+		code = JSONRPCNotFound
+	case codes.FailedPrecondition, codes.Unavailable:
+		code = JSONRPCUnavailable
+	case codes.Unimplemented:
+		code = JSONRPCNotSupported
+	case codes.ResourceExhausted:
+		code = JSONRPCLimitExceeded
+	case codes.Unauthenticated, codes.PermissionDenied:
+		code = JSONRPCUnauthorized
+	default:
+		code = JSONRPCInternalError
+	}
+	return &jsonRPCError{Code: code, Message: s.Message()}
+}
@@ -0,0 +1,175 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/medlet/pb"
+	"github.com/medibloc/go-medibloc/rpc/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDecodeJSONRPCParams(t *testing.T) {
+	names := jsonRPCMethods["med_getAccount"].params
+
+	req := new(rpcpb.GetAccountRequest)
+	require.NoError(t, decodeJSONRPCParams(json.RawMessage(`["0x01", "tail", 3]`), names, req))
+	assert.Equal(t, &rpcpb.GetAccountRequest{Address: "0x01", Type: "tail", Height: 3}, req)
+
+	req = new(rpcpb.GetAccountRequest)
+	require.NoError(t, decodeJSONRPCParams(json.RawMessage(`{"address": "0x01", "height": "3"}`), names, req))
+	assert.Equal(t, &rpcpb.GetAccountRequest{Address: "0x01", Height: 3}, req)
+
+	req = new(rpcpb.GetAccountRequest)
+	require.NoError(t, decodeJSONRPCParams(json.RawMessage(`[{"type": "genesis"}]`), names, req))
+	assert.Equal(t, &rpcpb.GetAccountRequest{Type: "genesis"}, req)
+
+	assert.Error(t, decodeJSONRPCParams(json.RawMessage(`["0x01", "tail", 3, 4]`), names, req))
+	assert.Error(t, decodeJSONRPCParams(json.RawMessage(`{"height": "abc"}`), names, req))
+}
+
+func TestToJSONRPCError(t *testing.T) {
+	assert.Equal(t, JSONRPCNotFound, toJSONRPCError(status.Error(codes.NotFound, ErrMsgBlockNotFound)).Code)
+	assert.Equal(t, JSONRPCInvalidParams, toJSONRPCError(status.Error(codes.InvalidArgument, ErrMsgInvalidTxHash)).Code)
+	assert.Equal(t, JSONRPCLimitExceeded, toJSONRPCError(status.Error(codes.ResourceExhausted, ErrMsgRateLimitExceeded)).Code)
+	assert.Equal(t, JSONRPCUnauthorized, toJSONRPCError(status.Error(codes.PermissionDenied, ErrMsgPermissionDenied)).Code)
+	assert.Equal(t, JSONRPCInternalError, toJSONRPCError(status.Error(codes.Internal, ErrMsgInternalError)).Code)
+
+	err := toJSONRPCError(core.ErrDuplicatedTransaction)
+	assert.Equal(t, JSONRPCDuplicatedTransaction, err.Code)
+	assert.Equal(t, core.ErrDuplicatedTransaction.Error(), err.Data)
+	assert.Equal(t, JSONRPCTransactionRejected, toJSONRPCError(errors.New("rejected")).Code)
+}
+
+func TestJSONRPCHTTP(t *testing.T) {
+	cfg := &medletpb.RPCConfig{}
+	auth := newAuthorizer(cfg)
+	api := newAPIService(nil, nil, nil, cfg)
+	server := httptest.NewServer(newJSONRPCHandler(api, auth, newLimiter(cfg, auth), nil))
+	defer server.Close()
+
+	post := func(body string) (int, string) {
+		resp, err := http.Post(server.URL, "application/json", strings.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		b, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, strings.TrimSpace(string(b))
+	}
+
+	code, body := post(`{"jsonrpc": "2.0", "id": 1, "method": "med_healthCheck"}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.JSONEq(t, `{"jsonrpc": "2.0", "id": 1, "result": {"ok": true}}`, body)
+
+	_, body = post(`[
+		{"jsonrpc": "2.0", "id": 1, "method": "med_healthCheck"},
+		{"jsonrpc": "2.0", "method": "med_healthCheck"},
+		{"jsonrpc": "2.0", "id": "a", "method": "med_unknown"},
+		{"jsonrpc": "2.0", "id": 2, "method": "med_subscribe"},
+		{"jsonrpc": "1.0", "id": 3, "method": "med_healthCheck"},
+		1
+	]`)
+	assert.JSONEq(t, `[
+		{"jsonrpc": "2.0", "id": 1, "result": {"ok": true}},
+		{"jsonrpc": "2.0", "id": "a", "error": {"code": -32601, "message": "unknown method"}},
+		{"jsonrpc": "2.0", "id": 2, "error": {"code": -32004, "message": "method is available only over websocket"}},
+		{"jsonrpc": "2.0", "id": 3, "error": {"code": -32600, "message": "invalid request"}},
+		{"jsonrpc": "2.0", "id": null, "error": {"code": -32600, "message": "invalid request"}}
+	]`, body)
+
+	_, body = post(`{"jsonrpc": "2.0", "id": 1, "method": "med_getAccount", "params": [1, 2, 3, 4]}`)
+	assert.JSONEq(t, `{"jsonrpc": "2.0", "id": 1, "error": {"code": -32602, "message": "invalid params"}}`, body)
+
+	_, body = post(`{"jsonrpc": "2.0", "id": 1, `)
+	assert.JSONEq(t, `{"jsonrpc": "2.0", "id": null, "error": {"code": -32700, "message": "parse error"}}`, body)
+
+	code, _ = post(`{"jsonrpc": "2.0", "method": "med_healthCheck"}`)
+	assert.Equal(t, http.StatusNoContent, code)
+}
+
+func TestJSONRPCWebSocket(t *testing.T) {
+	ee := core.NewEventEmitter(1024)
+	ee.Start()
+	defer ee.Stop()
+
+	cfg := &medletpb.RPCConfig{}
+	auth := newAuthorizer(cfg)
+	api := newAPIService(nil, nil, ee, cfg)
+	server := httptest.NewServer(newJSONRPCHandler(api, auth, newLimiter(cfg, auth), nil))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	read := func() map[string]interface{} {
+		msg := make(map[string]interface{})
+		require.NoError(t, conn.ReadJSON(&msg))
+		return msg
+	}
+
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  JSONRPCSubscribe,
+		"params":  []interface{}{[]string{core.TopicNewTailBlock}},
+	}))
+	msg := read()
+	assert.Equal(t, float64(1), msg["id"])
+	subID := msg["result"].(string)
+
+	// wait for the subscription to be registered
+	time.Sleep(100 * time.Millisecond)
+	ee.Trigger(&core.Event{Topic: core.TopicNewTailBlock, Data: "0xabcd"})
+	msg = read()
+	assert.Equal(t, JSONRPCSubscription, msg["method"])
+	params := msg["params"].(map[string]interface{})
+	assert.Equal(t, subID, params["subscription"])
+	assert.Equal(t, "0xabcd", params["result"].(map[string]interface{})["hash"])
+
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      2,
+		"method":  JSONRPCUnsubscribe,
+		"params":  []string{subID},
+	}))
+	msg = read()
+	assert.Equal(t, float64(2), msg["id"])
+	assert.Equal(t, true, msg["result"])
+
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      3,
+		"method":  JSONRPCUnsubscribe,
+		"params":  []string{subID},
+	}))
+	msg = read()
+	assert.Equal(t, float64(JSONRPCNotFound), msg["error"].(map[string]interface{})["code"])
+}
@@ -0,0 +1,379 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package rpc
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/medibloc/go-medibloc/medlet/pb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	forwardedForHeader = "x-forwarded-for"
+
+	bucketSweepInterval = time.Minute
+)
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rateLimiter is a token bucket rate limiter keyed by client.
+type rateLimiter struct {
+	mu        sync.Mutex
+	rate      float64
+	burst     float64
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+func newRateLimiter(rate float64, burst uint32) *rateLimiter {
+	if burst == 0 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:      rate,
+		burst:     float64(burst),
+		buckets:   make(map[string]*tokenBucket),
+		lastSweep: time.Now(),
+	}
+}
+
+func (rl *rateLimiter) allow(key string, now time.Time) bool {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	rl.sweep(now)
+
+	b, ok := rl.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: rl.burst, last: now}
+		rl.buckets[key] = b
+	}
+	b.tokens += now.Sub(b.last).Seconds() * rl.rate
+	if b.tokens > rl.burst {
+		b.tokens = rl.burst
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// sweep removes buckets which are refilled to burst.
+func (rl *rateLimiter) sweep(now time.Time) {
+	if now.Sub(rl.lastSweep) < bucketSweepInterval {
+		return
+	}
+	rl.lastSweep = now
+	for key, b := range rl.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*rl.rate >= rl.burst {
+			delete(rl.buckets, key)
+		}
+	}
+}
+
+// limiter limits in-flight requests and request rates of rpc clients.
+type limiter struct {
+	auth        *authorizer
+	maxInFlight int32
+	inFlight    int32
+	rates       map[string]*rateLimiter
+}
+
+func newLimiter(cfg *medletpb.RPCConfig, auth *authorizer) *limiter {
+	l := &limiter{
+		auth:        auth,
+		maxInFlight: cfg.ConnectionLimits,
+		rates:       make(map[string]*rateLimiter),
+	}
+	for _, r := range cfg.RateLimits {
+		if r.Rate <= 0 {
+			continue
+		}
+		l.rates[r.MethodClass] = newRateLimiter(r.Rate, r.Burst)
+	}
+	return l
+}
+
+// acquire reserves an in-flight request slot. release should be called if it returns nil.
+func (l *limiter) acquire() error {
+	n := atomic.AddInt32(&l.inFlight, 1)
+	if l.maxInFlight > 0 && n > l.maxInFlight {
+		atomic.AddInt32(&l.inFlight, -1)
+		metricsInFlightRejected.Mark(1)
+		return status.Error(codes.ResourceExhausted, ErrMsgTooManyConnections)
+	}
+	metricsInFlight.Update(int64(n))
+	return nil
+}
+
+func (l *limiter) release() {
+	n := atomic.AddInt32(&l.inFlight, -1)
+	metricsInFlight.Update(int64(n))
+}
+
+// acquireGrpc reserves an in-flight request slot for a grpc request. Requests forwarded by the http gateway
+// already hold the slot of their http request.
+func (l *limiter) acquireGrpc(ctx context.Context) (release func(), err error) {
+	if fromGateway(ctx) {
+		return func() {}, nil
+	}
+	if err := l.acquire(); err != nil {
+		return nil, err
+	}
+	return l.release, nil
+}
+
+// handler is a http middleware limiting in-flight requests.
+func (l *limiter) handler(next http.Handler) http.Handler {
+	if l == nil || l.maxInFlight <= 0 {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := l.acquire(); err != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusTooManyRequests)
+			json.NewEncoder(w).Encode(errorBody{Error: ErrMsgTooManyConnections})
+			return
+		}
+		defer l.release()
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (l *limiter) limitRate(ctx context.Context, fullMethod string) error {
+	class := methodPermission(fullMethod)
+	metricsRequests.Mark(1)
+	metricsRequestsByClass(class)
+
+	rl, ok := l.rates[class]
+	if !ok {
+		return nil
+	}
+	if !rl.allow(l.clientKey(ctx), time.Now()) {
+		metricsRateLimitedByClass(class)
+		return status.Error(codes.ResourceExhausted, ErrMsgRateLimitExceeded)
+	}
+	return nil
+}
+
+// clientKey identifies a client by its api key if the key is valid, otherwise by its ip address.
+func (l *limiter) clientKey(ctx context.Context) string {
+	if key := apiKeyFromContext(ctx); key != "" && l.auth.lookup(key) != nil {
+		return "key:" + key
+	}
+	return "ip:" + clientIP(ctx)
+}
+
+// clientIP returns ip address of the client. Forwarded address is trusted only if the request comes
+// from loopback, which is the case of the http gateway.
+func clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	if !isLoopback(host) {
+		return host
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return host
+	}
+	fwd := md[forwardedForHeader]
+	if len(fwd) == 0 {
+		return host
+	}
+	// the gateway appends the address of the http client to the end.
+	addrs := strings.Split(fwd[len(fwd)-1], ",")
+	if last := strings.TrimSpace(addrs[len(addrs)-1]); last != "" {
+		return last
+	}
+	return host
+}
+
+// fromGateway returns whether the request is forwarded by the http gateway.
+func fromGateway(ctx context.Context) bool {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil || !isLoopbackAddr(p.Addr) {
+		return false
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	return ok && len(md[forwardedForHeader]) > 0
+}
+
+func isLoopbackAddr(addr net.Addr) bool {
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		host = addr.String()
+	}
+	return isLoopback(host)
+}
+
+func isLoopback(host string) bool {
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func (l *limiter) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := l.limitRate(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	release, err := l.acquireGrpc(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return handler(ctx, req)
+}
+
+func (l *limiter) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := l.limitRate(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	release, err := l.acquireGrpc(ss.Context())
+	if err != nil {
+		return err
+	}
+	defer release()
+	return handler(srv, ss)
+}
+
+func chainUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		chained := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chained
+			chained = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return chained(ctx, req)
+	}
+}
+
+func chainStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		chained := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chained
+			chained = func(srv interface{}, ss grpc.ServerStream) error {
+				return interceptor(srv, ss, info, next)
+			}
+		}
+		return chained(srv, ss)
+	}
+}
+
+// connLimiter limits open connections of every rpc listener in process. It is a backstop of the in-flight request
+// limit against idle connections. Connections over the limit are closed as soon as they are accepted.
+type connLimiter struct {
+	max  int32
+	open int32
+}
+
+func newConnLimiter(max int32) *connLimiter {
+	return &connLimiter{max: max}
+}
+
+// listener wraps the listener to count its connections. It returns the listener as it is if unlimited.
+func (c *connLimiter) listener(lis net.Listener) net.Listener {
+	if c == nil || c.max <= 0 {
+		return lis
+	}
+	return &limitListener{Listener: lis, limit: c}
+}
+
+// grpcListener wraps the grpc listener to count its connections except loopback ones, which are opened by the http
+// gateway on behalf of the clients counted by the http listener.
+func (c *connLimiter) grpcListener(lis net.Listener) net.Listener {
+	if c == nil || c.max <= 0 {
+		return lis
+	}
+	return &limitListener{Listener: lis, limit: c, skipLoopback: true}
+}
+
+func (c *connLimiter) acquire() bool {
+	n := atomic.AddInt32(&c.open, 1)
+	if n > c.max {
+		atomic.AddInt32(&c.open, -1)
+		metricsConnectionRejected.Mark(1)
+		return false
+	}
+	metricsConnections.Update(int64(n))
+	return true
+}
+
+func (c *connLimiter) release() {
+	n := atomic.AddInt32(&c.open, -1)
+	metricsConnections.Update(int64(n))
+}
+
+type limitListener struct {
+	net.Listener
+	limit        *connLimiter
+	skipLoopback bool
+}
+
+func (l *limitListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+		if l.skipLoopback && isLoopbackAddr(conn.RemoteAddr()) {
+			return conn, nil
+		}
+		if !l.limit.acquire() {
+			conn.Close()
+			continue
+		}
+		return &limitConn{Conn: conn, limit: l.limit}, nil
+	}
+}
+
+type limitConn struct {
+	net.Conn
+	limit *connLimiter
+	once  sync.Once
+}
+
+func (c *limitConn) Close() error {
+	err := c.Conn.Close()
+	c.once.Do(c.limit.release)
+	return err
+}
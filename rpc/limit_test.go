@@ -0,0 +1,175 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package rpc
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/medibloc/go-medibloc/medlet/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestRateLimiter(t *testing.T) {
+	rl := newRateLimiter(2, 3)
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		assert.True(t, rl.allow("a", now))
+	}
+	assert.False(t, rl.allow("a", now))
+	assert.True(t, rl.allow("b", now))
+
+	now = now.Add(500 * time.Millisecond)
+	assert.True(t, rl.allow("a", now))
+	assert.False(t, rl.allow("a", now))
+
+	now = now.Add(bucketSweepInterval)
+	assert.True(t, rl.allow("a", now))
+	assert.Len(t, rl.buckets, 1)
+}
+
+func ctxFromPeer(addr string, kv ...string) context.Context {
+	tcpAddr, _ := net.ResolveTCPAddr("tcp", addr)
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: tcpAddr})
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(kv...))
+}
+
+func TestClientIP(t *testing.T) {
+	assert.Equal(t, "10.0.0.1", clientIP(ctxFromPeer("10.0.0.1:5000", "x-forwarded-for", "10.0.0.2")))
+	assert.Equal(t, "127.0.0.1", clientIP(ctxFromPeer("127.0.0.1:5000")))
+	assert.Equal(t, "10.0.0.3", clientIP(ctxFromPeer("127.0.0.1:5000", "x-forwarded-for", "10.0.0.2, 10.0.0.3")))
+}
+
+func TestLimiter(t *testing.T) {
+	const sendTx = "/rpcpb.ApiService/SendTransaction"
+
+	cfg := &medletpb.RPCConfig{
+		RateLimits: []*medletpb.RPCRateLimit{
+			{MethodClass: PermissionSendTransaction, Rate: 1, Burst: 1},
+		},
+		ApiKeys: []*medletpb.RPCAPIKey{
+			{Key: "key", Permissions: []string{PermissionSendTransaction}},
+		},
+	}
+	l := newLimiter(cfg, newAuthorizer(cfg))
+
+	assert.NoError(t, l.limitRate(ctxFromPeer("10.0.0.1:5000"), sendTx))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(l.limitRate(ctxFromPeer("10.0.0.1:5001"), sendTx)))
+	assert.NoError(t, l.limitRate(ctxFromPeer("10.0.0.2:5000"), sendTx))
+	assert.NoError(t, l.limitRate(ctxFromPeer("10.0.0.1:5000", "x-api-key", "key"), sendTx))
+	assert.NoError(t, l.limitRate(ctxFromPeer("10.0.0.1:5000"), "/rpcpb.ApiService/GetAccount"))
+}
+
+func TestInFlightLimit(t *testing.T) {
+	l := newLimiter(&medletpb.RPCConfig{ConnectionLimits: 1}, newAuthorizer(&medletpb.RPCConfig{}))
+
+	release, err := l.acquireGrpc(ctxFromPeer("10.0.0.1:5000"))
+	require.NoError(t, err)
+	_, err = l.acquireGrpc(ctxFromPeer("10.0.0.2:5000"))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, ErrMsgTooManyConnections, status.Convert(err).Message())
+
+	// requests forwarded by the gateway are counted by the http handler.
+	gwRelease, err := l.acquireGrpc(ctxFromPeer("127.0.0.1:5000", "x-forwarded-for", "10.0.0.2"))
+	require.NoError(t, err)
+	gwRelease()
+
+	rec := httptest.NewRecorder()
+	l.handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})).
+		ServeHTTP(rec, httptest.NewRequest("GET", "/v1/node/medstate", nil))
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Contains(t, rec.Body.String(), ErrMsgTooManyConnections)
+
+	release()
+	rec = httptest.NewRecorder()
+	l.handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})).
+		ServeHTTP(rec, httptest.NewRequest("GET", "/v1/node/medstate", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, int32(0), l.inFlight)
+}
+
+func TestConnLimiter(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	limited := newConnLimiter(1).listener(lis)
+	defer limited.Close()
+
+	accepted := make(chan net.Conn)
+	go func() {
+		for {
+			conn, err := limited.Accept()
+			if err != nil {
+				close(accepted)
+				return
+			}
+			accepted <- conn
+		}
+	}()
+
+	first, err := net.Dial("tcp", lis.Addr().String())
+	require.NoError(t, err)
+	defer first.Close()
+	conn := <-accepted
+
+	// the second connection is closed by the server while the first one is open.
+	second, err := net.Dial("tcp", lis.Addr().String())
+	require.NoError(t, err)
+	defer second.Close()
+	second.SetReadDeadline(time.Now().Add(time.Second))
+	_, err = second.Read(make([]byte, 1))
+	assert.Equal(t, io.EOF, err)
+
+	conn.Close()
+	conn.Close()
+	third, err := net.Dial("tcp", lis.Addr().String())
+	require.NoError(t, err)
+	defer third.Close()
+	select {
+	case conn := <-accepted:
+		conn.Close()
+	case <-time.After(time.Second):
+		t.Fatal("connection is not accepted after release")
+	}
+
+	assert.Equal(t, lis, newConnLimiter(0).listener(lis))
+}
+
+func TestGrpcListenerSkipsLoopback(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	limited := newConnLimiter(1).grpcListener(lis)
+	defer limited.Close()
+
+	for i := 0; i < 2; i++ {
+		conn, err := net.Dial("tcp", lis.Addr().String())
+		require.NoError(t, err)
+		defer conn.Close()
+		accepted, err := limited.Accept()
+		require.NoError(t, err)
+		defer accepted.Close()
+	}
+}
@@ -16,7 +16,10 @@
 package rpc
 
 import (
+	"github.com/medibloc/go-medibloc/common"
 	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/net"
 	"github.com/medibloc/go-medibloc/rpc/pb"
 	"github.com/medibloc/go-medibloc/util/byteutils"
 	"google.golang.org/grpc/codes"
@@ -103,3 +106,87 @@ func coreTxs2rpcTxs(txs []*core.Transaction, executed bool) ([]*rpcpb.GetTransac
 	}
 	return rpcTxs, nil
 }
+
+func coreRecord2rpcRecord(record *corepb.Record) *rpcpb.Record {
+	return &rpcpb.Record{
+		RecordHash:  byteutils.Bytes2Hex(record.RecordHash),
+		Owner:       common.BytesToAddress(record.Owner).Hex(),
+		Timestamp:   record.Timestamp,
+		RecordCount: record.RecordCount,
+		PrevHash:    byteutils.Bytes2Hex(record.PrevHash),
+		NextHash:    byteutils.Bytes2Hex(record.NextHash),
+	}
+}
+
+func coreRecords2rpcRecords(records []*corepb.Record) []*rpcpb.Record {
+	var rpcRecords []*rpcpb.Record
+	for _, record := range records {
+		rpcRecords = append(rpcRecords, coreRecord2rpcRecord(record))
+	}
+	return rpcRecords
+}
+
+func coreEvent2rpcStoredEvent(event *corepb.Event) *rpcpb.Event {
+	return &rpcpb.Event{
+		Seq:       event.Seq,
+		Topic:     event.Topic,
+		Hash:      event.Data,
+		Height:    event.Height,
+		BlockHash: byteutils.Bytes2Hex(event.BlockHash),
+		Timestamp: event.Timestamp,
+	}
+}
+
+func coreIssuer2rpcIssuer(reg *corepb.IssuerRegistration) *rpcpb.Issuer {
+	return &rpcpb.Issuer{
+		Address:   common.BytesToAddress(reg.Issuer).Hex(),
+		CertType:  reg.CertType,
+		SchemaId:  reg.SchemaId,
+		Registrar: common.BytesToAddress(reg.Registrar).Hex(),
+		Timestamp: reg.Timestamp,
+		Governed:  !byteutils.Equal(reg.Issuer, reg.Registrar),
+	}
+}
+
+func certificationStatus(cert *corepb.Certification, ts int64) string {
+	if cert.RevocationTime != -1 {
+		return CertStatusRevoked
+	}
+	if core.IsCertificationExpired(cert, ts) {
+		return CertStatusExpired
+	}
+	if cert.IssueTime > ts {
+		return CertStatusNotYetValid
+	}
+	return CertStatusValid
+}
+
+func coreCert2rpcCert(cert *corepb.Certification, ts int64) *rpcpb.Certification {
+	return &rpcpb.Certification{
+		CertificateHash: byteutils.Bytes2Hex(cert.CertificateHash),
+		Issuer:          common.BytesToAddress(cert.Issuer).Hex(),
+		Certified:       common.BytesToAddress(cert.Certified).Hex(),
+		IssueTime:       cert.IssueTime,
+		ExpirationTime:  cert.ExpirationTime,
+		RevocationTime:  cert.RevocationTime,
+		CertType:        cert.CertType,
+		SchemaId:        cert.SchemaId,
+		Status:          certificationStatus(cert, ts),
+	}
+}
+
+func netStats2rpcPeer(stats *net.StreamStats) *rpcpb.PeerInfo {
+	msgCount := make(map[string]int64)
+	for k, v := range stats.MsgCount {
+		msgCount[k] = int64(v)
+	}
+	return &rpcpb.PeerInfo{
+		Id:            stats.PeerID,
+		Addr:          stats.Addr,
+		Established:   stats.Established,
+		ConnectedAt:   stats.ConnectedAt,
+		LatestReadAt:  stats.LatestReadAt,
+		LatestWriteAt: stats.LatestWriteAt,
+		MsgCount:      msgCount,
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCertificationStatus(t *testing.T) {
+	const now = int64(1000)
+	tests := []struct {
+		name   string
+		cert   *corepb.Certification
+		status string
+	}{
+		{"valid", &corepb.Certification{IssueTime: 900, ExpirationTime: 1100, RevocationTime: -1}, CertStatusValid},
+		{"no expiration", &corepb.Certification{IssueTime: 900, ExpirationTime: 0, RevocationTime: -1}, CertStatusValid},
+		{"expires now", &corepb.Certification{IssueTime: 900, ExpirationTime: 1000, RevocationTime: -1}, CertStatusValid},
+		{"expired", &corepb.Certification{IssueTime: 900, ExpirationTime: 999, RevocationTime: -1}, CertStatusExpired},
+		{"issued now", &corepb.Certification{IssueTime: 1000, ExpirationTime: 1100, RevocationTime: -1}, CertStatusValid},
+		{"not yet valid", &corepb.Certification{IssueTime: 1001, ExpirationTime: 1100, RevocationTime: -1}, CertStatusNotYetValid},
+		{"not yet valid without expiration", &corepb.Certification{IssueTime: 1001, RevocationTime: -1}, CertStatusNotYetValid},
+		{"revoked", &corepb.Certification{IssueTime: 900, ExpirationTime: 0, RevocationTime: 950}, CertStatusRevoked},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.status, certificationStatus(tt.cert, now), tt.name)
+	}
+}
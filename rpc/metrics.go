@@ -0,0 +1,42 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package rpc
+
+import (
+	"fmt"
+
+	"github.com/medibloc/go-medibloc/metrics"
+)
+
+// Metrics of rpc requests and limits
+var (
+	metricsRequests           = metrics.NewMeter("med.rpc.requests")
+	metricsConnections        = metrics.NewGauge("med.rpc.connections")
+	metricsConnectionRejected = metrics.NewMeter("med.rpc.connections.rejected")
+	metricsInFlight           = metrics.NewGauge("med.rpc.inflight")
+	metricsInFlightRejected   = metrics.NewMeter("med.rpc.inflight.rejected")
+	metricsRangeExceeded      = metrics.NewMeter("med.rpc.range.exceeded")
+)
+
+func metricsRequestsByClass(class string) {
+	meter := metrics.NewMeter(fmt.Sprintf("med.rpc.requests.%s", class))
+	meter.Mark(1)
+}
+
+func metricsRateLimitedByClass(class string) {
+	meter := metrics.NewMeter(fmt.Sprintf("med.rpc.rate_limited.%s", class))
+	meter.Mark(1)
+}
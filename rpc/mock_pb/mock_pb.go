@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/medibloc/go-medibloc/rpc/pb (interfaces: ApiServiceClient,ApiService_SubscribeClient,ApiServiceServer,ApiService_SubscribeServer)
+// Source: github.com/medibloc/go-medibloc/rpc/pb (interfaces: ApiServiceClient,ApiService_SubscribeClient,ApiServiceServer,ApiService_SubscribeServer,AdminServiceClient,AdminServiceServer)
 
 // Package mock_pb is a generated GoMock package.
 package mock_pb
@@ -127,6 +127,24 @@ func (mr *MockApiServiceClientMockRecorder) GetCandidates(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCandidates", reflect.TypeOf((*MockApiServiceClient)(nil).GetCandidates), varargs...)
 }
 
+// GetCertification mocks base method
+func (m *MockApiServiceClient) GetCertification(arg0 context.Context, arg1 *pb.GetCertificationRequest, arg2 ...grpc.CallOption) (*pb.Certification, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCertification", varargs...)
+	ret0, _ := ret[0].(*pb.Certification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCertification indicates an expected call of GetCertification
+func (mr *MockApiServiceClientMockRecorder) GetCertification(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertification", reflect.TypeOf((*MockApiServiceClient)(nil).GetCertification), varargs...)
+}
+
 // GetDynasty mocks base method
 func (m *MockApiServiceClient) GetDynasty(arg0 context.Context, arg1 *pb.NonParamRequest, arg2 ...grpc.CallOption) (*pb.GetDynastyResponse, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -145,6 +163,42 @@ func (mr *MockApiServiceClientMockRecorder) GetDynasty(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynasty", reflect.TypeOf((*MockApiServiceClient)(nil).GetDynasty), varargs...)
 }
 
+// GetEvents mocks base method
+func (m *MockApiServiceClient) GetEvents(arg0 context.Context, arg1 *pb.GetEventsRequest, arg2 ...grpc.CallOption) (*pb.GetEventsResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetEvents", varargs...)
+	ret0, _ := ret[0].(*pb.GetEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvents indicates an expected call of GetEvents
+func (mr *MockApiServiceClientMockRecorder) GetEvents(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockApiServiceClient)(nil).GetEvents), varargs...)
+}
+
+// GetIssuer mocks base method
+func (m *MockApiServiceClient) GetIssuer(arg0 context.Context, arg1 *pb.GetIssuerRequest, arg2 ...grpc.CallOption) (*pb.Issuer, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetIssuer", varargs...)
+	ret0, _ := ret[0].(*pb.Issuer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIssuer indicates an expected call of GetIssuer
+func (mr *MockApiServiceClientMockRecorder) GetIssuer(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIssuer", reflect.TypeOf((*MockApiServiceClient)(nil).GetIssuer), varargs...)
+}
+
 // GetMedState mocks base method
 func (m *MockApiServiceClient) GetMedState(arg0 context.Context, arg1 *pb.NonParamRequest, arg2 ...grpc.CallOption) (*pb.GetMedStateResponse, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -181,6 +235,42 @@ func (mr *MockApiServiceClientMockRecorder) GetPendingTransactions(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingTransactions", reflect.TypeOf((*MockApiServiceClient)(nil).GetPendingTransactions), varargs...)
 }
 
+// GetRecord mocks base method
+func (m *MockApiServiceClient) GetRecord(arg0 context.Context, arg1 *pb.GetRecordRequest, arg2 ...grpc.CallOption) (*pb.Record, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRecord", varargs...)
+	ret0, _ := ret[0].(*pb.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecord indicates an expected call of GetRecord
+func (mr *MockApiServiceClientMockRecorder) GetRecord(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecord", reflect.TypeOf((*MockApiServiceClient)(nil).GetRecord), varargs...)
+}
+
+// GetRecordHistory mocks base method
+func (m *MockApiServiceClient) GetRecordHistory(arg0 context.Context, arg1 *pb.GetRecordHistoryRequest, arg2 ...grpc.CallOption) (*pb.GetRecordHistoryResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRecordHistory", varargs...)
+	ret0, _ := ret[0].(*pb.GetRecordHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecordHistory indicates an expected call of GetRecordHistory
+func (mr *MockApiServiceClientMockRecorder) GetRecordHistory(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecordHistory", reflect.TypeOf((*MockApiServiceClient)(nil).GetRecordHistory), varargs...)
+}
+
 // GetTransaction mocks base method
 func (m *MockApiServiceClient) GetTransaction(arg0 context.Context, arg1 *pb.GetTransactionRequest, arg2 ...grpc.CallOption) (*pb.GetTransactionResponse, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -217,6 +307,42 @@ func (mr *MockApiServiceClientMockRecorder) HealthCheck(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheck", reflect.TypeOf((*MockApiServiceClient)(nil).HealthCheck), varargs...)
 }
 
+// ListAccountCertifications mocks base method
+func (m *MockApiServiceClient) ListAccountCertifications(arg0 context.Context, arg1 *pb.ListAccountCertificationsRequest, arg2 ...grpc.CallOption) (*pb.ListAccountCertificationsResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAccountCertifications", varargs...)
+	ret0, _ := ret[0].(*pb.ListAccountCertificationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountCertifications indicates an expected call of ListAccountCertifications
+func (mr *MockApiServiceClientMockRecorder) ListAccountCertifications(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountCertifications", reflect.TypeOf((*MockApiServiceClient)(nil).ListAccountCertifications), varargs...)
+}
+
+// ListAccountRecords mocks base method
+func (m *MockApiServiceClient) ListAccountRecords(arg0 context.Context, arg1 *pb.ListAccountRecordsRequest, arg2 ...grpc.CallOption) (*pb.ListAccountRecordsResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAccountRecords", varargs...)
+	ret0, _ := ret[0].(*pb.ListAccountRecordsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountRecords indicates an expected call of ListAccountRecords
+func (mr *MockApiServiceClientMockRecorder) ListAccountRecords(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountRecords", reflect.TypeOf((*MockApiServiceClient)(nil).ListAccountRecords), varargs...)
+}
+
 // SendTransaction mocks base method
 func (m *MockApiServiceClient) SendTransaction(arg0 context.Context, arg1 *pb.SendTransactionRequest, arg2 ...grpc.CallOption) (*pb.SendTransactionResponse, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -253,6 +379,24 @@ func (mr *MockApiServiceClientMockRecorder) Subscribe(arg0, arg1 interface{}, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockApiServiceClient)(nil).Subscribe), varargs...)
 }
 
+// VerifyRecordProof mocks base method
+func (m *MockApiServiceClient) VerifyRecordProof(arg0 context.Context, arg1 *pb.VerifyRecordProofRequest, arg2 ...grpc.CallOption) (*pb.VerifyRecordProofResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyRecordProof", varargs...)
+	ret0, _ := ret[0].(*pb.VerifyRecordProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyRecordProof indicates an expected call of VerifyRecordProof
+func (mr *MockApiServiceClientMockRecorder) VerifyRecordProof(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyRecordProof", reflect.TypeOf((*MockApiServiceClient)(nil).VerifyRecordProof), varargs...)
+}
+
 // MockApiService_SubscribeClient is a mock of ApiService_SubscribeClient interface
 type MockApiService_SubscribeClient struct {
 	ctrl     *gomock.Controller
@@ -450,6 +594,19 @@ func (mr *MockApiServiceServerMockRecorder) GetCandidates(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCandidates", reflect.TypeOf((*MockApiServiceServer)(nil).GetCandidates), arg0, arg1)
 }
 
+// GetCertification mocks base method
+func (m *MockApiServiceServer) GetCertification(arg0 context.Context, arg1 *pb.GetCertificationRequest) (*pb.Certification, error) {
+	ret := m.ctrl.Call(m, "GetCertification", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Certification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCertification indicates an expected call of GetCertification
+func (mr *MockApiServiceServerMockRecorder) GetCertification(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertification", reflect.TypeOf((*MockApiServiceServer)(nil).GetCertification), arg0, arg1)
+}
+
 // GetDynasty mocks base method
 func (m *MockApiServiceServer) GetDynasty(arg0 context.Context, arg1 *pb.NonParamRequest) (*pb.GetDynastyResponse, error) {
 	ret := m.ctrl.Call(m, "GetDynasty", arg0, arg1)
@@ -463,6 +620,32 @@ func (mr *MockApiServiceServerMockRecorder) GetDynasty(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynasty", reflect.TypeOf((*MockApiServiceServer)(nil).GetDynasty), arg0, arg1)
 }
 
+// GetEvents mocks base method
+func (m *MockApiServiceServer) GetEvents(arg0 context.Context, arg1 *pb.GetEventsRequest) (*pb.GetEventsResponse, error) {
+	ret := m.ctrl.Call(m, "GetEvents", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvents indicates an expected call of GetEvents
+func (mr *MockApiServiceServerMockRecorder) GetEvents(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockApiServiceServer)(nil).GetEvents), arg0, arg1)
+}
+
+// GetIssuer mocks base method
+func (m *MockApiServiceServer) GetIssuer(arg0 context.Context, arg1 *pb.GetIssuerRequest) (*pb.Issuer, error) {
+	ret := m.ctrl.Call(m, "GetIssuer", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Issuer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIssuer indicates an expected call of GetIssuer
+func (mr *MockApiServiceServerMockRecorder) GetIssuer(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIssuer", reflect.TypeOf((*MockApiServiceServer)(nil).GetIssuer), arg0, arg1)
+}
+
 // GetMedState mocks base method
 func (m *MockApiServiceServer) GetMedState(arg0 context.Context, arg1 *pb.NonParamRequest) (*pb.GetMedStateResponse, error) {
 	ret := m.ctrl.Call(m, "GetMedState", arg0, arg1)
@@ -489,6 +672,32 @@ func (mr *MockApiServiceServerMockRecorder) GetPendingTransactions(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingTransactions", reflect.TypeOf((*MockApiServiceServer)(nil).GetPendingTransactions), arg0, arg1)
 }
 
+// GetRecord mocks base method
+func (m *MockApiServiceServer) GetRecord(arg0 context.Context, arg1 *pb.GetRecordRequest) (*pb.Record, error) {
+	ret := m.ctrl.Call(m, "GetRecord", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecord indicates an expected call of GetRecord
+func (mr *MockApiServiceServerMockRecorder) GetRecord(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecord", reflect.TypeOf((*MockApiServiceServer)(nil).GetRecord), arg0, arg1)
+}
+
+// GetRecordHistory mocks base method
+func (m *MockApiServiceServer) GetRecordHistory(arg0 context.Context, arg1 *pb.GetRecordHistoryRequest) (*pb.GetRecordHistoryResponse, error) {
+	ret := m.ctrl.Call(m, "GetRecordHistory", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetRecordHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecordHistory indicates an expected call of GetRecordHistory
+func (mr *MockApiServiceServerMockRecorder) GetRecordHistory(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecordHistory", reflect.TypeOf((*MockApiServiceServer)(nil).GetRecordHistory), arg0, arg1)
+}
+
 // GetTransaction mocks base method
 func (m *MockApiServiceServer) GetTransaction(arg0 context.Context, arg1 *pb.GetTransactionRequest) (*pb.GetTransactionResponse, error) {
 	ret := m.ctrl.Call(m, "GetTransaction", arg0, arg1)
@@ -515,6 +724,32 @@ func (mr *MockApiServiceServerMockRecorder) HealthCheck(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheck", reflect.TypeOf((*MockApiServiceServer)(nil).HealthCheck), arg0, arg1)
 }
 
+// ListAccountCertifications mocks base method
+func (m *MockApiServiceServer) ListAccountCertifications(arg0 context.Context, arg1 *pb.ListAccountCertificationsRequest) (*pb.ListAccountCertificationsResponse, error) {
+	ret := m.ctrl.Call(m, "ListAccountCertifications", arg0, arg1)
+	ret0, _ := ret[0].(*pb.ListAccountCertificationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountCertifications indicates an expected call of ListAccountCertifications
+func (mr *MockApiServiceServerMockRecorder) ListAccountCertifications(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountCertifications", reflect.TypeOf((*MockApiServiceServer)(nil).ListAccountCertifications), arg0, arg1)
+}
+
+// ListAccountRecords mocks base method
+func (m *MockApiServiceServer) ListAccountRecords(arg0 context.Context, arg1 *pb.ListAccountRecordsRequest) (*pb.ListAccountRecordsResponse, error) {
+	ret := m.ctrl.Call(m, "ListAccountRecords", arg0, arg1)
+	ret0, _ := ret[0].(*pb.ListAccountRecordsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountRecords indicates an expected call of ListAccountRecords
+func (mr *MockApiServiceServerMockRecorder) ListAccountRecords(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountRecords", reflect.TypeOf((*MockApiServiceServer)(nil).ListAccountRecords), arg0, arg1)
+}
+
 // SendTransaction mocks base method
 func (m *MockApiServiceServer) SendTransaction(arg0 context.Context, arg1 *pb.SendTransactionRequest) (*pb.SendTransactionResponse, error) {
 	ret := m.ctrl.Call(m, "SendTransaction", arg0, arg1)
@@ -540,6 +775,19 @@ func (mr *MockApiServiceServerMockRecorder) Subscribe(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockApiServiceServer)(nil).Subscribe), arg0, arg1)
 }
 
+// VerifyRecordProof mocks base method
+func (m *MockApiServiceServer) VerifyRecordProof(arg0 context.Context, arg1 *pb.VerifyRecordProofRequest) (*pb.VerifyRecordProofResponse, error) {
+	ret := m.ctrl.Call(m, "VerifyRecordProof", arg0, arg1)
+	ret0, _ := ret[0].(*pb.VerifyRecordProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyRecordProof indicates an expected call of VerifyRecordProof
+func (mr *MockApiServiceServerMockRecorder) VerifyRecordProof(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyRecordProof", reflect.TypeOf((*MockApiServiceServer)(nil).VerifyRecordProof), arg0, arg1)
+}
+
 // MockApiService_SubscribeServer is a mock of ApiService_SubscribeServer interface
 type MockApiService_SubscribeServer struct {
 	ctrl     *gomock.Controller
@@ -644,3 +892,359 @@ func (m *MockApiService_SubscribeServer) SetTrailer(arg0 metadata.MD) {
 func (mr *MockApiService_SubscribeServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockApiService_SubscribeServer)(nil).SetTrailer), arg0)
 }
+
+// MockAdminServiceClient is a mock of AdminServiceClient interface
+type MockAdminServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminServiceClientMockRecorder
+}
+
+// MockAdminServiceClientMockRecorder is the mock recorder for MockAdminServiceClient
+type MockAdminServiceClientMockRecorder struct {
+	mock *MockAdminServiceClient
+}
+
+// NewMockAdminServiceClient creates a new mock instance
+func NewMockAdminServiceClient(ctrl *gomock.Controller) *MockAdminServiceClient {
+	mock := &MockAdminServiceClient{ctrl: ctrl}
+	mock.recorder = &MockAdminServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAdminServiceClient) EXPECT() *MockAdminServiceClientMockRecorder {
+	return m.recorder
+}
+
+// AddPeer mocks base method
+func (m *MockAdminServiceClient) AddPeer(arg0 context.Context, arg1 *pb.AddPeerRequest, arg2 ...grpc.CallOption) (*pb.AdminResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddPeer", varargs...)
+	ret0, _ := ret[0].(*pb.AdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPeer indicates an expected call of AddPeer
+func (mr *MockAdminServiceClientMockRecorder) AddPeer(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPeer", reflect.TypeOf((*MockAdminServiceClient)(nil).AddPeer), varargs...)
+}
+
+// BanPeer mocks base method
+func (m *MockAdminServiceClient) BanPeer(arg0 context.Context, arg1 *pb.BanPeerRequest, arg2 ...grpc.CallOption) (*pb.AdminResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BanPeer", varargs...)
+	ret0, _ := ret[0].(*pb.AdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BanPeer indicates an expected call of BanPeer
+func (mr *MockAdminServiceClientMockRecorder) BanPeer(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanPeer", reflect.TypeOf((*MockAdminServiceClient)(nil).BanPeer), varargs...)
+}
+
+// GetPeers mocks base method
+func (m *MockAdminServiceClient) GetPeers(arg0 context.Context, arg1 *pb.AdminRequest, arg2 ...grpc.CallOption) (*pb.GetPeersResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPeers", varargs...)
+	ret0, _ := ret[0].(*pb.GetPeersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPeers indicates an expected call of GetPeers
+func (mr *MockAdminServiceClientMockRecorder) GetPeers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeers", reflect.TypeOf((*MockAdminServiceClient)(nil).GetPeers), varargs...)
+}
+
+// InspectLIB mocks base method
+func (m *MockAdminServiceClient) InspectLIB(arg0 context.Context, arg1 *pb.AdminRequest, arg2 ...grpc.CallOption) (*pb.InspectLIBResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "InspectLIB", varargs...)
+	ret0, _ := ret[0].(*pb.InspectLIBResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InspectLIB indicates an expected call of InspectLIB
+func (mr *MockAdminServiceClientMockRecorder) InspectLIB(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InspectLIB", reflect.TypeOf((*MockAdminServiceClient)(nil).InspectLIB), varargs...)
+}
+
+// NodeInfo mocks base method
+func (m *MockAdminServiceClient) NodeInfo(arg0 context.Context, arg1 *pb.AdminRequest, arg2 ...grpc.CallOption) (*pb.NodeInfoResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NodeInfo", varargs...)
+	ret0, _ := ret[0].(*pb.NodeInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NodeInfo indicates an expected call of NodeInfo
+func (mr *MockAdminServiceClientMockRecorder) NodeInfo(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeInfo", reflect.TypeOf((*MockAdminServiceClient)(nil).NodeInfo), varargs...)
+}
+
+// RemovePeer mocks base method
+func (m *MockAdminServiceClient) RemovePeer(arg0 context.Context, arg1 *pb.PeerRequest, arg2 ...grpc.CallOption) (*pb.AdminResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemovePeer", varargs...)
+	ret0, _ := ret[0].(*pb.AdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemovePeer indicates an expected call of RemovePeer
+func (mr *MockAdminServiceClientMockRecorder) RemovePeer(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePeer", reflect.TypeOf((*MockAdminServiceClient)(nil).RemovePeer), varargs...)
+}
+
+// SetLogLevel mocks base method
+func (m *MockAdminServiceClient) SetLogLevel(arg0 context.Context, arg1 *pb.SetLogLevelRequest, arg2 ...grpc.CallOption) (*pb.AdminResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetLogLevel", varargs...)
+	ret0, _ := ret[0].(*pb.AdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLogLevel indicates an expected call of SetLogLevel
+func (mr *MockAdminServiceClientMockRecorder) SetLogLevel(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLogLevel", reflect.TypeOf((*MockAdminServiceClient)(nil).SetLogLevel), varargs...)
+}
+
+// StartMining mocks base method
+func (m *MockAdminServiceClient) StartMining(arg0 context.Context, arg1 *pb.AdminRequest, arg2 ...grpc.CallOption) (*pb.AdminResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartMining", varargs...)
+	ret0, _ := ret[0].(*pb.AdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartMining indicates an expected call of StartMining
+func (mr *MockAdminServiceClientMockRecorder) StartMining(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartMining", reflect.TypeOf((*MockAdminServiceClient)(nil).StartMining), varargs...)
+}
+
+// StopMining mocks base method
+func (m *MockAdminServiceClient) StopMining(arg0 context.Context, arg1 *pb.AdminRequest, arg2 ...grpc.CallOption) (*pb.AdminResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StopMining", varargs...)
+	ret0, _ := ret[0].(*pb.AdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopMining indicates an expected call of StopMining
+func (mr *MockAdminServiceClientMockRecorder) StopMining(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopMining", reflect.TypeOf((*MockAdminServiceClient)(nil).StopMining), varargs...)
+}
+
+// TriggerSync mocks base method
+func (m *MockAdminServiceClient) TriggerSync(arg0 context.Context, arg1 *pb.TriggerSyncRequest, arg2 ...grpc.CallOption) (*pb.AdminResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TriggerSync", varargs...)
+	ret0, _ := ret[0].(*pb.AdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerSync indicates an expected call of TriggerSync
+func (mr *MockAdminServiceClientMockRecorder) TriggerSync(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerSync", reflect.TypeOf((*MockAdminServiceClient)(nil).TriggerSync), varargs...)
+}
+
+// MockAdminServiceServer is a mock of AdminServiceServer interface
+type MockAdminServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminServiceServerMockRecorder
+}
+
+// MockAdminServiceServerMockRecorder is the mock recorder for MockAdminServiceServer
+type MockAdminServiceServerMockRecorder struct {
+	mock *MockAdminServiceServer
+}
+
+// NewMockAdminServiceServer creates a new mock instance
+func NewMockAdminServiceServer(ctrl *gomock.Controller) *MockAdminServiceServer {
+	mock := &MockAdminServiceServer{ctrl: ctrl}
+	mock.recorder = &MockAdminServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAdminServiceServer) EXPECT() *MockAdminServiceServerMockRecorder {
+	return m.recorder
+}
+
+// AddPeer mocks base method
+func (m *MockAdminServiceServer) AddPeer(arg0 context.Context, arg1 *pb.AddPeerRequest) (*pb.AdminResponse, error) {
+	ret := m.ctrl.Call(m, "AddPeer", arg0, arg1)
+	ret0, _ := ret[0].(*pb.AdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPeer indicates an expected call of AddPeer
+func (mr *MockAdminServiceServerMockRecorder) AddPeer(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPeer", reflect.TypeOf((*MockAdminServiceServer)(nil).AddPeer), arg0, arg1)
+}
+
+// BanPeer mocks base method
+func (m *MockAdminServiceServer) BanPeer(arg0 context.Context, arg1 *pb.BanPeerRequest) (*pb.AdminResponse, error) {
+	ret := m.ctrl.Call(m, "BanPeer", arg0, arg1)
+	ret0, _ := ret[0].(*pb.AdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BanPeer indicates an expected call of BanPeer
+func (mr *MockAdminServiceServerMockRecorder) BanPeer(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanPeer", reflect.TypeOf((*MockAdminServiceServer)(nil).BanPeer), arg0, arg1)
+}
+
+// GetPeers mocks base method
+func (m *MockAdminServiceServer) GetPeers(arg0 context.Context, arg1 *pb.AdminRequest) (*pb.GetPeersResponse, error) {
+	ret := m.ctrl.Call(m, "GetPeers", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetPeersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPeers indicates an expected call of GetPeers
+func (mr *MockAdminServiceServerMockRecorder) GetPeers(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeers", reflect.TypeOf((*MockAdminServiceServer)(nil).GetPeers), arg0, arg1)
+}
+
+// InspectLIB mocks base method
+func (m *MockAdminServiceServer) InspectLIB(arg0 context.Context, arg1 *pb.AdminRequest) (*pb.InspectLIBResponse, error) {
+	ret := m.ctrl.Call(m, "InspectLIB", arg0, arg1)
+	ret0, _ := ret[0].(*pb.InspectLIBResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InspectLIB indicates an expected call of InspectLIB
+func (mr *MockAdminServiceServerMockRecorder) InspectLIB(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InspectLIB", reflect.TypeOf((*MockAdminServiceServer)(nil).InspectLIB), arg0, arg1)
+}
+
+// NodeInfo mocks base method
+func (m *MockAdminServiceServer) NodeInfo(arg0 context.Context, arg1 *pb.AdminRequest) (*pb.NodeInfoResponse, error) {
+	ret := m.ctrl.Call(m, "NodeInfo", arg0, arg1)
+	ret0, _ := ret[0].(*pb.NodeInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NodeInfo indicates an expected call of NodeInfo
+func (mr *MockAdminServiceServerMockRecorder) NodeInfo(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeInfo", reflect.TypeOf((*MockAdminServiceServer)(nil).NodeInfo), arg0, arg1)
+}
+
+// RemovePeer mocks base method
+func (m *MockAdminServiceServer) RemovePeer(arg0 context.Context, arg1 *pb.PeerRequest) (*pb.AdminResponse, error) {
+	ret := m.ctrl.Call(m, "RemovePeer", arg0, arg1)
+	ret0, _ := ret[0].(*pb.AdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemovePeer indicates an expected call of RemovePeer
+func (mr *MockAdminServiceServerMockRecorder) RemovePeer(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePeer", reflect.TypeOf((*MockAdminServiceServer)(nil).RemovePeer), arg0, arg1)
+}
+
+// SetLogLevel mocks base method
+func (m *MockAdminServiceServer) SetLogLevel(arg0 context.Context, arg1 *pb.SetLogLevelRequest) (*pb.AdminResponse, error) {
+	ret := m.ctrl.Call(m, "SetLogLevel", arg0, arg1)
+	ret0, _ := ret[0].(*pb.AdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLogLevel indicates an expected call of SetLogLevel
+func (mr *MockAdminServiceServerMockRecorder) SetLogLevel(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLogLevel", reflect.TypeOf((*MockAdminServiceServer)(nil).SetLogLevel), arg0, arg1)
+}
+
+// StartMining mocks base method
+func (m *MockAdminServiceServer) StartMining(arg0 context.Context, arg1 *pb.AdminRequest) (*pb.AdminResponse, error) {
+	ret := m.ctrl.Call(m, "StartMining", arg0, arg1)
+	ret0, _ := ret[0].(*pb.AdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartMining indicates an expected call of StartMining
+func (mr *MockAdminServiceServerMockRecorder) StartMining(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartMining", reflect.TypeOf((*MockAdminServiceServer)(nil).StartMining), arg0, arg1)
+}
+
+// StopMining mocks base method
+func (m *MockAdminServiceServer) StopMining(arg0 context.Context, arg1 *pb.AdminRequest) (*pb.AdminResponse, error) {
+	ret := m.ctrl.Call(m, "StopMining", arg0, arg1)
+	ret0, _ := ret[0].(*pb.AdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopMining indicates an expected call of StopMining
+func (mr *MockAdminServiceServerMockRecorder) StopMining(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopMining", reflect.TypeOf((*MockAdminServiceServer)(nil).StopMining), arg0, arg1)
+}
+
+// TriggerSync mocks base method
+func (m *MockAdminServiceServer) TriggerSync(arg0 context.Context, arg1 *pb.TriggerSyncRequest) (*pb.AdminResponse, error) {
+	ret := m.ctrl.Call(m, "TriggerSync", arg0, arg1)
+	ret0, _ := ret[0].(*pb.AdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerSync indicates an expected call of TriggerSync
+func (mr *MockAdminServiceServerMockRecorder) TriggerSync(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerSync", reflect.TypeOf((*MockAdminServiceServer)(nil).TriggerSync), arg0, arg1)
+}
@@ -0,0 +1,817 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: admin.proto
+
+/*
+Package rpcpb is a generated protocol buffer package.
+
+It is generated from these files:
+	admin.proto
+
+It has these top-level messages:
+	AdminRequest
+	AdminResponse
+	NodeInfoResponse
+	PeerInfo
+	GetPeersResponse
+	AddPeerRequest
+	PeerRequest
+	BanPeerRequest
+	TriggerSyncRequest
+	InspectLIBResponse
+	SetLogLevelRequest
+*/
+package rpcpb
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import context "golang.org/x/net/context"
+import grpc "google.golang.org/grpc"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type AdminRequest struct {
+}
+
+func (m *AdminRequest) Reset()                    { *m = AdminRequest{} }
+func (m *AdminRequest) String() string            { return proto.CompactTextString(m) }
+func (*AdminRequest) ProtoMessage()               {}
+func (*AdminRequest) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{0} }
+
+type AdminResponse struct {
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+}
+
+func (m *AdminResponse) Reset()                    { *m = AdminResponse{} }
+func (m *AdminResponse) String() string            { return proto.CompactTextString(m) }
+func (*AdminResponse) ProtoMessage()               {}
+func (*AdminResponse) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{1} }
+
+func (m *AdminResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+type NodeInfoResponse struct {
+	// Node ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Listening addresses of the node.
+	ListenAddrs []string `protobuf:"bytes,2,rep,name=listen_addrs,json=listenAddrs" json:"listen_addrs,omitempty"`
+	// Node version.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// P2P protocol client version.
+	ClientVersion string `protobuf:"bytes,4,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	// Block chain id.
+	ChainId uint32 `protobuf:"varint,5,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Number of connected peers.
+	PeerCount int32 `protobuf:"varint,6,opt,name=peer_count,json=peerCount,proto3" json:"peer_count,omitempty"`
+	// If block production is running, it returns true. otherwise, false.
+	Mining bool `protobuf:"varint,7,opt,name=mining,proto3" json:"mining,omitempty"`
+	// If block download is running, it returns true. otherwise, false.
+	Syncing bool `protobuf:"varint,8,opt,name=syncing,proto3" json:"syncing,omitempty"`
+}
+
+func (m *NodeInfoResponse) Reset()                    { *m = NodeInfoResponse{} }
+func (m *NodeInfoResponse) String() string            { return proto.CompactTextString(m) }
+func (*NodeInfoResponse) ProtoMessage()               {}
+func (*NodeInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{2} }
+
+func (m *NodeInfoResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *NodeInfoResponse) GetListenAddrs() []string {
+	if m != nil {
+		return m.ListenAddrs
+	}
+	return nil
+}
+
+func (m *NodeInfoResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *NodeInfoResponse) GetClientVersion() string {
+	if m != nil {
+		return m.ClientVersion
+	}
+	return ""
+}
+
+func (m *NodeInfoResponse) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *NodeInfoResponse) GetPeerCount() int32 {
+	if m != nil {
+		return m.PeerCount
+	}
+	return 0
+}
+
+func (m *NodeInfoResponse) GetMining() bool {
+	if m != nil {
+		return m.Mining
+	}
+	return false
+}
+
+func (m *NodeInfoResponse) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
+	}
+	return false
+}
+
+type PeerInfo struct {
+	// Peer ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Peer address.
+	Addr string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	// If handshake with the peer succeeded, it returns true. otherwise, false.
+	Established bool `protobuf:"varint,3,opt,name=established,proto3" json:"established,omitempty"`
+	// Unix time the stream is connected.
+	ConnectedAt int64 `protobuf:"varint,4,opt,name=connected_at,json=connectedAt,proto3" json:"connected_at,omitempty"`
+	// Unix time the last message is read.
+	LatestReadAt int64 `protobuf:"varint,5,opt,name=latest_read_at,json=latestReadAt,proto3" json:"latest_read_at,omitempty"`
+	// Unix time the last message is written.
+	LatestWriteAt int64 `protobuf:"varint,6,opt,name=latest_write_at,json=latestWriteAt,proto3" json:"latest_write_at,omitempty"`
+	// Number of messages received by message type.
+	MsgCount map[string]int64 `protobuf:"bytes,7,rep,name=msg_count,json=msgCount" json:"msg_count,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (m *PeerInfo) Reset()                    { *m = PeerInfo{} }
+func (m *PeerInfo) String() string            { return proto.CompactTextString(m) }
+func (*PeerInfo) ProtoMessage()               {}
+func (*PeerInfo) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{3} }
+
+func (m *PeerInfo) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PeerInfo) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *PeerInfo) GetEstablished() bool {
+	if m != nil {
+		return m.Established
+	}
+	return false
+}
+
+func (m *PeerInfo) GetConnectedAt() int64 {
+	if m != nil {
+		return m.ConnectedAt
+	}
+	return 0
+}
+
+func (m *PeerInfo) GetLatestReadAt() int64 {
+	if m != nil {
+		return m.LatestReadAt
+	}
+	return 0
+}
+
+func (m *PeerInfo) GetLatestWriteAt() int64 {
+	if m != nil {
+		return m.LatestWriteAt
+	}
+	return 0
+}
+
+func (m *PeerInfo) GetMsgCount() map[string]int64 {
+	if m != nil {
+		return m.MsgCount
+	}
+	return nil
+}
+
+type GetPeersResponse struct {
+	Peers []*PeerInfo `protobuf:"bytes,1,rep,name=peers" json:"peers,omitempty"`
+}
+
+func (m *GetPeersResponse) Reset()                    { *m = GetPeersResponse{} }
+func (m *GetPeersResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetPeersResponse) ProtoMessage()               {}
+func (*GetPeersResponse) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{4} }
+
+func (m *GetPeersResponse) GetPeers() []*PeerInfo {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+type AddPeerRequest struct {
+	// IPFS address of the peer. e.g. /ip4/127.0.0.1/tcp/9900/ipfs/{id}
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *AddPeerRequest) Reset()                    { *m = AddPeerRequest{} }
+func (m *AddPeerRequest) String() string            { return proto.CompactTextString(m) }
+func (*AddPeerRequest) ProtoMessage()               {}
+func (*AddPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{5} }
+
+func (m *AddPeerRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type PeerRequest struct {
+	// Peer ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *PeerRequest) Reset()                    { *m = PeerRequest{} }
+func (m *PeerRequest) String() string            { return proto.CompactTextString(m) }
+func (*PeerRequest) ProtoMessage()               {}
+func (*PeerRequest) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{6} }
+
+func (m *PeerRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type BanPeerRequest struct {
+	// Peer ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Ban duration in seconds. If 0, peer is banned until the node restarts.
+	Duration int64 `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (m *BanPeerRequest) Reset()                    { *m = BanPeerRequest{} }
+func (m *BanPeerRequest) String() string            { return proto.CompactTextString(m) }
+func (*BanPeerRequest) ProtoMessage()               {}
+func (*BanPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{7} }
+
+func (m *BanPeerRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *BanPeerRequest) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type TriggerSyncRequest struct {
+	// Target height to download. It should be higher than the current tail height.
+	TargetHeight uint64 `protobuf:"varint,1,opt,name=target_height,json=targetHeight,proto3" json:"target_height,omitempty"`
+}
+
+func (m *TriggerSyncRequest) Reset()                    { *m = TriggerSyncRequest{} }
+func (m *TriggerSyncRequest) String() string            { return proto.CompactTextString(m) }
+func (*TriggerSyncRequest) ProtoMessage()               {}
+func (*TriggerSyncRequest) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{8} }
+
+func (m *TriggerSyncRequest) GetTargetHeight() uint64 {
+	if m != nil {
+		return m.TargetHeight
+	}
+	return 0
+}
+
+type InspectLIBResponse struct {
+	// LIB hash before inspection.
+	PrevHash string `protobuf:"bytes,1,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`
+	// LIB height before inspection.
+	PrevHeight uint64 `protobuf:"varint,2,opt,name=prev_height,json=prevHeight,proto3" json:"prev_height,omitempty"`
+	// LIB hash after inspection.
+	Hash string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// LIB height after inspection.
+	Height uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *InspectLIBResponse) Reset()                    { *m = InspectLIBResponse{} }
+func (m *InspectLIBResponse) String() string            { return proto.CompactTextString(m) }
+func (*InspectLIBResponse) ProtoMessage()               {}
+func (*InspectLIBResponse) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{9} }
+
+func (m *InspectLIBResponse) GetPrevHash() string {
+	if m != nil {
+		return m.PrevHash
+	}
+	return ""
+}
+
+func (m *InspectLIBResponse) GetPrevHeight() uint64 {
+	if m != nil {
+		return m.PrevHeight
+	}
+	return 0
+}
+
+func (m *InspectLIBResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *InspectLIBResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type SetLogLevelRequest struct {
+	// Log level "debug", "info", "warn", or "error".
+	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (m *SetLogLevelRequest) Reset()                    { *m = SetLogLevelRequest{} }
+func (m *SetLogLevelRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetLogLevelRequest) ProtoMessage()               {}
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{10} }
+
+func (m *SetLogLevelRequest) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*AdminRequest)(nil), "rpcpb.AdminRequest")
+	proto.RegisterType((*AdminResponse)(nil), "rpcpb.AdminResponse")
+	proto.RegisterType((*NodeInfoResponse)(nil), "rpcpb.NodeInfoResponse")
+	proto.RegisterType((*PeerInfo)(nil), "rpcpb.PeerInfo")
+	proto.RegisterType((*GetPeersResponse)(nil), "rpcpb.GetPeersResponse")
+	proto.RegisterType((*AddPeerRequest)(nil), "rpcpb.AddPeerRequest")
+	proto.RegisterType((*PeerRequest)(nil), "rpcpb.PeerRequest")
+	proto.RegisterType((*BanPeerRequest)(nil), "rpcpb.BanPeerRequest")
+	proto.RegisterType((*TriggerSyncRequest)(nil), "rpcpb.TriggerSyncRequest")
+	proto.RegisterType((*InspectLIBResponse)(nil), "rpcpb.InspectLIBResponse")
+	proto.RegisterType((*SetLogLevelRequest)(nil), "rpcpb.SetLogLevelRequest")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for AdminService service
+
+type AdminServiceClient interface {
+	NodeInfo(ctx context.Context, in *AdminRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error)
+	GetPeers(ctx context.Context, in *AdminRequest, opts ...grpc.CallOption) (*GetPeersResponse, error)
+	AddPeer(ctx context.Context, in *AddPeerRequest, opts ...grpc.CallOption) (*AdminResponse, error)
+	RemovePeer(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*AdminResponse, error)
+	BanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*AdminResponse, error)
+	StartMining(ctx context.Context, in *AdminRequest, opts ...grpc.CallOption) (*AdminResponse, error)
+	StopMining(ctx context.Context, in *AdminRequest, opts ...grpc.CallOption) (*AdminResponse, error)
+	TriggerSync(ctx context.Context, in *TriggerSyncRequest, opts ...grpc.CallOption) (*AdminResponse, error)
+	InspectLIB(ctx context.Context, in *AdminRequest, opts ...grpc.CallOption) (*InspectLIBResponse, error)
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*AdminResponse, error)
+}
+
+type adminServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAdminServiceClient(cc *grpc.ClientConn) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) NodeInfo(ctx context.Context, in *AdminRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error) {
+	out := new(NodeInfoResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/NodeInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetPeers(ctx context.Context, in *AdminRequest, opts ...grpc.CallOption) (*GetPeersResponse, error) {
+	out := new(GetPeersResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetPeers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AddPeer(ctx context.Context, in *AddPeerRequest, opts ...grpc.CallOption) (*AdminResponse, error) {
+	out := new(AdminResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/AddPeer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RemovePeer(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*AdminResponse, error) {
+	out := new(AdminResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/RemovePeer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) BanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*AdminResponse, error) {
+	out := new(AdminResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/BanPeer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartMining(ctx context.Context, in *AdminRequest, opts ...grpc.CallOption) (*AdminResponse, error) {
+	out := new(AdminResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/StartMining", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StopMining(ctx context.Context, in *AdminRequest, opts ...grpc.CallOption) (*AdminResponse, error) {
+	out := new(AdminResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/StopMining", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) TriggerSync(ctx context.Context, in *TriggerSyncRequest, opts ...grpc.CallOption) (*AdminResponse, error) {
+	out := new(AdminResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/TriggerSync", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) InspectLIB(ctx context.Context, in *AdminRequest, opts ...grpc.CallOption) (*InspectLIBResponse, error) {
+	out := new(InspectLIBResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/InspectLIB", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*AdminResponse, error) {
+	out := new(AdminResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/SetLogLevel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for AdminService service
+
+type AdminServiceServer interface {
+	NodeInfo(context.Context, *AdminRequest) (*NodeInfoResponse, error)
+	GetPeers(context.Context, *AdminRequest) (*GetPeersResponse, error)
+	AddPeer(context.Context, *AddPeerRequest) (*AdminResponse, error)
+	RemovePeer(context.Context, *PeerRequest) (*AdminResponse, error)
+	BanPeer(context.Context, *BanPeerRequest) (*AdminResponse, error)
+	StartMining(context.Context, *AdminRequest) (*AdminResponse, error)
+	StopMining(context.Context, *AdminRequest) (*AdminResponse, error)
+	TriggerSync(context.Context, *TriggerSyncRequest) (*AdminResponse, error)
+	InspectLIB(context.Context, *AdminRequest) (*InspectLIBResponse, error)
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*AdminResponse, error)
+}
+
+func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
+	s.RegisterService(&_AdminService_serviceDesc, srv)
+}
+
+func _AdminService_NodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).NodeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/NodeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).NodeInfo(ctx, req.(*AdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/GetPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetPeers(ctx, req.(*AdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AddPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/AddPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddPeer(ctx, req.(*AddPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemovePeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemovePeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/RemovePeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemovePeer(ctx, req.(*PeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_BanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).BanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/BanPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).BanPeer(ctx, req.(*BanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartMining_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartMining(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/StartMining",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartMining(ctx, req.(*AdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StopMining_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StopMining(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/StopMining",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StopMining(ctx, req.(*AdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_TriggerSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).TriggerSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/TriggerSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).TriggerSync(ctx, req.(*TriggerSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_InspectLIB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).InspectLIB(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/InspectLIB",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).InspectLIB(ctx, req.(*AdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AdminService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NodeInfo",
+			Handler:    _AdminService_NodeInfo_Handler,
+		},
+		{
+			MethodName: "GetPeers",
+			Handler:    _AdminService_GetPeers_Handler,
+		},
+		{
+			MethodName: "AddPeer",
+			Handler:    _AdminService_AddPeer_Handler,
+		},
+		{
+			MethodName: "RemovePeer",
+			Handler:    _AdminService_RemovePeer_Handler,
+		},
+		{
+			MethodName: "BanPeer",
+			Handler:    _AdminService_BanPeer_Handler,
+		},
+		{
+			MethodName: "StartMining",
+			Handler:    _AdminService_StartMining_Handler,
+		},
+		{
+			MethodName: "StopMining",
+			Handler:    _AdminService_StopMining_Handler,
+		},
+		{
+			MethodName: "TriggerSync",
+			Handler:    _AdminService_TriggerSync_Handler,
+		},
+		{
+			MethodName: "InspectLIB",
+			Handler:    _AdminService_InspectLIB_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _AdminService_SetLogLevel_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin.proto",
+}
+
+func init() { proto.RegisterFile("admin.proto", fileDescriptorAdmin) }
+
+var fileDescriptorAdmin = []byte{
+	// 900 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x96, 0x93, 0x66, 0xe3, 0x1e, 0x37, 0x69, 0x99, 0xfe, 0xb9, 0xe9, 0x96, 0xcd, 0x1a, 0x16,
+	0x55, 0xb9, 0xa8, 0xc5, 0x72, 0xc3, 0x06, 0x6e, 0xb2, 0x08, 0xb1, 0x95, 0xba, 0x68, 0xd7, 0x41,
+	0xa0, 0x05, 0x89, 0x68, 0x62, 0x0f, 0xce, 0xa8, 0xce, 0x8c, 0xf1, 0x4c, 0x83, 0x2a, 0x04, 0x17,
+	0x5c, 0x72, 0xcb, 0xdb, 0xf0, 0x1a, 0xbc, 0x02, 0x4f, 0xc0, 0x13, 0xa0, 0x39, 0x1e, 0xa7, 0x4e,
+	0x9b, 0xad, 0xe0, 0x2e, 0xe7, 0x9b, 0xcf, 0xdf, 0x9c, 0x73, 0xe6, 0x3b, 0x27, 0xe0, 0xd1, 0x64,
+	0xce, 0xc5, 0x59, 0x5e, 0x48, 0x2d, 0x49, 0xab, 0xc8, 0xe3, 0x7c, 0xda, 0x7b, 0x98, 0x4a, 0x99,
+	0x66, 0x2c, 0xa4, 0x39, 0x0f, 0xa9, 0x10, 0x52, 0x53, 0xcd, 0xa5, 0x50, 0x25, 0x29, 0xe8, 0xc2,
+	0xd6, 0xc8, 0x7c, 0x13, 0xb1, 0x1f, 0xaf, 0x98, 0xd2, 0xc1, 0x23, 0xe8, 0xd8, 0x58, 0xe5, 0x52,
+	0x28, 0x46, 0xba, 0xd0, 0x90, 0x97, 0xbe, 0xd3, 0x77, 0x4e, 0xdd, 0xa8, 0x21, 0x2f, 0x83, 0x7f,
+	0x1c, 0xd8, 0xf9, 0x52, 0x26, 0xec, 0x5c, 0xfc, 0x20, 0xeb, 0x24, 0x9e, 0x20, 0x69, 0x33, 0x6a,
+	0xf0, 0x84, 0x3c, 0x86, 0xad, 0x8c, 0x2b, 0xcd, 0xc4, 0x84, 0x26, 0x49, 0xa1, 0xfc, 0x46, 0xbf,
+	0x79, 0xba, 0x19, 0x79, 0x25, 0x36, 0x32, 0x10, 0xf1, 0xa1, 0xbd, 0x60, 0x85, 0xe2, 0x52, 0xf8,
+	0x4d, 0xfc, 0xae, 0x0a, 0xc9, 0x13, 0xe8, 0xc6, 0x19, 0x67, 0x42, 0x4f, 0x2a, 0xc2, 0x06, 0x12,
+	0x3a, 0x25, 0xfa, 0xb5, 0xa5, 0x1d, 0x81, 0x1b, 0xcf, 0x28, 0x17, 0x13, 0x9e, 0xf8, 0xad, 0xbe,
+	0x73, 0xda, 0x89, 0xda, 0x18, 0x9f, 0x27, 0xe4, 0x04, 0x20, 0x67, 0xac, 0x98, 0xc4, 0xf2, 0x4a,
+	0x68, 0xff, 0x41, 0xdf, 0x39, 0x6d, 0x45, 0x9b, 0x06, 0xf9, 0xcc, 0x00, 0xe4, 0x00, 0x1e, 0xcc,
+	0xb9, 0xe0, 0x22, 0xf5, 0xdb, 0x58, 0x96, 0x8d, 0x4c, 0x4a, 0xea, 0x5a, 0xc4, 0xe6, 0xc0, 0xc5,
+	0x83, 0x2a, 0x0c, 0xfe, 0x6c, 0x80, 0xfb, 0x8a, 0xb1, 0xc2, 0x14, 0x7d, 0xa7, 0x58, 0x02, 0x1b,
+	0xa6, 0x4a, 0xbf, 0x81, 0x08, 0xfe, 0x26, 0x7d, 0xf0, 0x98, 0xd2, 0x74, 0x9a, 0x71, 0x35, 0x63,
+	0x09, 0x56, 0xe8, 0x46, 0x75, 0xc8, 0xb4, 0x28, 0x96, 0x42, 0xb0, 0x58, 0xb3, 0x64, 0x42, 0x35,
+	0xd6, 0xd8, 0x8c, 0xbc, 0x25, 0x36, 0xd2, 0xe4, 0x7d, 0xe8, 0x66, 0x54, 0x33, 0xa5, 0x27, 0x05,
+	0xa3, 0x48, 0x6a, 0x21, 0x69, 0xab, 0x44, 0x23, 0x46, 0x0d, 0xeb, 0x03, 0xd8, 0xb6, 0xac, 0x9f,
+	0x0a, 0xae, 0xd9, 0x84, 0x96, 0x15, 0x37, 0xa3, 0x4e, 0x09, 0x7f, 0x63, 0xd0, 0x91, 0x26, 0x43,
+	0xd8, 0x9c, 0xab, 0xd4, 0xf6, 0xa4, 0xdd, 0x6f, 0x9e, 0x7a, 0x4f, 0x4f, 0xce, 0xd0, 0x22, 0x67,
+	0x55, 0x69, 0x67, 0x2f, 0x55, 0x8a, 0x2d, 0xfa, 0x5c, 0xe8, 0xe2, 0x3a, 0x72, 0xe7, 0x36, 0xec,
+	0x7d, 0x02, 0x9d, 0x95, 0x23, 0xb2, 0x03, 0xcd, 0x4b, 0x76, 0x6d, 0x9b, 0x60, 0x7e, 0x92, 0x3d,
+	0x68, 0x2d, 0x68, 0x76, 0xc5, 0xb0, 0x0d, 0xcd, 0xa8, 0x0c, 0x86, 0x8d, 0x8f, 0x9d, 0xe0, 0x19,
+	0xec, 0x7c, 0xc1, 0xb4, 0xb9, 0x43, 0x2d, 0x0d, 0xf3, 0x04, 0x5a, 0xe6, 0x3d, 0x94, 0xef, 0x60,
+	0x22, 0xdb, 0xb7, 0x12, 0x89, 0xca, 0xd3, 0x60, 0x00, 0xdd, 0x51, 0x92, 0x18, 0xd4, 0xfa, 0xd3,
+	0xbc, 0x91, 0x69, 0x30, 0x53, 0xca, 0x5e, 0x5e, 0x85, 0xc1, 0x09, 0x78, 0x75, 0xe2, 0xad, 0x57,
+	0x0a, 0x3e, 0x85, 0xee, 0x73, 0x2a, 0xee, 0x61, 0x90, 0x1e, 0xb8, 0xc9, 0x55, 0x81, 0xd3, 0x61,
+	0x8b, 0x58, 0xc6, 0xc1, 0x33, 0x20, 0x5f, 0x15, 0x3c, 0x4d, 0x59, 0x31, 0xbe, 0x16, 0x71, 0xa5,
+	0xf0, 0x1e, 0x74, 0x34, 0x2d, 0x52, 0xa6, 0x27, 0x33, 0xc6, 0xd3, 0x99, 0x46, 0xb1, 0x8d, 0x68,
+	0xab, 0x04, 0x5f, 0x20, 0x16, 0xfc, 0x0a, 0xe4, 0x5c, 0xa8, 0x9c, 0xc5, 0xfa, 0xe2, 0xfc, 0xf9,
+	0xb2, 0x01, 0xc7, 0xb0, 0x99, 0x17, 0x6c, 0x31, 0x99, 0x51, 0x35, 0xb3, 0x39, 0xb8, 0x06, 0x78,
+	0x41, 0xd5, 0x8c, 0x3c, 0x02, 0xaf, 0x3c, 0x2c, 0x55, 0x1b, 0xa8, 0x0a, 0x78, 0x8c, 0x88, 0xb1,
+	0x1c, 0x7e, 0x58, 0x4e, 0x0e, 0xfe, 0x36, 0xae, 0xb6, 0xfc, 0x0d, 0xe4, 0xdb, 0x28, 0x18, 0x00,
+	0x19, 0x33, 0x7d, 0x21, 0xd3, 0x0b, 0xb6, 0x60, 0x59, 0x95, 0xfa, 0x1e, 0xb4, 0x32, 0x13, 0xdb,
+	0xbb, 0xcb, 0xe0, 0xe9, 0xef, 0x6d, 0xbb, 0x0e, 0xc6, 0xac, 0x58, 0xf0, 0x98, 0x91, 0x57, 0xe0,
+	0x56, 0xc3, 0x4e, 0x76, 0xed, 0x23, 0xd5, 0xf7, 0x45, 0xef, 0xd0, 0x82, 0xb7, 0x57, 0x42, 0x70,
+	0xf0, 0xdb, 0x5f, 0x7f, 0xff, 0xd1, 0xd8, 0x21, 0xdd, 0x70, 0xf1, 0x61, 0x88, 0x6b, 0x29, 0x14,
+	0x32, 0x61, 0xe4, 0x35, 0xb8, 0x95, 0x1b, 0xee, 0x57, 0xbc, 0xed, 0x99, 0xe0, 0x10, 0x15, 0xdf,
+	0x21, 0xdb, 0x37, 0x8a, 0xe8, 0x12, 0xf2, 0x1a, 0xda, 0xd6, 0x25, 0x64, 0x7f, 0xa9, 0x58, 0x77,
+	0x4d, 0x6f, 0x6f, 0xf5, 0x22, 0x2b, 0x78, 0x84, 0x82, 0xbb, 0x41, 0x77, 0x55, 0x70, 0xe8, 0x0c,
+	0xc8, 0x18, 0x20, 0x62, 0x73, 0xb9, 0x60, 0xa8, 0x4a, 0x6a, 0xf6, 0xbc, 0x5f, 0xf2, 0x18, 0x25,
+	0xf7, 0x07, 0xbb, 0xab, 0x92, 0xe1, 0xcf, 0x3c, 0xf9, 0x85, 0x7c, 0x0b, 0x6d, 0x6b, 0xc1, 0x65,
+	0x9e, 0xab, 0x96, 0x7c, 0x8b, 0x68, 0x80, 0xa2, 0x0f, 0x87, 0xce, 0x20, 0x38, 0x5c, 0xa3, 0x1b,
+	0x4e, 0xa9, 0x20, 0xdf, 0x81, 0x37, 0xd6, 0xb4, 0xd0, 0x2f, 0xcb, 0x55, 0xb6, 0xb6, 0xb3, 0xeb,
+	0xd5, 0x1f, 0xa3, 0xfa, 0x71, 0x70, 0x70, 0x23, 0x5d, 0xee, 0xc3, 0x50, 0x19, 0x41, 0xd3, 0x8d,
+	0x37, 0x00, 0x63, 0x2d, 0xf3, 0xff, 0xaf, 0xdd, 0x47, 0xed, 0x9e, 0xc9, 0x7c, 0x7f, 0x8d, 0xbc,
+	0xcc, 0xc9, 0x1b, 0xf0, 0x6a, 0x83, 0x45, 0x8e, 0xac, 0xcc, 0xdd, 0x61, 0xfb, 0xef, 0x6f, 0x68,
+	0x96, 0xb6, 0xc9, 0xfa, 0x7b, 0x80, 0x9b, 0xc1, 0x5b, 0x9f, 0x75, 0x75, 0xdd, 0xdd, 0x01, 0xad,
+	0x52, 0xaf, 0xe7, 0x9d, 0xf1, 0x69, 0xc8, 0x4b, 0x66, 0xa9, 0xef, 0xd5, 0x06, 0x6b, 0x99, 0xfa,
+	0xdd, 0x61, 0x7b, 0x4b, 0xea, 0xef, 0xe2, 0x0d, 0xfe, 0xd0, 0x19, 0xf4, 0x6a, 0x76, 0xc9, 0x64,
+	0x1a, 0xe2, 0x30, 0x4e, 0x1f, 0xe0, 0x3f, 0xf4, 0x47, 0xff, 0x0e, 0x00, 0x87, 0x79, 0xa2, 0x91,
+	0xd5, 0x07, 0x00, 0x00,
+}
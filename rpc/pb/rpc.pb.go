@@ -25,8 +25,26 @@ It has these top-level messages:
 	GetAccountTransactionsRequest
 	SendTransactionRequest
 	SendTransactionResponse
+	GetIssuerRequest
+	Issuer
+	Record
+	GetRecordRequest
+	ListAccountRecordsRequest
+	ListAccountRecordsResponse
+	Certification
+	GetCertificationRequest
+	ListAccountCertificationsRequest
+	ListAccountCertificationsResponse
+	GetRecordHistoryRequest
+	GetRecordHistoryResponse
+	VerifyRecordProofRequest
+	VerifyRecordProofResponse
 	SubscribeRequest
 	SubscribeResponse
+	TransactionReceipt
+	GetEventsRequest
+	GetEventsResponse
+	Event
 	HealthCheckResponse
 */
 package rpcpb
@@ -483,6 +501,8 @@ func (m *GetMedStateResponse) GetLIB() string {
 
 type GetTransactionsResponse struct {
 	Transactions []*GetTransactionResponse `protobuf:"bytes,1,rep,name=transactions" json:"transactions,omitempty"`
+	// Offset of the next page of GetAccountTransactions. It is 0 if there are no more transactions.
+	NextOffset uint64 `protobuf:"varint,2,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"`
 }
 
 func (m *GetTransactionsResponse) Reset()                    { *m = GetTransactionsResponse{} }
@@ -497,6 +517,13 @@ func (m *GetTransactionsResponse) GetTransactions() []*GetTransactionResponse {
 	return nil
 }
 
+func (m *GetTransactionsResponse) GetNextOffset() uint64 {
+	if m != nil {
+		return m.NextOffset
+	}
+	return 0
+}
+
 type GetTransactionRequest struct {
 	// Transaction hash
 	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
@@ -644,6 +671,10 @@ type GetAccountTransactionsRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// Whether or not to include pending transactions. Default is true.
 	IncludePending bool `protobuf:"varint,2,opt,name=include_pending,json=includePending,proto3" json:"include_pending,omitempty"`
+	// Number of transactions to skip.
+	Offset uint64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Maximum number of transactions to return. It is capped to the server limit, which is also used if 0.
+	Limit uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
 func (m *GetAccountTransactionsRequest) Reset()         { *m = GetAccountTransactionsRequest{} }
@@ -667,6 +698,20 @@ func (m *GetAccountTransactionsRequest) GetIncludePending() bool {
 	return false
 }
 
+func (m *GetAccountTransactionsRequest) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *GetAccountTransactionsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 type SendTransactionRequest struct {
 	// Transaction hash
 	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
@@ -727,133 +772,1134 @@ func (m *SendTransactionRequest) GetValue() string {
 	return ""
 }
 
-func (m *SendTransactionRequest) GetTimestamp() int64 {
+func (m *SendTransactionRequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *SendTransactionRequest) GetTxType() string {
+	if m != nil {
+		return m.TxType
+	}
+	return ""
+}
+
+func (m *SendTransactionRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *SendTransactionRequest) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *SendTransactionRequest) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
+func (m *SendTransactionRequest) GetAlg() uint32 {
+	if m != nil {
+		return m.Alg
+	}
+	return 0
+}
+
+func (m *SendTransactionRequest) GetSign() string {
+	if m != nil {
+		return m.Sign
+	}
+	return ""
+}
+
+func (m *SendTransactionRequest) GetPayerSign() string {
+	if m != nil {
+		return m.PayerSign
+	}
+	return ""
+}
+
+type SendTransactionResponse struct {
+	// Hex string of transaction hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *SendTransactionResponse) Reset()                    { *m = SendTransactionResponse{} }
+func (m *SendTransactionResponse) String() string            { return proto.CompactTextString(m) }
+func (*SendTransactionResponse) ProtoMessage()               {}
+func (*SendTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{16} }
+
+func (m *SendTransactionResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type GetIssuerRequest struct {
+	// Hex string of the issuer's address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Certification type.
+	CertType string `protobuf:"bytes,2,opt,name=cert_type,json=certType,proto3" json:"cert_type,omitempty"`
+}
+
+func (m *GetIssuerRequest) Reset()                    { *m = GetIssuerRequest{} }
+func (m *GetIssuerRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetIssuerRequest) ProtoMessage()               {}
+func (*GetIssuerRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{17} }
+
+func (m *GetIssuerRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetIssuerRequest) GetCertType() string {
+	if m != nil {
+		return m.CertType
+	}
+	return ""
+}
+
+type Issuer struct {
+	// Hex string of the issuer's address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Certification type.
+	CertType string `protobuf:"bytes,2,opt,name=cert_type,json=certType,proto3" json:"cert_type,omitempty"`
+	// Schema identifier of the certification type.
+	SchemaId string `protobuf:"bytes,3,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	// Hex string of the account which registered the issuer.
+	Registrar string `protobuf:"bytes,4,opt,name=registrar,proto3" json:"registrar,omitempty"`
+	// Timestamp of the registration.
+	Timestamp int64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// If issuer is registered by governance rather than by itself, it returns true. otherwise, false.
+	Governed bool `protobuf:"varint,6,opt,name=governed,proto3" json:"governed,omitempty"`
+}
+
+func (m *Issuer) Reset()                    { *m = Issuer{} }
+func (m *Issuer) String() string            { return proto.CompactTextString(m) }
+func (*Issuer) ProtoMessage()               {}
+func (*Issuer) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{18} }
+
+func (m *Issuer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Issuer) GetCertType() string {
+	if m != nil {
+		return m.CertType
+	}
+	return ""
+}
+
+func (m *Issuer) GetSchemaId() string {
+	if m != nil {
+		return m.SchemaId
+	}
+	return ""
+}
+
+func (m *Issuer) GetRegistrar() string {
+	if m != nil {
+		return m.Registrar
+	}
+	return ""
+}
+
+func (m *Issuer) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Issuer) GetGoverned() bool {
+	if m != nil {
+		return m.Governed
+	}
+	return false
+}
+
+type Record struct {
+	// Hex string of the record hash.
+	RecordHash string `protobuf:"bytes,1,opt,name=record_hash,json=recordHash,proto3" json:"record_hash,omitempty"`
+	// Hex string of the record owner's address.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Timestamp of the transaction which added the record.
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Number of record hashes if the record is an anchored batch.
+	RecordCount uint32 `protobuf:"varint,4,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+	// Hex string of the record hash amended by this record.
+	PrevHash string `protobuf:"bytes,5,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`
+	// Hex string of the record hash amending this record.
+	NextHash string `protobuf:"bytes,6,opt,name=next_hash,json=nextHash,proto3" json:"next_hash,omitempty"`
+}
+
+func (m *Record) Reset()                    { *m = Record{} }
+func (m *Record) String() string            { return proto.CompactTextString(m) }
+func (*Record) ProtoMessage()               {}
+func (*Record) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{19} }
+
+func (m *Record) GetRecordHash() string {
+	if m != nil {
+		return m.RecordHash
+	}
+	return ""
+}
+
+func (m *Record) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Record) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Record) GetRecordCount() uint32 {
+	if m != nil {
+		return m.RecordCount
+	}
+	return 0
+}
+
+func (m *Record) GetPrevHash() string {
+	if m != nil {
+		return m.PrevHash
+	}
+	return ""
+}
+
+func (m *Record) GetNextHash() string {
+	if m != nil {
+		return m.NextHash
+	}
+	return ""
+}
+
+type GetRecordRequest struct {
+	// Hex string of the record owner's address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Hex string of the record hash.
+	RecordHash string `protobuf:"bytes,2,opt,name=record_hash,json=recordHash,proto3" json:"record_hash,omitempty"`
+}
+
+func (m *GetRecordRequest) Reset()                    { *m = GetRecordRequest{} }
+func (m *GetRecordRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetRecordRequest) ProtoMessage()               {}
+func (*GetRecordRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{20} }
+
+func (m *GetRecordRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetRecordRequest) GetRecordHash() string {
+	if m != nil {
+		return m.RecordHash
+	}
+	return ""
+}
+
+type ListAccountRecordsRequest struct {
+	// Hex string of the record owner's address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Number of records to skip.
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Maximum number of records to return. Default is 100.
+	Limit uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *ListAccountRecordsRequest) Reset()                    { *m = ListAccountRecordsRequest{} }
+func (m *ListAccountRecordsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListAccountRecordsRequest) ProtoMessage()               {}
+func (*ListAccountRecordsRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{21} }
+
+func (m *ListAccountRecordsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ListAccountRecordsRequest) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListAccountRecordsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListAccountRecordsResponse struct {
+	Records []*Record `protobuf:"bytes,1,rep,name=records" json:"records,omitempty"`
+	// Total number of records of the account.
+	Total uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *ListAccountRecordsResponse) Reset()                    { *m = ListAccountRecordsResponse{} }
+func (m *ListAccountRecordsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListAccountRecordsResponse) ProtoMessage()               {}
+func (*ListAccountRecordsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{22} }
+
+func (m *ListAccountRecordsResponse) GetRecords() []*Record {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *ListAccountRecordsResponse) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type Certification struct {
+	// Hex string of the certificate hash.
+	CertificateHash string `protobuf:"bytes,1,opt,name=certificate_hash,json=certificateHash,proto3" json:"certificate_hash,omitempty"`
+	// Hex string of the issuer's address.
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// Hex string of the certified account's address.
+	Certified string `protobuf:"bytes,3,opt,name=certified,proto3" json:"certified,omitempty"`
+	// Certification issue time.
+	IssueTime int64 `protobuf:"varint,4,opt,name=issue_time,json=issueTime,proto3" json:"issue_time,omitempty"`
+	// Certification expiration time. 0 if it never expires.
+	ExpirationTime int64 `protobuf:"varint,5,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+	// Certification revocation time. -1 if not revoked.
+	RevocationTime int64 `protobuf:"varint,6,opt,name=revocation_time,json=revocationTime,proto3" json:"revocation_time,omitempty"`
+	// Certification type.
+	CertType string `protobuf:"bytes,7,opt,name=cert_type,json=certType,proto3" json:"cert_type,omitempty"`
+	// Schema identifier of the certification type.
+	SchemaId string `protobuf:"bytes,8,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	// Certification status "valid", "not_yet_valid", "expired", or "revoked".
+	Status string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *Certification) Reset()                    { *m = Certification{} }
+func (m *Certification) String() string            { return proto.CompactTextString(m) }
+func (*Certification) ProtoMessage()               {}
+func (*Certification) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{23} }
+
+func (m *Certification) GetCertificateHash() string {
+	if m != nil {
+		return m.CertificateHash
+	}
+	return ""
+}
+
+func (m *Certification) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *Certification) GetCertified() string {
+	if m != nil {
+		return m.Certified
+	}
+	return ""
+}
+
+func (m *Certification) GetIssueTime() int64 {
+	if m != nil {
+		return m.IssueTime
+	}
+	return 0
+}
+
+func (m *Certification) GetExpirationTime() int64 {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return 0
+}
+
+func (m *Certification) GetRevocationTime() int64 {
+	if m != nil {
+		return m.RevocationTime
+	}
+	return 0
+}
+
+func (m *Certification) GetCertType() string {
+	if m != nil {
+		return m.CertType
+	}
+	return ""
+}
+
+func (m *Certification) GetSchemaId() string {
+	if m != nil {
+		return m.SchemaId
+	}
+	return ""
+}
+
+func (m *Certification) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type GetCertificationRequest struct {
+	// Hex string of the issuer's or certified account's address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Hex string of the certificate hash.
+	CertificateHash string `protobuf:"bytes,2,opt,name=certificate_hash,json=certificateHash,proto3" json:"certificate_hash,omitempty"`
+}
+
+func (m *GetCertificationRequest) Reset()                    { *m = GetCertificationRequest{} }
+func (m *GetCertificationRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetCertificationRequest) ProtoMessage()               {}
+func (*GetCertificationRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{24} }
+
+func (m *GetCertificationRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetCertificationRequest) GetCertificateHash() string {
+	if m != nil {
+		return m.CertificateHash
+	}
+	return ""
+}
+
+type ListAccountCertificationsRequest struct {
+	// Hex string of the account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Certifications "received" or "issued" by the account. Default is "received".
+	Direction string `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	// If you send issuer, only certifications issued by it are returned.
+	Issuer string `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// If you send cert_type, only certifications of the type are returned.
+	CertType string `protobuf:"bytes,4,opt,name=cert_type,json=certType,proto3" json:"cert_type,omitempty"`
+	// If you send status, only certifications of the status are returned.
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// Number of certifications to skip.
+	Offset uint64 `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Maximum number of certifications to return. Default is 100.
+	Limit uint64 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *ListAccountCertificationsRequest) Reset()         { *m = ListAccountCertificationsRequest{} }
+func (m *ListAccountCertificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAccountCertificationsRequest) ProtoMessage()    {}
+func (*ListAccountCertificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorRpc, []int{25}
+}
+
+func (m *ListAccountCertificationsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ListAccountCertificationsRequest) GetDirection() string {
+	if m != nil {
+		return m.Direction
+	}
+	return ""
+}
+
+func (m *ListAccountCertificationsRequest) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *ListAccountCertificationsRequest) GetCertType() string {
+	if m != nil {
+		return m.CertType
+	}
+	return ""
+}
+
+func (m *ListAccountCertificationsRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ListAccountCertificationsRequest) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListAccountCertificationsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListAccountCertificationsResponse struct {
+	Certifications []*Certification `protobuf:"bytes,1,rep,name=certifications" json:"certifications,omitempty"`
+	// Total number of certifications matching the filters.
+	Total uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *ListAccountCertificationsResponse) Reset()         { *m = ListAccountCertificationsResponse{} }
+func (m *ListAccountCertificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAccountCertificationsResponse) ProtoMessage()    {}
+func (*ListAccountCertificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorRpc, []int{26}
+}
+
+func (m *ListAccountCertificationsResponse) GetCertifications() []*Certification {
+	if m != nil {
+		return m.Certifications
+	}
+	return nil
+}
+
+func (m *ListAccountCertificationsResponse) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type GetRecordHistoryRequest struct {
+	// Hex string of the record owner's address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Hex string of any record hash in the amendment history.
+	RecordHash string `protobuf:"bytes,2,opt,name=record_hash,json=recordHash,proto3" json:"record_hash,omitempty"`
+}
+
+func (m *GetRecordHistoryRequest) Reset()                    { *m = GetRecordHistoryRequest{} }
+func (m *GetRecordHistoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetRecordHistoryRequest) ProtoMessage()               {}
+func (*GetRecordHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{27} }
+
+func (m *GetRecordHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetRecordHistoryRequest) GetRecordHash() string {
+	if m != nil {
+		return m.RecordHash
+	}
+	return ""
+}
+
+type GetRecordHistoryResponse struct {
+	// Every version of the record from the first to the latest.
+	Records []*Record `protobuf:"bytes,1,rep,name=records" json:"records,omitempty"`
+	// Latest version of the record.
+	Latest *Record `protobuf:"bytes,2,opt,name=latest" json:"latest,omitempty"`
+}
+
+func (m *GetRecordHistoryResponse) Reset()                    { *m = GetRecordHistoryResponse{} }
+func (m *GetRecordHistoryResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetRecordHistoryResponse) ProtoMessage()               {}
+func (*GetRecordHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{28} }
+
+func (m *GetRecordHistoryResponse) GetRecords() []*Record {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *GetRecordHistoryResponse) GetLatest() *Record {
+	if m != nil {
+		return m.Latest
+	}
+	return nil
+}
+
+type VerifyRecordProofRequest struct {
+	// Hex string of the record owner's address.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Hex string of the anchored merkle root.
+	MerkleRoot string `protobuf:"bytes,2,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// Hex string of the record hash to verify.
+	RecordHash string `protobuf:"bytes,3,opt,name=record_hash,json=recordHash,proto3" json:"record_hash,omitempty"`
+	// Leaf index of the record hash in the batch.
+	Index uint64 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	// Hex strings of sibling hashes from the leaf up to the root.
+	Path []string `protobuf:"bytes,5,rep,name=path" json:"path,omitempty"`
+}
+
+func (m *VerifyRecordProofRequest) Reset()                    { *m = VerifyRecordProofRequest{} }
+func (m *VerifyRecordProofRequest) String() string            { return proto.CompactTextString(m) }
+func (*VerifyRecordProofRequest) ProtoMessage()               {}
+func (*VerifyRecordProofRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{29} }
+
+func (m *VerifyRecordProofRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *VerifyRecordProofRequest) GetMerkleRoot() string {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return ""
+}
+
+func (m *VerifyRecordProofRequest) GetRecordHash() string {
+	if m != nil {
+		return m.RecordHash
+	}
+	return ""
+}
+
+func (m *VerifyRecordProofRequest) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *VerifyRecordProofRequest) GetPath() []string {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+type VerifyRecordProofResponse struct {
+	// If record hash is included in the anchored batch, it returns true. otherwise, false.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// Timestamp of the anchoring transaction.
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Number of record hashes in the anchored batch.
+	RecordCount uint32 `protobuf:"varint,3,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+}
+
+func (m *VerifyRecordProofResponse) Reset()                    { *m = VerifyRecordProofResponse{} }
+func (m *VerifyRecordProofResponse) String() string            { return proto.CompactTextString(m) }
+func (*VerifyRecordProofResponse) ProtoMessage()               {}
+func (*VerifyRecordProofResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{30} }
+
+func (m *VerifyRecordProofResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *VerifyRecordProofResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *VerifyRecordProofResponse) GetRecordCount() uint32 {
+	if m != nil {
+		return m.RecordCount
+	}
+	return 0
+}
+
+type SubscribeRequest struct {
+	Topics []string `protobuf:"bytes,1,rep,name=topics" json:"topics,omitempty"`
+	// Hex strings of addresses. Only transactions sent from or to the addresses are streamed.
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses" json:"addresses,omitempty"`
+	// Transaction types. Only transactions of the types are streamed.
+	TxTypes []string `protobuf:"bytes,3,rep,name=tx_types,json=txTypes" json:"tx_types,omitempty"`
+	// Hex strings of record hashes. Only transactions adding or amending the records are streamed.
+	RecordHashes []string `protobuf:"bytes,4,rep,name=record_hashes,json=recordHashes" json:"record_hashes,omitempty"`
+	// If true, full block, transaction and receipt are included in the response.
+	IncludePayload bool `protobuf:"varint,5,opt,name=include_payload,json=includePayload,proto3" json:"include_payload,omitempty"`
+	// If set, blocks and transactions from the height are streamed before new events. The blocks from the height
+	// to the tail are limited to max_blocks_range of the rpc config.
+	FromHeight uint64 `protobuf:"varint,6,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// If true, events are dropped when the subscriber is too slow. Otherwise, the subscription is closed.
+	AllowDrop bool `protobuf:"varint,7,opt,name=allow_drop,json=allowDrop,proto3" json:"allow_drop,omitempty"`
+	// If set, stored events from the sequence number are streamed before new events. Event store should be enabled.
+	// The stored events from the sequence number to the latest are limited to max_replay_events of the rpc config.
+	FromSeq uint64 `protobuf:"varint,8,opt,name=from_seq,json=fromSeq,proto3" json:"from_seq,omitempty"`
+}
+
+func (m *SubscribeRequest) Reset()                    { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()               {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{31} }
+
+func (m *SubscribeRequest) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetTxTypes() []string {
+	if m != nil {
+		return m.TxTypes
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetRecordHashes() []string {
+	if m != nil {
+		return m.RecordHashes
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetIncludePayload() bool {
+	if m != nil {
+		return m.IncludePayload
+	}
+	return false
+}
+
+func (m *SubscribeRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *SubscribeRequest) GetAllowDrop() bool {
+	if m != nil {
+		return m.AllowDrop
+	}
+	return false
+}
+
+func (m *SubscribeRequest) GetFromSeq() uint64 {
+	if m != nil {
+		return m.FromSeq
+	}
+	return 0
+}
+
+type SubscribeResponse struct {
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Hash  string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Block of the event. It is set if include_payload is true.
+	Block *GetBlockResponse `protobuf:"bytes,3,opt,name=block" json:"block,omitempty"`
+	// Transaction of the event. It is set if include_payload is true.
+	Transaction *GetTransactionResponse `protobuf:"bytes,4,opt,name=transaction" json:"transaction,omitempty"`
+	// Receipt of the executed transaction. It is set if include_payload is true.
+	Receipt *TransactionReceipt `protobuf:"bytes,5,opt,name=receipt" json:"receipt,omitempty"`
+	// Number of events dropped since the last response.
+	Dropped uint64 `protobuf:"varint,6,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	// If the event is streamed from the past blocks by from_height, it returns true.
+	Replayed bool `protobuf:"varint,7,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	// Sequence number of the event in the event store. It is 0 if the event is not stored.
+	Seq uint64 `protobuf:"varint,8,opt,name=seq,proto3" json:"seq,omitempty"`
+}
+
+func (m *SubscribeResponse) Reset()                    { *m = SubscribeResponse{} }
+func (m *SubscribeResponse) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeResponse) ProtoMessage()               {}
+func (*SubscribeResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{32} }
+
+func (m *SubscribeResponse) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *SubscribeResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *SubscribeResponse) GetBlock() *GetBlockResponse {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *SubscribeResponse) GetTransaction() *GetTransactionResponse {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *SubscribeResponse) GetReceipt() *TransactionReceipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+func (m *SubscribeResponse) GetDropped() uint64 {
+	if m != nil {
+		return m.Dropped
+	}
+	return 0
+}
+
+func (m *SubscribeResponse) GetReplayed() bool {
+	if m != nil {
+		return m.Replayed
+	}
+	return false
+}
+
+func (m *SubscribeResponse) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type TransactionReceipt struct {
+	// Transaction hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Hash of the block including the transaction.
+	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// Height of the block including the transaction.
+	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Timestamp of the block including the transaction.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *TransactionReceipt) Reset()                    { *m = TransactionReceipt{} }
+func (m *TransactionReceipt) String() string            { return proto.CompactTextString(m) }
+func (*TransactionReceipt) ProtoMessage()               {}
+func (*TransactionReceipt) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{33} }
+
+func (m *TransactionReceipt) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *TransactionReceipt) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *TransactionReceipt) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *TransactionReceipt) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type GetEventsRequest struct {
+	// Sequence number of the first event. Events are returned from the oldest stored one if both from_seq and from_height are 0.
+	FromSeq uint64 `protobuf:"varint,1,opt,name=from_seq,json=fromSeq,proto3" json:"from_seq,omitempty"`
+	// Height of the first event. It is used if from_seq is 0.
+	FromHeight uint64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// Maximum number of scanned events.
+	Limit uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Topics of returned events. All stored topics are returned if empty.
+	Topics []string `protobuf:"bytes,4,rep,name=topics" json:"topics,omitempty"`
+}
+
+func (m *GetEventsRequest) Reset()                    { *m = GetEventsRequest{} }
+func (m *GetEventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetEventsRequest) ProtoMessage()               {}
+func (*GetEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{34} }
+
+func (m *GetEventsRequest) GetFromSeq() uint64 {
+	if m != nil {
+		return m.FromSeq
+	}
+	return 0
+}
+
+func (m *GetEventsRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *GetEventsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetEventsRequest) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+type GetEventsResponse struct {
+	Events []*Event `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+	// Sequence number to request the next events.
+	NextSeq uint64 `protobuf:"varint,2,opt,name=next_seq,json=nextSeq,proto3" json:"next_seq,omitempty"`
+	// Sequence number of the oldest stored event.
+	FirstSeq uint64 `protobuf:"varint,3,opt,name=first_seq,json=firstSeq,proto3" json:"first_seq,omitempty"`
+	// Sequence number of the latest stored event.
+	LastSeq uint64 `protobuf:"varint,4,opt,name=last_seq,json=lastSeq,proto3" json:"last_seq,omitempty"`
+}
+
+func (m *GetEventsResponse) Reset()                    { *m = GetEventsResponse{} }
+func (m *GetEventsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetEventsResponse) ProtoMessage()               {}
+func (*GetEventsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{35} }
+
+func (m *GetEventsResponse) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *GetEventsResponse) GetNextSeq() uint64 {
+	if m != nil {
+		return m.NextSeq
+	}
+	return 0
+}
+
+func (m *GetEventsResponse) GetFirstSeq() uint64 {
+	if m != nil {
+		return m.FirstSeq
+	}
+	return 0
+}
+
+func (m *GetEventsResponse) GetLastSeq() uint64 {
+	if m != nil {
+		return m.LastSeq
+	}
+	return 0
+}
+
+type Event struct {
+	Seq   uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Hash  string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Height of the block of the event. Height of the latest block stored before is used for pending transactions.
+	Height    uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	BlockHash string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Timestamp int64  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *Event) Reset()                    { *m = Event{} }
+func (m *Event) String() string            { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()               {}
+func (*Event) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{36} }
+
+func (m *Event) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *Event) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *Event) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *Event) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Event) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *Event) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type HealthCheckResponse struct {
+	// True if the node is ready.
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	// True if the node is alive. A node which is not alive should be restarted.
+	Live bool `protobuf:"varint,2,opt,name=live,proto3" json:"live,omitempty"`
+	// True if the node is alive and synchronized with the network.
+	Ready bool `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
+	// Failed checks.
+	Failures   []string `protobuf:"bytes,4,rep,name=failures" json:"failures,omitempty"`
+	Syncing    bool     `protobuf:"varint,5,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	TailHeight uint64   `protobuf:"varint,6,opt,name=tail_height,json=tailHeight,proto3" json:"tail_height,omitempty"`
+	// Seconds since the timestamp of the tail block.
+	TailAge         int64  `protobuf:"varint,7,opt,name=tail_age,json=tailAge,proto3" json:"tail_age,omitempty"`
+	LibHeight       uint64 `protobuf:"varint,8,opt,name=lib_height,json=libHeight,proto3" json:"lib_height,omitempty"`
+	LibLag          uint64 `protobuf:"varint,9,opt,name=lib_lag,json=libLag,proto3" json:"lib_lag,omitempty"`
+	Peers           uint32 `protobuf:"varint,10,opt,name=peers,proto3" json:"peers,omitempty"`
+	StorageWritable bool   `protobuf:"varint,11,opt,name=storage_writable,json=storageWritable,proto3" json:"storage_writable,omitempty"`
+	// True if the miner of the node is a member of the dynasty.
+	DynastyMember bool `protobuf:"varint,12,opt,name=dynasty_member,json=dynastyMember,proto3" json:"dynasty_member,omitempty"`
+	// Number of missed slots among the recent slots of the miner.
+	MissedSlots uint32 `protobuf:"varint,13,opt,name=missed_slots,json=missedSlots,proto3" json:"missed_slots,omitempty"`
+}
+
+func (m *HealthCheckResponse) Reset()                    { *m = HealthCheckResponse{} }
+func (m *HealthCheckResponse) String() string            { return proto.CompactTextString(m) }
+func (*HealthCheckResponse) ProtoMessage()               {}
+func (*HealthCheckResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{37} }
+
+func (m *HealthCheckResponse) GetOk() bool {
 	if m != nil {
-		return m.Timestamp
+		return m.Ok
 	}
-	return 0
+	return false
 }
 
-func (m *SendTransactionRequest) GetTxType() string {
+func (m *HealthCheckResponse) GetLive() bool {
 	if m != nil {
-		return m.TxType
+		return m.Live
 	}
-	return ""
+	return false
 }
 
-func (m *SendTransactionRequest) GetNonce() uint64 {
+func (m *HealthCheckResponse) GetReady() bool {
 	if m != nil {
-		return m.Nonce
+		return m.Ready
 	}
-	return 0
+	return false
 }
 
-func (m *SendTransactionRequest) GetChainId() uint32 {
+func (m *HealthCheckResponse) GetFailures() []string {
 	if m != nil {
-		return m.ChainId
+		return m.Failures
 	}
-	return 0
+	return nil
 }
 
-func (m *SendTransactionRequest) GetPayload() string {
+func (m *HealthCheckResponse) GetSyncing() bool {
 	if m != nil {
-		return m.Payload
+		return m.Syncing
 	}
-	return ""
+	return false
 }
 
-func (m *SendTransactionRequest) GetAlg() uint32 {
+func (m *HealthCheckResponse) GetTailHeight() uint64 {
 	if m != nil {
-		return m.Alg
+		return m.TailHeight
 	}
 	return 0
 }
 
-func (m *SendTransactionRequest) GetSign() string {
+func (m *HealthCheckResponse) GetTailAge() int64 {
 	if m != nil {
-		return m.Sign
+		return m.TailAge
 	}
-	return ""
+	return 0
 }
 
-func (m *SendTransactionRequest) GetPayerSign() string {
+func (m *HealthCheckResponse) GetLibHeight() uint64 {
 	if m != nil {
-		return m.PayerSign
+		return m.LibHeight
 	}
-	return ""
-}
-
-type SendTransactionResponse struct {
-	// Hex string of transaction hash.
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	return 0
 }
 
-func (m *SendTransactionResponse) Reset()                    { *m = SendTransactionResponse{} }
-func (m *SendTransactionResponse) String() string            { return proto.CompactTextString(m) }
-func (*SendTransactionResponse) ProtoMessage()               {}
-func (*SendTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{16} }
-
-func (m *SendTransactionResponse) GetHash() string {
+func (m *HealthCheckResponse) GetLibLag() uint64 {
 	if m != nil {
-		return m.Hash
+		return m.LibLag
 	}
-	return ""
-}
-
-type SubscribeRequest struct {
-	Topics []string `protobuf:"bytes,1,rep,name=topics" json:"topics,omitempty"`
+	return 0
 }
 
-func (m *SubscribeRequest) Reset()                    { *m = SubscribeRequest{} }
-func (m *SubscribeRequest) String() string            { return proto.CompactTextString(m) }
-func (*SubscribeRequest) ProtoMessage()               {}
-func (*SubscribeRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{17} }
-
-func (m *SubscribeRequest) GetTopics() []string {
+func (m *HealthCheckResponse) GetPeers() uint32 {
 	if m != nil {
-		return m.Topics
+		return m.Peers
 	}
-	return nil
-}
-
-type SubscribeResponse struct {
-	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
-	Hash  string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	return 0
 }
 
-func (m *SubscribeResponse) Reset()                    { *m = SubscribeResponse{} }
-func (m *SubscribeResponse) String() string            { return proto.CompactTextString(m) }
-func (*SubscribeResponse) ProtoMessage()               {}
-func (*SubscribeResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{18} }
-
-func (m *SubscribeResponse) GetTopic() string {
+func (m *HealthCheckResponse) GetStorageWritable() bool {
 	if m != nil {
-		return m.Topic
+		return m.StorageWritable
 	}
-	return ""
+	return false
 }
 
-func (m *SubscribeResponse) GetHash() string {
+func (m *HealthCheckResponse) GetDynastyMember() bool {
 	if m != nil {
-		return m.Hash
+		return m.DynastyMember
 	}
-	return ""
-}
-
-type HealthCheckResponse struct {
-	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	return false
 }
 
-func (m *HealthCheckResponse) Reset()                    { *m = HealthCheckResponse{} }
-func (m *HealthCheckResponse) String() string            { return proto.CompactTextString(m) }
-func (*HealthCheckResponse) ProtoMessage()               {}
-func (*HealthCheckResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{19} }
-
-func (m *HealthCheckResponse) GetOk() bool {
+func (m *HealthCheckResponse) GetMissedSlots() uint32 {
 	if m != nil {
-		return m.Ok
+		return m.MissedSlots
 	}
-	return false
+	return 0
 }
 
 func init() {
@@ -874,8 +1920,26 @@ func init() {
 	proto.RegisterType((*GetAccountTransactionsRequest)(nil), "rpcpb.GetAccountTransactionsRequest")
 	proto.RegisterType((*SendTransactionRequest)(nil), "rpcpb.SendTransactionRequest")
 	proto.RegisterType((*SendTransactionResponse)(nil), "rpcpb.SendTransactionResponse")
+	proto.RegisterType((*GetIssuerRequest)(nil), "rpcpb.GetIssuerRequest")
+	proto.RegisterType((*Issuer)(nil), "rpcpb.Issuer")
+	proto.RegisterType((*Record)(nil), "rpcpb.Record")
+	proto.RegisterType((*GetRecordRequest)(nil), "rpcpb.GetRecordRequest")
+	proto.RegisterType((*ListAccountRecordsRequest)(nil), "rpcpb.ListAccountRecordsRequest")
+	proto.RegisterType((*ListAccountRecordsResponse)(nil), "rpcpb.ListAccountRecordsResponse")
+	proto.RegisterType((*Certification)(nil), "rpcpb.Certification")
+	proto.RegisterType((*GetCertificationRequest)(nil), "rpcpb.GetCertificationRequest")
+	proto.RegisterType((*ListAccountCertificationsRequest)(nil), "rpcpb.ListAccountCertificationsRequest")
+	proto.RegisterType((*ListAccountCertificationsResponse)(nil), "rpcpb.ListAccountCertificationsResponse")
+	proto.RegisterType((*GetRecordHistoryRequest)(nil), "rpcpb.GetRecordHistoryRequest")
+	proto.RegisterType((*GetRecordHistoryResponse)(nil), "rpcpb.GetRecordHistoryResponse")
+	proto.RegisterType((*VerifyRecordProofRequest)(nil), "rpcpb.VerifyRecordProofRequest")
+	proto.RegisterType((*VerifyRecordProofResponse)(nil), "rpcpb.VerifyRecordProofResponse")
 	proto.RegisterType((*SubscribeRequest)(nil), "rpcpb.SubscribeRequest")
 	proto.RegisterType((*SubscribeResponse)(nil), "rpcpb.SubscribeResponse")
+	proto.RegisterType((*TransactionReceipt)(nil), "rpcpb.TransactionReceipt")
+	proto.RegisterType((*GetEventsRequest)(nil), "rpcpb.GetEventsRequest")
+	proto.RegisterType((*GetEventsResponse)(nil), "rpcpb.GetEventsResponse")
+	proto.RegisterType((*Event)(nil), "rpcpb.Event")
 	proto.RegisterType((*HealthCheckResponse)(nil), "rpcpb.HealthCheckResponse")
 }
 
@@ -900,7 +1964,15 @@ type ApiServiceClient interface {
 	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error)
 	GetAccountTransactions(ctx context.Context, in *GetAccountTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error)
 	SendTransaction(ctx context.Context, in *SendTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
+	GetRecord(ctx context.Context, in *GetRecordRequest, opts ...grpc.CallOption) (*Record, error)
+	ListAccountRecords(ctx context.Context, in *ListAccountRecordsRequest, opts ...grpc.CallOption) (*ListAccountRecordsResponse, error)
+	GetCertification(ctx context.Context, in *GetCertificationRequest, opts ...grpc.CallOption) (*Certification, error)
+	ListAccountCertifications(ctx context.Context, in *ListAccountCertificationsRequest, opts ...grpc.CallOption) (*ListAccountCertificationsResponse, error)
+	GetIssuer(ctx context.Context, in *GetIssuerRequest, opts ...grpc.CallOption) (*Issuer, error)
+	GetRecordHistory(ctx context.Context, in *GetRecordHistoryRequest, opts ...grpc.CallOption) (*GetRecordHistoryResponse, error)
+	VerifyRecordProof(ctx context.Context, in *VerifyRecordProofRequest, opts ...grpc.CallOption) (*VerifyRecordProofResponse, error)
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error)
+	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
 	HealthCheck(ctx context.Context, in *NonParamRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 }
 
@@ -1002,6 +2074,69 @@ func (c *apiServiceClient) SendTransaction(ctx context.Context, in *SendTransact
 	return out, nil
 }
 
+func (c *apiServiceClient) GetRecord(ctx context.Context, in *GetRecordRequest, opts ...grpc.CallOption) (*Record, error) {
+	out := new(Record)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetRecord", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ListAccountRecords(ctx context.Context, in *ListAccountRecordsRequest, opts ...grpc.CallOption) (*ListAccountRecordsResponse, error) {
+	out := new(ListAccountRecordsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/ListAccountRecords", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetCertification(ctx context.Context, in *GetCertificationRequest, opts ...grpc.CallOption) (*Certification, error) {
+	out := new(Certification)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetCertification", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ListAccountCertifications(ctx context.Context, in *ListAccountCertificationsRequest, opts ...grpc.CallOption) (*ListAccountCertificationsResponse, error) {
+	out := new(ListAccountCertificationsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/ListAccountCertifications", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetIssuer(ctx context.Context, in *GetIssuerRequest, opts ...grpc.CallOption) (*Issuer, error) {
+	out := new(Issuer)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetIssuer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetRecordHistory(ctx context.Context, in *GetRecordHistoryRequest, opts ...grpc.CallOption) (*GetRecordHistoryResponse, error) {
+	out := new(GetRecordHistoryResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetRecordHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) VerifyRecordProof(ctx context.Context, in *VerifyRecordProofRequest, opts ...grpc.CallOption) (*VerifyRecordProofResponse, error) {
+	out := new(VerifyRecordProofResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/VerifyRecordProof", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error) {
 	stream, err := grpc.NewClientStream(ctx, &_ApiService_serviceDesc.Streams[0], c.cc, "/rpcpb.ApiService/Subscribe", opts...)
 	if err != nil {
@@ -1034,6 +2169,15 @@ func (x *apiServiceSubscribeClient) Recv() (*SubscribeResponse, error) {
 	return m, nil
 }
 
+func (c *apiServiceClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error) {
+	out := new(GetEventsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetEvents", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) HealthCheck(ctx context.Context, in *NonParamRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
 	out := new(HealthCheckResponse)
 	err := grpc.Invoke(ctx, "/rpcpb.ApiService/HealthCheck", in, out, c.cc, opts...)
@@ -1056,7 +2200,15 @@ type ApiServiceServer interface {
 	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
 	GetAccountTransactions(context.Context, *GetAccountTransactionsRequest) (*GetTransactionsResponse, error)
 	SendTransaction(context.Context, *SendTransactionRequest) (*SendTransactionResponse, error)
+	GetRecord(context.Context, *GetRecordRequest) (*Record, error)
+	ListAccountRecords(context.Context, *ListAccountRecordsRequest) (*ListAccountRecordsResponse, error)
+	GetCertification(context.Context, *GetCertificationRequest) (*Certification, error)
+	ListAccountCertifications(context.Context, *ListAccountCertificationsRequest) (*ListAccountCertificationsResponse, error)
+	GetIssuer(context.Context, *GetIssuerRequest) (*Issuer, error)
+	GetRecordHistory(context.Context, *GetRecordHistoryRequest) (*GetRecordHistoryResponse, error)
+	VerifyRecordProof(context.Context, *VerifyRecordProofRequest) (*VerifyRecordProofResponse, error)
 	Subscribe(*SubscribeRequest, ApiService_SubscribeServer) error
+	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
 	HealthCheck(context.Context, *NonParamRequest) (*HealthCheckResponse, error)
 }
 
@@ -1244,6 +2396,132 @@ func _ApiService_SendTransaction_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetRecord(ctx, req.(*GetRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_ListAccountRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).ListAccountRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/ListAccountRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).ListAccountRecords(ctx, req.(*ListAccountRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetCertification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCertificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetCertification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetCertification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetCertification(ctx, req.(*GetCertificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_ListAccountCertifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountCertificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).ListAccountCertifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/ListAccountCertifications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).ListAccountCertifications(ctx, req.(*ListAccountCertificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssuerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetIssuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetIssuer(ctx, req.(*GetIssuerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetRecordHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecordHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetRecordHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetRecordHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetRecordHistory(ctx, req.(*GetRecordHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_VerifyRecordProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyRecordProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).VerifyRecordProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/VerifyRecordProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).VerifyRecordProof(ctx, req.(*VerifyRecordProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscribeRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1265,6 +2543,24 @@ func (x *apiServiceSubscribeServer) Send(m *SubscribeResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ApiService_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetEvents(ctx, req.(*GetEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NonParamRequest)
 	if err := dec(in); err != nil {
@@ -1327,6 +2623,38 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendTransaction",
 			Handler:    _ApiService_SendTransaction_Handler,
 		},
+		{
+			MethodName: "GetRecord",
+			Handler:    _ApiService_GetRecord_Handler,
+		},
+		{
+			MethodName: "ListAccountRecords",
+			Handler:    _ApiService_ListAccountRecords_Handler,
+		},
+		{
+			MethodName: "GetCertification",
+			Handler:    _ApiService_GetCertification_Handler,
+		},
+		{
+			MethodName: "ListAccountCertifications",
+			Handler:    _ApiService_ListAccountCertifications_Handler,
+		},
+		{
+			MethodName: "GetIssuer",
+			Handler:    _ApiService_GetIssuer_Handler,
+		},
+		{
+			MethodName: "GetRecordHistory",
+			Handler:    _ApiService_GetRecordHistory_Handler,
+		},
+		{
+			MethodName: "VerifyRecordProof",
+			Handler:    _ApiService_VerifyRecordProof_Handler,
+		},
+		{
+			MethodName: "GetEvents",
+			Handler:    _ApiService_GetEvents_Handler,
+		},
 		{
 			MethodName: "HealthCheck",
 			Handler:    _ApiService_HealthCheck_Handler,
@@ -1345,82 +2673,165 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("rpc.proto", fileDescriptorRpc) }
 
 var fileDescriptorRpc = []byte{
-	// 1232 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0x5b, 0x6f, 0x1b, 0x45,
-	0x14, 0xd6, 0xae, 0x93, 0xd8, 0x7b, 0xdc, 0x5c, 0x3c, 0x49, 0x9d, 0x8d, 0xeb, 0xa4, 0x66, 0x54,
-	0x84, 0x15, 0x44, 0x5c, 0x82, 0xc4, 0x43, 0x25, 0x1e, 0xd2, 0x56, 0x4a, 0x23, 0x41, 0x15, 0x36,
-	0x11, 0x12, 0x55, 0x91, 0x35, 0xde, 0x9d, 0xda, 0xab, 0x6c, 0x66, 0x96, 0xdd, 0x71, 0x62, 0x0b,
-	0xf1, 0xc2, 0x23, 0xaf, 0xfc, 0x03, 0x1e, 0x91, 0xf8, 0x21, 0xf0, 0xca, 0x5f, 0xe0, 0x87, 0xa0,
-	0x99, 0x9d, 0xbd, 0xf9, 0x92, 0xd0, 0xe7, 0xbe, 0xed, 0xb9, 0xcc, 0x77, 0x66, 0xce, 0xe5, 0x3b,
-	0x36, 0x58, 0x51, 0xe8, 0x1e, 0x85, 0x11, 0x17, 0x1c, 0xad, 0x46, 0xa1, 0x1b, 0x0e, 0x5a, 0xed,
-	0x21, 0xe7, 0xc3, 0x80, 0xf6, 0x48, 0xe8, 0xf7, 0x08, 0x63, 0x5c, 0x10, 0xe1, 0x73, 0x16, 0x27,
-	0x4e, 0xf8, 0x7b, 0x68, 0x9c, 0x52, 0x71, 0xe2, 0xba, 0x7c, 0xcc, 0x84, 0x43, 0x7f, 0x1c, 0xd3,
-	0x58, 0x20, 0x1b, 0xaa, 0xc4, 0xf3, 0x22, 0x1a, 0xc7, 0xb6, 0xd1, 0x31, 0xba, 0x96, 0x93, 0x8a,
-	0x08, 0xc1, 0x8a, 0x98, 0x86, 0xd4, 0x36, 0x95, 0x5a, 0x7d, 0xa3, 0x26, 0xac, 0x8d, 0xa8, 0x3f,
-	0x1c, 0x09, 0xbb, 0xd2, 0x31, 0xba, 0x2b, 0x8e, 0x96, 0xf0, 0xdf, 0x06, 0xa0, 0x22, 0x76, 0x1c,
-	0x72, 0x16, 0xd3, 0x3b, 0xc0, 0x6d, 0xa8, 0x0e, 0x48, 0x40, 0x98, 0x9b, 0xe2, 0xa7, 0x22, 0xda,
-	0x81, 0x55, 0xc6, 0xa5, 0x3e, 0x89, 0x90, 0x08, 0xd2, 0xff, 0x86, 0xc6, 0xc2, 0x67, 0x43, 0x7b,
-	0x25, 0xf1, 0xd7, 0xa2, 0xf4, 0xbf, 0xe1, 0x82, 0x7a, 0xf6, 0x6a, 0xa7, 0xd2, 0xb5, 0x9c, 0x44,
-	0x40, 0x6d, 0xb0, 0x06, 0x84, 0x79, 0xb7, 0xbe, 0x27, 0x46, 0xf6, 0x9a, 0x3a, 0x91, 0x2b, 0xa4,
-	0x75, 0xcc, 0x62, 0x41, 0xae, 0x24, 0x5e, 0x35, 0xb1, 0x66, 0x0a, 0xfc, 0x2d, 0x6c, 0x9e, 0x52,
-	0xf1, 0x3c, 0xe0, 0xee, 0x55, 0x9a, 0x25, 0x04, 0x2b, 0x23, 0x12, 0x8f, 0xf4, 0x2b, 0xd4, 0xf7,
-	0x7b, 0xe5, 0xe7, 0xf7, 0x0a, 0x6c, 0xe5, 0x98, 0x3a, 0x3b, 0xb9, 0xb3, 0x51, 0x74, 0xce, 0x82,
-	0x99, 0x85, 0x60, 0x8f, 0xa1, 0x1e, 0x92, 0x88, 0x32, 0xd1, 0x57, 0xa6, 0x8a, 0x32, 0x41, 0xa2,
-	0x7a, 0x25, 0x1d, 0x5a, 0x50, 0x73, 0xb9, 0xcf, 0x06, 0x24, 0xa6, 0x3a, 0x43, 0x99, 0x2c, 0x03,
-	0x45, 0xf4, 0x96, 0x44, 0x32, 0x47, 0xd2, 0xa2, 0x25, 0xa9, 0x8f, 0xc7, 0x61, 0x18, 0x4c, 0x75,
-	0x86, 0xb4, 0x24, 0xd3, 0x23, 0xfc, 0x6b, 0x1a, 0x0b, 0x72, 0x1d, 0xaa, 0xf4, 0x54, 0x9c, 0x5c,
-	0x81, 0xf6, 0xa0, 0xe6, 0x8e, 0x88, 0xcf, 0xfa, 0xbe, 0x67, 0xd7, 0x3a, 0x46, 0x77, 0xdd, 0xa9,
-	0x2a, 0xf9, 0xcc, 0x43, 0x5b, 0x50, 0x21, 0xc1, 0xd0, 0xb6, 0x94, 0x56, 0x7e, 0xca, 0xb7, 0xc4,
-	0xfe, 0x90, 0xd9, 0x90, 0xbc, 0x45, 0x7e, 0xa3, 0x47, 0x60, 0x11, 0xd7, 0x8d, 0xfb, 0x11, 0xe7,
-	0xc2, 0xde, 0x49, 0xee, 0x2a, 0x15, 0x0e, 0xe7, 0x42, 0xa2, 0x8b, 0x89, 0xb6, 0x3d, 0x4c, 0x2a,
-	0x2d, 0x26, 0x89, 0xe9, 0x11, 0x58, 0x5e, 0xc8, 0xb5, 0xad, 0x99, 0x9c, 0x93, 0x0a, 0x65, 0x3c,
-	0x81, 0x07, 0x22, 0x22, 0x2c, 0x26, 0xae, 0x6a, 0x79, 0xfb, 0xa0, 0x53, 0xe9, 0xd6, 0x8f, 0xf7,
-	0x8f, 0xd4, 0x60, 0x1c, 0x9d, 0x52, 0x71, 0x99, 0x5b, 0xd3, 0x0a, 0x38, 0xa5, 0x23, 0xf8, 0xcb,
-	0xbc, 0x46, 0x71, 0xa1, 0xf0, 0xef, 0x22, 0x7e, 0xad, 0x2b, 0xa4, 0xbe, 0xd1, 0x06, 0x98, 0x82,
-	0xab, 0xea, 0xac, 0x38, 0xa6, 0xe0, 0xf8, 0xa5, 0x9a, 0xab, 0xf4, 0x9c, 0x2e, 0x6e, 0x0f, 0xd6,
-	0x06, 0x4a, 0x63, 0x1b, 0xea, 0x26, 0xbb, 0xf9, 0x4d, 0x4a, 0x5d, 0xe0, 0x68, 0x37, 0xdc, 0x80,
-	0xcd, 0xd7, 0x9c, 0x9d, 0x93, 0x88, 0x5c, 0xeb, 0xe0, 0x98, 0x80, 0xf5, 0x82, 0x30, 0xcf, 0xf7,
-	0x88, 0xb8, 0x6b, 0x96, 0xda, 0x60, 0xb9, 0x3c, 0x08, 0x88, 0x88, 0x48, 0xa0, 0x9b, 0x26, 0x57,
-	0x48, 0xab, 0x1c, 0x89, 0x73, 0x7e, 0x4b, 0x23, 0xdd, 0x37, 0xb9, 0x02, 0x9f, 0xc1, 0xc3, 0x53,
-	0x2a, 0xb2, 0x28, 0xf9, 0xfd, 0x9f, 0x02, 0xb8, 0x99, 0x56, 0xbf, 0x61, 0x4b, 0xbf, 0x21, 0x73,
-	0x77, 0x0a, 0x3e, 0xf8, 0x58, 0x51, 0xc0, 0xcb, 0x29, 0x23, 0xb1, 0x98, 0x66, 0x38, 0x6d, 0xb0,
-	0xf4, 0x3d, 0x35, 0x8c, 0xe5, 0xe4, 0x0a, 0xcc, 0x60, 0xfb, 0x94, 0x8a, 0x6f, 0xa8, 0x77, 0x21,
-	0x24, 0x5c, 0x7a, 0xa8, 0xd8, 0x62, 0x46, 0xb9, 0xc5, 0xe4, 0xd4, 0x11, 0x3f, 0xc8, 0xa6, 0x8e,
-	0xf8, 0xc1, 0xb2, 0xa9, 0x93, 0xed, 0xf8, 0xf5, 0xd9, 0x73, 0x3d, 0x0e, 0xf2, 0x13, 0xbf, 0x85,
-	0xdd, 0x72, 0x2b, 0xe4, 0x0f, 0x9e, 0x6d, 0x20, 0xe3, 0xfd, 0x1b, 0xe8, 0x53, 0x95, 0xcc, 0x92,
-	0xdf, 0x52, 0xfa, 0xc0, 0x7f, 0x99, 0xd0, 0x5c, 0x8c, 0xba, 0x8c, 0x6d, 0x54, 0x23, 0xea, 0x77,
-	0x17, 0x1a, 0x31, 0xa9, 0xa9, 0x29, 0xb8, 0xa2, 0x42, 0x12, 0x8c, 0x53, 0x02, 0x48, 0x84, 0xf2,
-	0x34, 0xaf, 0xce, 0x4e, 0xf3, 0x2e, 0x54, 0xc5, 0xa4, 0xaf, 0x88, 0x4c, 0x93, 0x80, 0x98, 0x5c,
-	0x4a, 0x2a, 0xcb, 0x78, 0xb8, 0x5a, 0xe4, 0xe1, 0x3b, 0x86, 0xdf, 0x86, 0x6a, 0x48, 0xa6, 0x01,
-	0x27, 0x9e, 0x1e, 0xea, 0x54, 0x4c, 0x69, 0xe1, 0x60, 0x9e, 0x16, 0x1e, 0x17, 0x68, 0x61, 0x1f,
-	0x20, 0x24, 0x53, 0x1a, 0xf5, 0x95, 0xa5, 0x93, 0x74, 0xaa, 0xd2, 0x5c, 0x48, 0x73, 0x0b, 0x6a,
-	0x74, 0x42, 0xdd, 0xb1, 0xa4, 0xfa, 0x8f, 0x3a, 0x46, 0xb7, 0xe6, 0x64, 0x32, 0x1e, 0xc0, 0x7e,
-	0xbe, 0x7d, 0xca, 0xd5, 0xbd, 0x6f, 0xcb, 0x7d, 0x02, 0x9b, 0x3e, 0x73, 0x83, 0xb1, 0x47, 0xfb,
-	0x21, 0x65, 0x9e, 0x5c, 0x08, 0xa6, 0x42, 0xdf, 0xd0, 0xea, 0xf3, 0x44, 0x8b, 0xff, 0x34, 0xa1,
-	0x79, 0x41, 0x99, 0xf7, 0xff, 0xca, 0xfb, 0xc1, 0xd7, 0x0b, 0x7f, 0x06, 0xbb, 0x73, 0xe9, 0x5a,
-	0xde, 0xdf, 0xf8, 0x10, 0xb6, 0x2e, 0xc6, 0x83, 0xd8, 0x8d, 0xfc, 0x01, 0x4d, 0xf3, 0xda, 0x84,
-	0x35, 0xc1, 0x43, 0xdf, 0x4d, 0x89, 0x43, 0x4b, 0xf8, 0x2b, 0x68, 0x14, 0x7c, 0x35, 0xe8, 0x0e,
-	0xac, 0x2a, 0xb3, 0x46, 0x4d, 0x84, 0x45, 0xbb, 0x14, 0x7f, 0x0c, 0xdb, 0xaf, 0x28, 0x09, 0xc4,
-	0xe8, 0xc5, 0x88, 0x16, 0xd6, 0xf1, 0x06, 0x98, 0xfc, 0x4a, 0x9d, 0xae, 0x39, 0x26, 0xbf, 0x3a,
-	0xfe, 0xc3, 0x02, 0x38, 0x09, 0xfd, 0x0b, 0x1a, 0xdd, 0xf8, 0x2e, 0x45, 0xdf, 0x01, 0xe4, 0x3d,
-	0x86, 0xec, 0x9c, 0x17, 0xca, 0x3f, 0xa8, 0x5a, 0x7b, 0x0b, 0x2c, 0x49, 0x04, 0xbc, 0xfd, 0xcb,
-	0x3f, 0xff, 0xfe, 0x66, 0xae, 0xa3, 0x7a, 0xef, 0xe6, 0xf3, 0x1e, 0xd1, 0x48, 0xaf, 0xa1, 0x96,
-	0xee, 0x04, 0xd4, 0x9c, 0x5b, 0x12, 0x09, 0xe6, 0xb2, 0xe5, 0x81, 0x1b, 0x0a, 0xb1, 0x8e, 0x2c,
-	0x89, 0xa8, 0x16, 0x09, 0x72, 0xc0, 0xca, 0xb6, 0x11, 0x9a, 0x3d, 0x98, 0x0e, 0x44, 0xcb, 0x9e,
-	0x37, 0x68, 0x48, 0xa4, 0x20, 0x1f, 0x20, 0xc8, 0x20, 0x63, 0xf4, 0x03, 0xac, 0x97, 0xb6, 0x44,
-	0x76, 0xd1, 0x99, 0x8d, 0xd5, 0x6a, 0xe7, 0xb0, 0xf3, 0x3b, 0x05, 0x37, 0x15, 0xf4, 0x16, 0xda,
-	0x90, 0xd0, 0xf9, 0xe6, 0x40, 0x97, 0x2a, 0xb5, 0x7a, 0x73, 0x2c, 0xc5, 0x2e, 0x24, 0x76, 0x66,
-	0xc9, 0x94, 0x13, 0xeb, 0x69, 0x9c, 0xb7, 0x50, 0x2f, 0xec, 0x96, 0xa5, 0xb0, 0xad, 0x1c, 0x76,
-	0x76, 0x0f, 0xe1, 0x3d, 0x85, 0xbb, 0x8d, 0x1a, 0x12, 0x97, 0x71, 0x8f, 0xf6, 0xae, 0xa9, 0x17,
-	0x2b, 0xb8, 0x48, 0xb1, 0xb7, 0x26, 0x87, 0x22, 0xe5, 0x2c, 0x0d, 0x74, 0xb0, 0x70, 0x95, 0xe4,
-	0xd9, 0xe9, 0xa8, 0x60, 0x2d, 0x64, 0xcb, 0x60, 0xc5, 0xbd, 0xd2, 0xd3, 0xc4, 0x84, 0xde, 0xc1,
-	0x46, 0xf9, 0x30, 0x6a, 0x2f, 0x59, 0x4f, 0x49, 0xc4, 0xbb, 0x97, 0x17, 0xde, 0x55, 0x01, 0x1b,
-	0x68, 0x73, 0x26, 0x20, 0xfa, 0xd5, 0x50, 0x8f, 0x5b, 0xc0, 0xa7, 0xe8, 0xc9, 0x5c, 0x77, 0x2f,
-	0xa0, 0xdb, 0x7b, 0x9f, 0x7a, 0xa8, 0x22, 0x3f, 0x41, 0xb8, 0x30, 0x08, 0xbd, 0x9f, 0x34, 0x25,
-	0xff, 0x5c, 0x7a, 0x3c, 0x0a, 0x60, 0x73, 0x86, 0x47, 0x50, 0xfa, 0xae, 0xc5, 0x74, 0x9c, 0x45,
-	0x5f, 0x42, 0x3f, 0xb8, 0xa5, 0xa2, 0xef, 0xe0, 0xd9, 0x77, 0x3f, 0x33, 0x0e, 0xd1, 0x1b, 0xb0,
-	0x32, 0x6a, 0xc9, 0xa6, 0x67, 0x96, 0x98, 0xb2, 0xe9, 0x99, 0x63, 0x21, 0x6c, 0x2b, 0x6c, 0x84,
-	0xd7, 0x25, 0x76, 0x9c, 0x9a, 0x9f, 0x19, 0x87, 0x4f, 0x0d, 0xf4, 0x06, 0xea, 0x05, 0xde, 0xb9,
-	0xb7, 0x21, 0x17, 0x70, 0x54, 0xb9, 0x64, 0x23, 0xe5, 0xe0, 0x4a, 0x87, 0xc1, 0x9a, 0xfa, 0x8b,
-	0xf7, 0xc5, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x54, 0x0d, 0x9c, 0x22, 0x14, 0x0e, 0x00, 0x00,
+	// 2557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x19, 0x4d, 0x8f, 0xdc, 0x48,
+	0x55, 0xee, 0xee, 0xe9, 0x6e, 0xbf, 0xf9, 0xae, 0x24, 0x33, 0x9e, 0xce, 0x24, 0x99, 0x98, 0x84,
+	0x0c, 0xc3, 0x6e, 0x26, 0xcc, 0xa2, 0x15, 0x8a, 0x10, 0x28, 0x9b, 0xa0, 0x64, 0xa4, 0x64, 0x09,
+	0x9e, 0x68, 0x11, 0xcb, 0x47, 0xab, 0xda, 0xae, 0xe9, 0x36, 0x71, 0xdb, 0x8e, 0x5d, 0x3d, 0x33,
+	0xad, 0x10, 0x09, 0x01, 0x87, 0x15, 0x5a, 0x09, 0x24, 0x2e, 0x1c, 0xf6, 0xc4, 0x11, 0x89, 0x13,
+	0x9c, 0xf8, 0x07, 0x70, 0x45, 0xfc, 0x03, 0x7e, 0x08, 0xaa, 0x57, 0x65, 0xbb, 0xec, 0x76, 0xcf,
+	0x24, 0x88, 0xdb, 0xde, 0xfa, 0x7d, 0xf8, 0xbd, 0x57, 0xef, 0xab, 0xde, 0xab, 0x06, 0x33, 0x89,
+	0xdd, 0xbb, 0x71, 0x12, 0xf1, 0x88, 0x2c, 0x24, 0xb1, 0x1b, 0x0f, 0x7a, 0xdb, 0xc3, 0x28, 0x1a,
+	0x06, 0x6c, 0x9f, 0xc6, 0xfe, 0x3e, 0x0d, 0xc3, 0x88, 0x53, 0xee, 0x47, 0x61, 0x2a, 0x99, 0xec,
+	0x1f, 0xc1, 0xfa, 0x63, 0xc6, 0x1f, 0xb8, 0x6e, 0x34, 0x09, 0xb9, 0xc3, 0x5e, 0x4d, 0x58, 0xca,
+	0x89, 0x05, 0x1d, 0xea, 0x79, 0x09, 0x4b, 0x53, 0xcb, 0xd8, 0x31, 0x76, 0x4d, 0x27, 0x03, 0x09,
+	0x81, 0x16, 0x9f, 0xc6, 0xcc, 0x6a, 0x20, 0x1a, 0x7f, 0x93, 0x0d, 0x68, 0x8f, 0x98, 0x3f, 0x1c,
+	0x71, 0xab, 0xb9, 0x63, 0xec, 0xb6, 0x1c, 0x05, 0xd9, 0xff, 0x34, 0x80, 0xe8, 0xb2, 0xd3, 0x38,
+	0x0a, 0x53, 0x76, 0x8e, 0x70, 0x0b, 0x3a, 0x03, 0x1a, 0xd0, 0xd0, 0xcd, 0xe4, 0x67, 0x20, 0xb9,
+	0x0c, 0x0b, 0x61, 0x24, 0xf0, 0x52, 0x83, 0x04, 0x04, 0xff, 0x09, 0x4b, 0xb9, 0x1f, 0x0e, 0xad,
+	0x96, 0xe4, 0x57, 0xa0, 0xe0, 0x3f, 0x89, 0x38, 0xf3, 0xac, 0x85, 0x9d, 0xe6, 0xae, 0xe9, 0x48,
+	0x80, 0x6c, 0x83, 0x39, 0xa0, 0xa1, 0x77, 0xea, 0x7b, 0x7c, 0x64, 0xb5, 0xf1, 0x8b, 0x02, 0x21,
+	0xa8, 0x93, 0x30, 0xe5, 0xf4, 0xa5, 0x90, 0xd7, 0x91, 0xd4, 0x1c, 0x61, 0xff, 0x00, 0x56, 0x1f,
+	0x33, 0xfe, 0x51, 0x10, 0xb9, 0x2f, 0x33, 0x2f, 0x11, 0x68, 0x8d, 0x68, 0x3a, 0x52, 0xa7, 0xc0,
+	0xdf, 0xef, 0xe4, 0x9f, 0x3f, 0x35, 0x61, 0xad, 0x90, 0xa9, 0xbc, 0x53, 0x30, 0x1b, 0x3a, 0x73,
+	0xae, 0xac, 0xa1, 0x29, 0xbb, 0x01, 0x8b, 0x31, 0x4d, 0x58, 0xc8, 0xfb, 0x48, 0x6a, 0x22, 0x09,
+	0x24, 0xea, 0x89, 0x60, 0xe8, 0x41, 0xd7, 0x8d, 0xfc, 0x70, 0x40, 0x53, 0xa6, 0x3c, 0x94, 0xc3,
+	0x42, 0x51, 0xc2, 0x4e, 0x69, 0x22, 0x7c, 0x24, 0x28, 0x0a, 0x12, 0xf8, 0x74, 0x12, 0xc7, 0xc1,
+	0x54, 0x79, 0x48, 0x41, 0xc2, 0x3d, 0xdc, 0x1f, 0xb3, 0x94, 0xd3, 0x71, 0x8c, 0xee, 0x69, 0x3a,
+	0x05, 0x82, 0x6c, 0x41, 0xd7, 0x1d, 0x51, 0x3f, 0xec, 0xfb, 0x9e, 0xd5, 0xdd, 0x31, 0x76, 0x97,
+	0x9d, 0x0e, 0xc2, 0x87, 0x1e, 0x59, 0x83, 0x26, 0x0d, 0x86, 0x96, 0x89, 0x58, 0xf1, 0x53, 0x9c,
+	0x25, 0xf5, 0x87, 0xa1, 0x05, 0xf2, 0x2c, 0xe2, 0x37, 0xb9, 0x0a, 0x26, 0x75, 0xdd, 0xb4, 0x9f,
+	0x44, 0x11, 0xb7, 0x2e, 0x4b, 0x5b, 0x05, 0xc2, 0x89, 0x22, 0x2e, 0xa4, 0xf3, 0x33, 0x45, 0xbb,
+	0x22, 0x23, 0xcd, 0xcf, 0x24, 0xe9, 0x2a, 0x98, 0x5e, 0x1c, 0x29, 0xda, 0x86, 0xfc, 0x4e, 0x20,
+	0x90, 0xf8, 0x00, 0x96, 0x78, 0x42, 0xc3, 0x94, 0xba, 0x98, 0xf2, 0xd6, 0xf5, 0x9d, 0xe6, 0xee,
+	0xe2, 0xc1, 0xb5, 0xbb, 0x58, 0x18, 0x77, 0x1f, 0x33, 0xfe, 0xa2, 0xa0, 0x66, 0x11, 0x70, 0x4a,
+	0x9f, 0xd8, 0x1f, 0x16, 0x31, 0x4a, 0xb5, 0xc0, 0x1f, 0x27, 0xd1, 0x58, 0x45, 0x08, 0x7f, 0x93,
+	0x15, 0x68, 0xf0, 0x08, 0xa3, 0xd3, 0x72, 0x1a, 0x3c, 0xb2, 0x1f, 0xc1, 0xba, 0xf6, 0x9d, 0x0a,
+	0xee, 0x3e, 0xb4, 0x07, 0x88, 0xb1, 0x0c, 0xb4, 0x64, 0xb3, 0xb0, 0xa4, 0x94, 0x05, 0x8e, 0x62,
+	0xb3, 0xd7, 0x61, 0xf5, 0xe3, 0x28, 0x7c, 0x4e, 0x13, 0x3a, 0x56, 0xca, 0x6d, 0x0a, 0xe6, 0x43,
+	0x1a, 0x7a, 0xbe, 0x47, 0xf9, 0x79, 0xb5, 0xb4, 0x0d, 0xa6, 0x1b, 0x05, 0x01, 0xe5, 0x09, 0x0d,
+	0x54, 0xd2, 0x14, 0x08, 0x41, 0x15, 0x25, 0xf1, 0x3c, 0x3a, 0x65, 0x89, 0xca, 0x9b, 0x02, 0x61,
+	0x1f, 0xc2, 0x95, 0xc7, 0x8c, 0xe7, 0x5a, 0x0a, 0xfb, 0xef, 0x01, 0xb8, 0x39, 0x56, 0x9d, 0x61,
+	0x4d, 0x9d, 0x21, 0x67, 0x77, 0x34, 0x1e, 0xfb, 0x00, 0x5b, 0xc0, 0xa3, 0x69, 0x48, 0x53, 0x3e,
+	0xcd, 0xe5, 0x6c, 0x83, 0xa9, 0xec, 0x54, 0x62, 0x4c, 0xa7, 0x40, 0xd8, 0x21, 0x5c, 0x7a, 0xcc,
+	0xf8, 0x33, 0xe6, 0x1d, 0x71, 0x21, 0x2e, 0xfb, 0x48, 0x4f, 0x31, 0xa3, 0x9c, 0x62, 0xa2, 0xea,
+	0xa8, 0x1f, 0xe4, 0x55, 0x47, 0xfd, 0x60, 0x5e, 0xd5, 0x89, 0x74, 0x7c, 0x7a, 0xf8, 0x91, 0x2a,
+	0x07, 0xf1, 0xd3, 0x7e, 0x03, 0x9b, 0xe5, 0x54, 0x28, 0x0e, 0x5c, 0x4d, 0x20, 0xe3, 0x9d, 0x13,
+	0x48, 0x14, 0x69, 0xc8, 0xce, 0x78, 0x3f, 0x3a, 0x3e, 0x4e, 0x19, 0x57, 0x19, 0x02, 0x02, 0xf5,
+	0x7d, 0xc4, 0xd8, 0x5f, 0x87, 0x2b, 0x55, 0x41, 0x73, 0xfb, 0x8b, 0xfd, 0x8f, 0x06, 0x6c, 0xd4,
+	0xab, 0x9d, 0xd7, 0x8e, 0x30, 0x53, 0x95, 0x63, 0xb4, 0x4c, 0x95, 0x41, 0x6f, 0xf0, 0x08, 0x7b,
+	0x25, 0x0d, 0x26, 0x59, 0x87, 0x90, 0x40, 0xb9, 0xdc, 0x17, 0xaa, 0xe5, 0xbe, 0x09, 0x1d, 0x7e,
+	0xd6, 0xc7, 0x4e, 0xa7, 0xba, 0x04, 0x3f, 0x7b, 0x31, 0x8d, 0xb5, 0x46, 0xdd, 0xd1, 0x1b, 0xf5,
+	0x39, 0xdd, 0xc1, 0x82, 0x4e, 0x4c, 0xa7, 0x41, 0x44, 0x3d, 0x55, 0xf5, 0x19, 0x98, 0xf5, 0x8d,
+	0xeb, 0xb3, 0x7d, 0xe3, 0x86, 0xd6, 0x37, 0xae, 0x01, 0xc4, 0x74, 0xca, 0x92, 0x3e, 0x52, 0x76,
+	0x64, 0x2a, 0x23, 0xe6, 0x48, 0x90, 0x7b, 0xd0, 0x65, 0x67, 0xcc, 0x9d, 0x88, 0xbb, 0xe0, 0xe6,
+	0x8e, 0xb1, 0xdb, 0x75, 0x72, 0xd8, 0xfe, 0xbd, 0x01, 0xd7, 0x8a, 0xfb, 0xa9, 0x1c, 0xff, 0x8b,
+	0xee, 0xc1, 0x3b, 0xb0, 0xea, 0x87, 0x6e, 0x30, 0xf1, 0x58, 0x3f, 0x66, 0xa1, 0x27, 0xae, 0x8c,
+	0x06, 0x8a, 0x5f, 0x51, 0xe8, 0xe7, 0x12, 0x2b, 0xd2, 0x50, 0x45, 0x5e, 0xa5, 0xa1, 0x84, 0x84,
+	0xa3, 0x02, 0x7f, 0xec, 0x73, 0xf4, 0x7a, 0xcb, 0x91, 0x80, 0xfd, 0x97, 0x06, 0x6c, 0x1c, 0xb1,
+	0xd0, 0x7b, 0xbb, 0x6c, 0xf8, 0xd2, 0x87, 0xd7, 0x7e, 0x1f, 0x36, 0x67, 0xdc, 0x35, 0xbf, 0x1c,
+	0xec, 0x43, 0x6c, 0xe6, 0x87, 0x69, 0x3a, 0x61, 0xc9, 0xc5, 0x31, 0xbe, 0x0a, 0xa6, 0xcb, 0x12,
+	0xde, 0xd7, 0x2e, 0xf4, 0xae, 0x40, 0x08, 0x4f, 0xd8, 0x7f, 0x33, 0xa0, 0x2d, 0x05, 0xfd, 0x8f,
+	0x12, 0x04, 0x31, 0x75, 0x47, 0x6c, 0x4c, 0x85, 0xdb, 0x64, 0xbc, 0xba, 0x12, 0x71, 0x88, 0xa3,
+	0x4a, 0xc2, 0x86, 0x7e, 0xca, 0x13, 0x9a, 0xa8, 0xc8, 0x15, 0x88, 0x0b, 0xa2, 0xd7, 0x83, 0xee,
+	0x30, 0x3a, 0x61, 0x49, 0xc8, 0x3c, 0x0c, 0x5f, 0xd7, 0xc9, 0x61, 0xfb, 0xef, 0x06, 0xb4, 0x1d,
+	0xe6, 0x46, 0x89, 0x27, 0x1a, 0x53, 0x82, 0xbf, 0xfa, 0x9a, 0x9f, 0x40, 0xa2, 0x70, 0x7a, 0xb8,
+	0x0c, 0x0b, 0xd1, 0x69, 0xc8, 0x12, 0x65, 0xb9, 0x04, 0xca, 0xba, 0x9b, 0x55, 0xdd, 0x37, 0x61,
+	0x49, 0x09, 0xc5, 0xa2, 0x42, 0xd3, 0x97, 0x1d, 0xa5, 0xe8, 0xa1, 0x40, 0x89, 0x73, 0xc7, 0x09,
+	0x3b, 0x91, 0x5a, 0xe5, 0xec, 0xd1, 0x15, 0x08, 0xd4, 0x79, 0x15, 0x4c, 0xec, 0x96, 0x48, 0x94,
+	0xb9, 0xd7, 0x15, 0x08, 0x41, 0xb4, 0x9f, 0x61, 0xf8, 0xa4, 0xf9, 0x17, 0x87, 0xaf, 0x72, 0xbe,
+	0x46, 0xf5, 0x7c, 0xb6, 0x0b, 0x5b, 0x4f, 0xfd, 0xb4, 0x98, 0x4f, 0x05, 0xe1, 0x2d, 0x4a, 0xbf,
+	0xa8, 0xe8, 0x46, 0x7d, 0x45, 0x37, 0xf5, 0x8a, 0xfe, 0x31, 0xf4, 0xea, 0x94, 0xa8, 0x24, 0xbd,
+	0x03, 0x1d, 0x69, 0x50, 0x76, 0xb5, 0x2c, 0xab, 0xab, 0x45, 0x1d, 0x32, 0xa3, 0x0a, 0xe1, 0x3c,
+	0xe2, 0xea, 0x2a, 0x6f, 0x39, 0x12, 0xb0, 0xff, 0xda, 0x80, 0xe5, 0x87, 0x2c, 0xe1, 0xfe, 0xb1,
+	0xef, 0xe2, 0x54, 0x4f, 0xbe, 0x06, 0x6b, 0x6e, 0x8e, 0x60, 0x7a, 0x64, 0x57, 0x35, 0x3c, 0xba,
+	0x7a, 0x03, 0xda, 0x3e, 0x26, 0xb0, 0x72, 0x8d, 0x82, 0x70, 0x72, 0x90, 0xac, 0x2c, 0xcb, 0xcb,
+	0x02, 0x21, 0x0a, 0x12, 0xf9, 0xfa, 0x22, 0xe6, 0x18, 0xde, 0xa6, 0x63, 0x22, 0xe6, 0x85, 0x3f,
+	0x16, 0x07, 0x5a, 0x65, 0x67, 0xb1, 0x9f, 0xa0, 0x35, 0x92, 0x47, 0xe6, 0xe7, 0x4a, 0x81, 0xce,
+	0x18, 0x13, 0x76, 0x12, 0xb9, 0x1a, 0x63, 0x5b, 0x32, 0x16, 0x68, 0x64, 0x2c, 0xd5, 0x50, 0xe7,
+	0xbc, 0x1a, 0xea, 0x56, 0x6a, 0x48, 0x4c, 0xb2, 0x9c, 0xf2, 0x49, 0x6a, 0x99, 0x6a, 0x92, 0x45,
+	0xc8, 0xfe, 0x19, 0xde, 0xf7, 0x25, 0xbf, 0x5d, 0x1c, 0xf5, 0x3a, 0xc7, 0x36, 0x6a, 0x1d, 0x6b,
+	0xff, 0xdb, 0x80, 0x1d, 0x2d, 0xe6, 0x25, 0x45, 0x6f, 0x91, 0x5f, 0xdb, 0x60, 0x7a, 0x7e, 0xc2,
+	0xb0, 0x9b, 0x65, 0x93, 0x5b, 0x8e, 0xd0, 0xa2, 0xd6, 0x2c, 0x45, 0xad, 0xe4, 0xa6, 0x56, 0xc5,
+	0x4d, 0x85, 0x27, 0x16, 0x74, 0x4f, 0x68, 0xa9, 0xdc, 0xae, 0x4f, 0xe5, 0x8e, 0x9e, 0xca, 0xa7,
+	0x70, 0xf3, 0x9c, 0x63, 0xa9, 0x8c, 0xfe, 0x36, 0xac, 0xb8, 0x25, 0x8a, 0x4a, 0xec, 0xcb, 0xd9,
+	0x98, 0x58, 0x72, 0x7b, 0x85, 0x77, 0x4e, 0x9a, 0xbf, 0xc0, 0x80, 0xc9, 0x92, 0x78, 0xe2, 0xa7,
+	0x3c, 0x4a, 0xa6, 0xff, 0x87, 0xf2, 0xff, 0x39, 0x58, 0xb3, 0x52, 0xdf, 0xb5, 0x2e, 0x6f, 0x43,
+	0x3b, 0xa0, 0x9c, 0xa5, 0xb2, 0x19, 0xcc, 0xf0, 0x29, 0xa2, 0xfd, 0x85, 0x01, 0xd6, 0x27, 0x2c,
+	0xf1, 0x8f, 0xa7, 0x92, 0xf0, 0x3c, 0x89, 0xa2, 0xe3, 0xec, 0x0c, 0x79, 0x9f, 0x35, 0xf4, 0x3e,
+	0x7b, 0x03, 0x16, 0xc7, 0x2c, 0x79, 0x19, 0x30, 0xb9, 0xda, 0x28, 0xfb, 0x25, 0x0a, 0x97, 0x9b,
+	0xca, 0x01, 0x9b, 0x75, 0xfd, 0xdb, 0x0f, 0x3d, 0x76, 0x96, 0x8d, 0x18, 0x08, 0x88, 0x7b, 0x31,
+	0xa6, 0x7c, 0xa4, 0x36, 0x63, 0xfc, 0x6d, 0x27, 0xb0, 0x55, 0x63, 0x9d, 0xf2, 0x85, 0x1c, 0x20,
+	0xd4, 0xd0, 0xdd, 0x75, 0x24, 0x50, 0xbe, 0x06, 0x1a, 0x17, 0x5d, 0x03, 0xcd, 0x99, 0x6b, 0xc0,
+	0xfe, 0xbc, 0x01, 0x6b, 0x47, 0x93, 0x41, 0xea, 0x26, 0xfe, 0x80, 0x65, 0xae, 0xd8, 0x80, 0x36,
+	0x8f, 0x62, 0xdf, 0xcd, 0xb6, 0x02, 0x05, 0x95, 0x17, 0x86, 0x46, 0x65, 0x61, 0x90, 0xeb, 0x21,
+	0x66, 0x7e, 0x6a, 0x35, 0x91, 0xd8, 0x91, 0xf3, 0x4a, 0x4a, 0xbe, 0x02, 0xcb, 0x9a, 0x93, 0x58,
+	0x6a, 0xb5, 0x90, 0xbe, 0x54, 0xb8, 0x89, 0x95, 0x87, 0x39, 0x35, 0xac, 0x2c, 0x94, 0x87, 0x39,
+	0x89, 0x15, 0x2e, 0x17, 0x33, 0x56, 0x5f, 0x2d, 0x16, 0xb2, 0x68, 0x40, 0xa0, 0x9e, 0x20, 0x46,
+	0x74, 0x47, 0x1a, 0x04, 0xd1, 0x69, 0xdf, 0x4b, 0x22, 0xb9, 0x25, 0x77, 0x1d, 0x13, 0x31, 0x8f,
+	0x92, 0x08, 0xb7, 0x64, 0xfc, 0x3e, 0x65, 0xaf, 0xb0, 0x5b, 0xb5, 0x9c, 0x8e, 0x80, 0x8f, 0xd8,
+	0x2b, 0xfb, 0xcf, 0x0d, 0x58, 0xd7, 0xdc, 0x51, 0xf8, 0x1e, 0x3d, 0x90, 0xa5, 0x06, 0x02, 0xb5,
+	0x6f, 0x01, 0xef, 0xc3, 0x02, 0xee, 0x8c, 0xe8, 0xea, 0x73, 0x36, 0x4b, 0xc9, 0x45, 0xbe, 0x0b,
+	0x8b, 0xda, 0x96, 0x82, 0x19, 0x72, 0xe1, 0x5e, 0xa3, 0x7f, 0x41, 0x3e, 0xc0, 0x0a, 0x61, 0x7e,
+	0xcc, 0xd1, 0x57, 0x8b, 0x07, 0x5b, 0xea, 0xe3, 0xd2, 0x97, 0xc8, 0xe0, 0x64, 0x9c, 0xa2, 0x5a,
+	0x85, 0x63, 0x62, 0x35, 0x98, 0xb4, 0x9c, 0x0c, 0x14, 0x33, 0x4b, 0xc2, 0xe2, 0x80, 0x4e, 0x99,
+	0xa7, 0xdc, 0x96, 0xc3, 0x62, 0x52, 0x2c, 0x1c, 0x26, 0x7e, 0xda, 0x9f, 0x19, 0x40, 0x66, 0xf5,
+	0xd4, 0x8e, 0xc8, 0xd7, 0x00, 0xf0, 0xc4, 0x7a, 0x17, 0x30, 0x11, 0x83, 0x35, 0x72, 0x13, 0x96,
+	0x14, 0x59, 0xdf, 0x15, 0x17, 0x25, 0x83, 0x8c, 0x69, 0x29, 0xd3, 0x5b, 0x95, 0x4c, 0xb7, 0x7f,
+	0x81, 0x33, 0xc9, 0xf7, 0x4e, 0x58, 0xc8, 0xf3, 0xde, 0xae, 0x87, 0xd9, 0x28, 0x85, 0xb9, 0x9a,
+	0x41, 0x8d, 0x99, 0x0c, 0xaa, 0x9d, 0x22, 0xb4, 0xba, 0x68, 0xe9, 0x75, 0x61, 0x7f, 0x6e, 0xc0,
+	0xba, 0xa6, 0x5e, 0x65, 0xcd, 0x2d, 0x68, 0x33, 0xc4, 0xa8, 0xe6, 0xb5, 0xa4, 0x42, 0x83, 0x6c,
+	0x8e, 0xa2, 0x09, 0x2b, 0x71, 0xd4, 0x12, 0x56, 0x4a, 0x3b, 0x3a, 0x02, 0x16, 0x56, 0x5e, 0x05,
+	0xf3, 0xd8, 0x4f, 0x52, 0x49, 0x93, 0x86, 0x74, 0x11, 0x21, 0x88, 0x5b, 0xd0, 0x0d, 0xa8, 0xa2,
+	0xc9, 0xce, 0xd2, 0x11, 0xb0, 0x48, 0xe2, 0x3f, 0x1a, 0xb0, 0x80, 0x4a, 0xb2, 0x98, 0x19, 0x79,
+	0xcc, 0x8a, 0x54, 0x6e, 0xd4, 0xa5, 0x72, 0x53, 0x0b, 0x59, 0xb1, 0xb9, 0xb7, 0x4a, 0x9b, 0x7b,
+	0x39, 0x94, 0x0b, 0xd5, 0x50, 0x96, 0xe2, 0xd4, 0xae, 0xc6, 0xe9, 0x37, 0x4d, 0xb8, 0xf4, 0x84,
+	0xd1, 0x80, 0x8f, 0x1e, 0x8e, 0x98, 0xf6, 0xde, 0xb6, 0x02, 0x8d, 0xe8, 0xa5, 0x6a, 0x6d, 0x8d,
+	0xe8, 0xa5, 0x30, 0x28, 0xf0, 0x4f, 0x98, 0xda, 0xe6, 0xf0, 0xb7, 0x30, 0x3d, 0x61, 0xd4, 0x9b,
+	0xa2, 0x95, 0x5d, 0x47, 0x02, 0x22, 0x65, 0x8f, 0xa9, 0x1f, 0x4c, 0x92, 0xbc, 0xab, 0xe4, 0xb0,
+	0x48, 0xf4, 0x74, 0x1a, 0xba, 0x62, 0x2d, 0x94, 0x9d, 0x24, 0x03, 0x45, 0x02, 0x88, 0xe7, 0x89,
+	0x4a, 0x0b, 0x11, 0x28, 0x95, 0x00, 0xa2, 0x99, 0x09, 0x06, 0x3a, 0x64, 0xea, 0x99, 0xad, 0x23,
+	0xe0, 0x07, 0x43, 0x26, 0x1c, 0x10, 0xf8, 0x83, 0xec, 0x53, 0x59, 0x0f, 0x66, 0xe0, 0x0f, 0xd4,
+	0x97, 0x9b, 0xd0, 0x11, 0xe4, 0x80, 0xca, 0xc7, 0xb6, 0x96, 0xd3, 0x0e, 0xfc, 0xc1, 0x53, 0x8a,
+	0xaf, 0xa1, 0x31, 0x63, 0x49, 0x8a, 0x0f, 0x6e, 0xcb, 0x8e, 0x04, 0xc4, 0x44, 0x23, 0x2e, 0x3d,
+	0x3a, 0x64, 0xfd, 0xd3, 0xc4, 0xe7, 0x74, 0x10, 0x30, 0x6b, 0x11, 0x8d, 0x5d, 0x55, 0xf8, 0x1f,
+	0x2a, 0x34, 0xb9, 0x0d, 0x2b, 0x9e, 0x7c, 0xc2, 0xe9, 0x8f, 0xd9, 0x78, 0xc0, 0x12, 0x6b, 0x09,
+	0x19, 0x97, 0x15, 0xf6, 0x19, 0x22, 0x45, 0x31, 0x8d, 0xfd, 0x34, 0x65, 0x5e, 0x3f, 0x0d, 0x22,
+	0x9e, 0x5a, 0xcb, 0xb2, 0xeb, 0x4b, 0xdc, 0x91, 0x40, 0x1d, 0xfc, 0x7a, 0x1d, 0xe0, 0x41, 0xec,
+	0x1f, 0xb1, 0xe4, 0xc4, 0x77, 0x19, 0xf9, 0x04, 0xa0, 0xd8, 0xc0, 0x89, 0x55, 0xf4, 0x9f, 0xf2,
+	0x83, 0x74, 0x6f, 0xab, 0x86, 0x22, 0x03, 0x68, 0x5f, 0xfa, 0xd5, 0xbf, 0xfe, 0xf3, 0x87, 0xc6,
+	0x32, 0x59, 0xdc, 0x3f, 0xf9, 0xc6, 0x3e, 0x55, 0x92, 0x3e, 0x86, 0x6e, 0xd6, 0xf9, 0xc8, 0xc6,
+	0x4c, 0x2b, 0x94, 0x32, 0xe7, 0xb5, 0x48, 0x7b, 0x1d, 0x25, 0x2e, 0x12, 0x53, 0x48, 0x94, 0xed,
+	0xd2, 0x01, 0x33, 0x63, 0x4b, 0x49, 0xf5, 0xc3, 0xac, 0xee, 0x7b, 0xd6, 0x2c, 0x41, 0x89, 0x24,
+	0x28, 0x72, 0x89, 0x40, 0x2e, 0x32, 0x25, 0x3f, 0x85, 0xe5, 0xd2, 0x2b, 0x5b, 0x6e, 0x68, 0xe5,
+	0xc5, 0xaf, 0xb7, 0x5d, 0x88, 0x9d, 0x7d, 0x93, 0xb3, 0x37, 0x50, 0xf4, 0x1a, 0x59, 0x11, 0xa2,
+	0x8b, 0x97, 0x37, 0xf2, 0x02, 0xa0, 0x78, 0x79, 0x9b, 0x2b, 0x5b, 0x73, 0x6c, 0xe5, 0x91, 0xae,
+	0xec, 0x58, 0x15, 0x68, 0xf2, 0x13, 0x58, 0xd4, 0xde, 0xe6, 0xe6, 0x8a, 0xed, 0x15, 0x62, 0xab,
+	0xef, 0x78, 0xf6, 0x16, 0xca, 0xbd, 0x44, 0xd6, 0x85, 0xdc, 0x30, 0xf2, 0xd8, 0xfe, 0x98, 0x79,
+	0x29, 0x8a, 0x4b, 0xf0, 0x71, 0x4b, 0x3d, 0x9d, 0xe8, 0x0f, 0x32, 0x73, 0x15, 0x5d, 0xaf, 0xbd,
+	0xb2, 0x0a, 0xef, 0xec, 0xa0, 0xb2, 0x1e, 0xb1, 0x84, 0x32, 0xfd, 0x5d, 0x6e, 0x5f, 0x3d, 0xdb,
+	0x90, 0x63, 0x58, 0x29, 0x7f, 0x4c, 0xb6, 0xe7, 0x5c, 0x83, 0x52, 0xe3, 0xf9, 0x97, 0xa4, 0xbd,
+	0x89, 0x0a, 0xd7, 0xc9, 0x6a, 0x45, 0x21, 0xf9, 0xad, 0x01, 0x1b, 0x45, 0xfa, 0x96, 0x0e, 0x77,
+	0x6b, 0x26, 0xbb, 0x6b, 0x1e, 0xa3, 0x2e, 0x3c, 0xea, 0x1e, 0x6a, 0xbe, 0x45, 0x6c, 0xad, 0x10,
+	0xf6, 0x5f, 0xab, 0x29, 0xe9, 0x4d, 0xe9, 0xf0, 0x24, 0x80, 0xd5, 0xca, 0xbb, 0x09, 0xc9, 0xce,
+	0x55, 0xff, 0xfc, 0xd4, 0xbb, 0x3e, 0x8f, 0xac, 0xb4, 0xf7, 0x50, 0xfb, 0x65, 0xbb, 0x7a, 0xee,
+	0xfb, 0xc6, 0x1e, 0x19, 0x62, 0xf5, 0xa8, 0x67, 0x07, 0xad, 0x7a, 0x4a, 0x9b, 0x7c, 0xaf, 0x3c,
+	0x3a, 0xdb, 0x07, 0x28, 0xf0, 0x3d, 0xb2, 0x57, 0x7f, 0x1c, 0x39, 0xc0, 0xed, 0xbf, 0xd6, 0xa6,
+	0xbb, 0x37, 0xe4, 0x97, 0x06, 0x90, 0xd9, 0x6d, 0x9b, 0xec, 0x28, 0xc9, 0x73, 0xb7, 0xfd, 0xde,
+	0xcd, 0x73, 0x38, 0xd4, 0x01, 0x6f, 0xa3, 0x3d, 0x37, 0xc8, 0xb5, 0xf3, 0xec, 0x49, 0xc9, 0x67,
+	0x06, 0x0e, 0x04, 0xe5, 0xad, 0x5c, 0x0b, 0x5d, 0xdd, 0xda, 0xd9, 0xab, 0x5d, 0x8e, 0xec, 0xef,
+	0xa0, 0xc6, 0x6f, 0x91, 0x0f, 0xeb, 0x35, 0x96, 0x56, 0xa7, 0xfd, 0xd7, 0xd5, 0xed, 0xf4, 0x0d,
+	0xf9, 0xc2, 0x28, 0x3d, 0x70, 0x3c, 0x2c, 0xaf, 0x5a, 0x77, 0x66, 0x8f, 0x5c, 0xbb, 0xa9, 0xf6,
+	0x76, 0x2f, 0x66, 0x54, 0x2e, 0x7a, 0x0f, 0x0d, 0xfe, 0x2a, 0xb9, 0xf5, 0x16, 0x06, 0xa7, 0x84,
+	0x61, 0x56, 0xa8, 0x37, 0x34, 0x2d, 0x2b, 0x4a, 0xcf, 0x73, 0x79, 0x56, 0x48, 0xac, 0x7d, 0x0f,
+	0x55, 0xec, 0x91, 0xdd, 0x7a, 0x15, 0x72, 0x19, 0x96, 0xce, 0xc0, 0x85, 0xe0, 0x0d, 0xf9, 0x9d,
+	0x01, 0x6b, 0xd5, 0x3d, 0x4f, 0x0f, 0x48, 0xdd, 0x5a, 0xd9, 0xbb, 0x31, 0x97, 0xae, 0x8e, 0x7a,
+	0x1f, 0xed, 0xf8, 0x26, 0x39, 0x78, 0xfb, 0xec, 0xdc, 0x1f, 0x29, 0xe5, 0x1c, 0xd6, 0x67, 0xb6,
+	0x2d, 0x92, 0x69, 0x9c, 0xb7, 0x25, 0xf6, 0x76, 0xe6, 0x33, 0x28, 0x9b, 0xb6, 0xd1, 0xa6, 0x8d,
+	0xfb, 0xc6, 0x9e, 0x8d, 0xbd, 0x55, 0x19, 0x71, 0x82, 0x1f, 0x90, 0x4f, 0xc1, 0xcc, 0xf7, 0x8b,
+	0xdc, 0xdd, 0xd5, 0x05, 0xac, 0x67, 0xcd, 0x12, 0x94, 0x74, 0x0b, 0xa5, 0x13, 0x7b, 0x59, 0x88,
+	0x4e, 0x33, 0xf2, 0x7d, 0x63, 0xef, 0x9e, 0xa1, 0xae, 0x47, 0x39, 0x85, 0xea, 0xa1, 0x2c, 0x8d,
+	0xc5, 0x3d, 0x6b, 0x96, 0x50, 0x77, 0x3d, 0xaa, 0xf1, 0xf4, 0x53, 0x58, 0xd4, 0xe6, 0xb5, 0x0b,
+	0x6f, 0x9a, 0x9a, 0xd9, 0xae, 0xdc, 0x8b, 0x47, 0xc8, 0xe0, 0x0a, 0x86, 0x41, 0x1b, 0xff, 0xfb,
+	0xfe, 0xe0, 0xbf, 0x03, 0x00, 0xf4, 0xa1, 0xdc, 0xa8, 0x2d, 0x1f, 0x00, 0x00,
 }
@@ -15,8 +15,9 @@ func main() {
 	out.Write([]byte("package rpcpb \n\nconst (\n"))
 	for _, f := range fs {
 		if strings.HasSuffix(f.Name(), ".json") {
-			name := strings.TrimPrefix(f.Name(), "rpc.")
-			out.Write([]byte(strings.TrimSuffix(name, ".json") + " = `"))
+			name := strings.TrimSuffix(strings.TrimPrefix(f.Name(), "rpc."), ".json")
+			name = strings.Replace(name, ".swagger", "Swagger", 1)
+			out.Write([]byte(name + " = `"))
 			f, _ := os.Open(f.Name())
 			io.Copy(out, f)
 			out.Write([]byte("`\n"))
@@ -2,5 +2,6 @@ package rpcpb
 
 // exporter
 const (
-	Swagger = swagger
+	Swagger      = swagger
+	AdminSwagger = adminSwagger
 )
@@ -1,7 +1,448 @@
-package rpcpb
+package rpcpb 
 
 const (
-	swagger = `{
+adminSwagger = `{
+  "swagger": "2.0",
+  "info": {
+    "title": "admin.proto",
+    "version": "version not set"
+  },
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "paths": {
+    "/v1/admin/lib/inspect": {
+      "post": {
+        "operationId": "InspectLIB",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbInspectLIBResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/rpcpbAdminRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AdminService"
+        ]
+      }
+    },
+    "/v1/admin/log/level": {
+      "put": {
+        "operationId": "SetLogLevel",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbAdminResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/rpcpbSetLogLevelRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AdminService"
+        ]
+      }
+    },
+    "/v1/admin/mining/start": {
+      "post": {
+        "operationId": "StartMining",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbAdminResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/rpcpbAdminRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AdminService"
+        ]
+      }
+    },
+    "/v1/admin/mining/stop": {
+      "post": {
+        "operationId": "StopMining",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbAdminResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/rpcpbAdminRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AdminService"
+        ]
+      }
+    },
+    "/v1/admin/node": {
+      "get": {
+        "operationId": "NodeInfo",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbNodeInfoResponse"
+            }
+          }
+        },
+        "tags": [
+          "AdminService"
+        ]
+      }
+    },
+    "/v1/admin/peer": {
+      "post": {
+        "operationId": "AddPeer",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbAdminResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/rpcpbAddPeerRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AdminService"
+        ]
+      }
+    },
+    "/v1/admin/peer/{id}": {
+      "delete": {
+        "operationId": "RemovePeer",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbAdminResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "id",
+            "description": "Peer ID.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "AdminService"
+        ]
+      }
+    },
+    "/v1/admin/peer/{id}/ban": {
+      "post": {
+        "operationId": "BanPeer",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbAdminResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "id",
+            "description": "Peer ID.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/rpcpbBanPeerRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AdminService"
+        ]
+      }
+    },
+    "/v1/admin/peers": {
+      "get": {
+        "operationId": "GetPeers",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbGetPeersResponse"
+            }
+          }
+        },
+        "tags": [
+          "AdminService"
+        ]
+      }
+    },
+    "/v1/admin/sync": {
+      "post": {
+        "operationId": "TriggerSync",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbAdminResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/rpcpbTriggerSyncRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AdminService"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "rpcpbAddPeerRequest": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "type": "string",
+          "title": "IPFS address of the peer. e.g. /ip4/127.0.0.1/tcp/9900/ipfs/{id}"
+        }
+      }
+    },
+    "rpcpbAdminRequest": {
+      "type": "object"
+    },
+    "rpcpbAdminResponse": {
+      "type": "object",
+      "properties": {
+        "ok": {
+          "type": "boolean",
+          "format": "boolean"
+        }
+      }
+    },
+    "rpcpbBanPeerRequest": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string",
+          "description": "Peer ID."
+        },
+        "duration": {
+          "type": "string",
+          "format": "int64",
+          "description": "Ban duration in seconds. If 0, peer is banned until the node restarts."
+        }
+      }
+    },
+    "rpcpbGetPeersResponse": {
+      "type": "object",
+      "properties": {
+        "peers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/rpcpbPeerInfo"
+          }
+        }
+      }
+    },
+    "rpcpbInspectLIBResponse": {
+      "type": "object",
+      "properties": {
+        "prev_hash": {
+          "type": "string",
+          "description": "LIB hash before inspection."
+        },
+        "prev_height": {
+          "type": "string",
+          "format": "uint64",
+          "description": "LIB height before inspection."
+        },
+        "hash": {
+          "type": "string",
+          "description": "LIB hash after inspection."
+        },
+        "height": {
+          "type": "string",
+          "format": "uint64",
+          "description": "LIB height after inspection."
+        }
+      }
+    },
+    "rpcpbNodeInfoResponse": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string",
+          "description": "Node ID."
+        },
+        "listen_addrs": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "description": "Listening addresses of the node."
+        },
+        "version": {
+          "type": "string",
+          "description": "Node version."
+        },
+        "client_version": {
+          "type": "string",
+          "description": "P2P protocol client version."
+        },
+        "chain_id": {
+          "type": "integer",
+          "format": "int64",
+          "description": "Block chain id."
+        },
+        "peer_count": {
+          "type": "integer",
+          "format": "int32",
+          "description": "Number of connected peers."
+        },
+        "mining": {
+          "type": "boolean",
+          "format": "boolean",
+          "description": "If block production is running, it returns true. otherwise, false."
+        },
+        "syncing": {
+          "type": "boolean",
+          "format": "boolean",
+          "description": "If block download is running, it returns true. otherwise, false."
+        }
+      }
+    },
+    "rpcpbPeerInfo": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string",
+          "description": "Peer ID."
+        },
+        "addr": {
+          "type": "string",
+          "description": "Peer address."
+        },
+        "established": {
+          "type": "boolean",
+          "format": "boolean",
+          "description": "If handshake with the peer succeeded, it returns true. otherwise, false."
+        },
+        "connected_at": {
+          "type": "string",
+          "format": "int64",
+          "description": "Unix time the stream is connected."
+        },
+        "latest_read_at": {
+          "type": "string",
+          "format": "int64",
+          "description": "Unix time the last message is read."
+        },
+        "latest_write_at": {
+          "type": "string",
+          "format": "int64",
+          "description": "Unix time the last message is written."
+        },
+        "msg_count": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string",
+            "format": "int64"
+          },
+          "description": "Number of messages received by message type."
+        }
+      }
+    },
+    "rpcpbSetLogLevelRequest": {
+      "type": "object",
+      "properties": {
+        "level": {
+          "type": "string",
+          "description": "Log level \"debug\", \"info\", \"warn\", or \"error\"."
+        }
+      }
+    },
+    "rpcpbTriggerSyncRequest": {
+      "type": "object",
+      "properties": {
+        "target_height": {
+          "type": "string",
+          "format": "uint64",
+          "description": "Target height to download. It should be higher than the current tail height."
+        }
+      }
+    }
+  }
+}
+`
+swagger = `{
   "swagger": "2.0",
   "info": {
     "title": "rpc.proto",
@@ -20,33 +461,271 @@ const (
   "paths": {
     "/v1/account": {
       "get": {
-        "operationId": "GetAccount",
+        "operationId": "GetAccount",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbGetAccountResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "address",
+            "description": "Hex string of the account addresss.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "type",
+            "description": "If you send type, height field is ignored.\nBlock type \"genesis\", \"confirmed\", or \"tail\".",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "height",
+            "description": "Block account state with height.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "uint64"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/v1/account/{address}/certification/{certificate_hash}": {
+      "get": {
+        "operationId": "GetCertification",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbCertification"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "address",
+            "description": "Hex string of the issuer's or certified account's address.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "certificate_hash",
+            "description": "Hex string of the certificate hash.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/v1/account/{address}/certifications": {
+      "get": {
+        "operationId": "ListAccountCertifications",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/rpcpbGetAccountResponse"
+              "$ref": "#/definitions/rpcpbListAccountCertificationsResponse"
             }
           }
         },
         "parameters": [
           {
             "name": "address",
-            "description": "Hex string of the account addresss.",
+            "description": "Hex string of the account address.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "direction",
+            "description": "Certifications \"received\" or \"issued\" by the account. Default is \"received\".",
             "in": "query",
             "required": false,
             "type": "string"
           },
           {
-            "name": "type",
-            "description": "If you send type, height field is ignored.\nBlock type \"genesis\", \"confirmed\", or \"tail\".",
+            "name": "issuer",
+            "description": "If you send issuer, only certifications issued by it are returned.",
             "in": "query",
             "required": false,
             "type": "string"
           },
           {
-            "name": "height",
-            "description": "Block account state with height.",
+            "name": "cert_type",
+            "description": "If you send cert_type, only certifications of the type are returned.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "status",
+            "description": "If you send status, only certifications of the status are returned.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "offset",
+            "description": "Number of certifications to skip.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "uint64"
+          },
+          {
+            "name": "limit",
+            "description": "Maximum number of certifications to return. Default is 100.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "uint64"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/v1/account/{address}/issuer/{cert_type}": {
+      "get": {
+        "operationId": "GetIssuer",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbIssuer"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "address",
+            "description": "Hex string of the issuer's address.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "cert_type",
+            "description": "Certification type.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/v1/account/{address}/record/{record_hash}": {
+      "get": {
+        "operationId": "GetRecord",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbRecord"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "address",
+            "description": "Hex string of the record owner's address.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "record_hash",
+            "description": "Hex string of the record hash.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/v1/account/{address}/record/{record_hash}/history": {
+      "get": {
+        "operationId": "GetRecordHistory",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbGetRecordHistoryResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "address",
+            "description": "Hex string of the record owner's address.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "record_hash",
+            "description": "Hex string of any record hash in the amendment history.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/v1/account/{address}/records": {
+      "get": {
+        "operationId": "ListAccountRecords",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbListAccountRecordsResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "address",
+            "description": "Hex string of the record owner's address.",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "offset",
+            "description": "Number of records to skip.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "uint64"
+          },
+          {
+            "name": "limit",
+            "description": "Maximum number of records to return. Default is 100.",
             "in": "query",
             "required": false,
             "type": "string",
@@ -84,6 +763,22 @@ const (
             "required": false,
             "type": "boolean",
             "format": "boolean"
+          },
+          {
+            "name": "offset",
+            "description": "Number of transactions to skip.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "uint64"
+          },
+          {
+            "name": "limit",
+            "description": "Maximum number of transactions to return. It is capped to the server limit, which is also used if 0.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "uint64"
           }
         ],
         "tags": [
@@ -195,6 +890,58 @@ const (
         ]
       }
     },
+    "/v1/events": {
+      "get": {
+        "operationId": "GetEvents",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbGetEventsResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "from_seq",
+            "description": "Sequence number of the first event. Events are returned from the oldest stored one if both from_seq and from_height are 0.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "uint64"
+          },
+          {
+            "name": "from_height",
+            "description": "Height of the first event. It is used if from_seq is 0.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "uint64"
+          },
+          {
+            "name": "limit",
+            "description": "Maximum number of scanned events.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "uint64"
+          },
+          {
+            "name": "topics",
+            "description": "Topics of returned events. All stored topics are returned if empty.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
     "/v1/healthcheck": {
       "get": {
         "operationId": "HealthCheck",
@@ -227,6 +974,32 @@ const (
         ]
       }
     },
+    "/v1/record/verify": {
+      "post": {
+        "operationId": "VerifyRecordProof",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbVerifyRecordProofResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/rpcpbVerifyRecordProofRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
     "/v1/subscribe": {
       "post": {
         "operationId": "Subscribe",
@@ -313,24 +1086,95 @@ const (
             }
           }
         },
-        "tags": [
-          "ApiService"
-        ]
+        "tags": [
+          "ApiService"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "rpcpbCandidate": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "collatral": {
+          "type": "string"
+        },
+        "votePower": {
+          "type": "string"
+        }
+      }
+    },
+    "rpcpbCertification": {
+      "type": "object",
+      "properties": {
+        "certificate_hash": {
+          "type": "string",
+          "description": "Hex string of the certificate hash."
+        },
+        "issuer": {
+          "type": "string",
+          "description": "Hex string of the issuer's address."
+        },
+        "certified": {
+          "type": "string",
+          "description": "Hex string of the certified account's address."
+        },
+        "issue_time": {
+          "type": "string",
+          "format": "int64",
+          "description": "Certification issue time."
+        },
+        "expiration_time": {
+          "type": "string",
+          "format": "int64",
+          "description": "Certification expiration time. 0 if it never expires."
+        },
+        "revocation_time": {
+          "type": "string",
+          "format": "int64",
+          "description": "Certification revocation time. -1 if not revoked."
+        },
+        "cert_type": {
+          "type": "string",
+          "description": "Certification type."
+        },
+        "schema_id": {
+          "type": "string",
+          "description": "Schema identifier of the certification type."
+        },
+        "status": {
+          "type": "string",
+          "description": "Certification status \"valid\", \"not_yet_valid\", \"expired\", or \"revoked\"."
+        }
       }
-    }
-  },
-  "definitions": {
-    "rpcpbCandidate": {
+    },
+    "rpcpbEvent": {
       "type": "object",
       "properties": {
-        "address": {
+        "seq": {
+          "type": "string",
+          "format": "uint64"
+        },
+        "topic": {
           "type": "string"
         },
-        "collatral": {
+        "hash": {
           "type": "string"
         },
-        "votePower": {
+        "height": {
+          "type": "string",
+          "format": "uint64",
+          "description": "Height of the block of the event. Height of the latest block stored before is used for pending transactions."
+        },
+        "block_hash": {
           "type": "string"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "int64"
         }
       }
     },
@@ -470,6 +1314,32 @@ const (
         }
       }
     },
+    "rpcpbGetEventsResponse": {
+      "type": "object",
+      "properties": {
+        "events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/rpcpbEvent"
+          }
+        },
+        "next_seq": {
+          "type": "string",
+          "format": "uint64",
+          "description": "Sequence number to request the next events."
+        },
+        "first_seq": {
+          "type": "string",
+          "format": "uint64",
+          "description": "Sequence number of the oldest stored event."
+        },
+        "last_seq": {
+          "type": "string",
+          "format": "uint64",
+          "description": "Sequence number of the latest stored event."
+        }
+      }
+    },
     "rpcpbGetMedStateResponse": {
       "type": "object",
       "properties": {
@@ -493,6 +1363,22 @@ const (
         }
       }
     },
+    "rpcpbGetRecordHistoryResponse": {
+      "type": "object",
+      "properties": {
+        "records": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/rpcpbRecord"
+          },
+          "description": "Every version of the record from the first to the latest."
+        },
+        "latest": {
+          "$ref": "#/definitions/rpcpbRecord",
+          "description": "Latest version of the record."
+        }
+      }
+    },
     "rpcpbGetTransactionResponse": {
       "type": "object",
       "properties": {
@@ -563,6 +1449,11 @@ const (
           "items": {
             "$ref": "#/definitions/rpcpbGetTransactionResponse"
           }
+        },
+        "next_offset": {
+          "type": "string",
+          "format": "uint64",
+          "description": "Offset of the next page of GetAccountTransactions. It is 0 if there are no more transactions."
         }
       }
     },
@@ -570,8 +1461,159 @@ const (
       "type": "object",
       "properties": {
         "ok": {
+          "type": "boolean",
+          "format": "boolean",
+          "description": "True if the node is ready."
+        },
+        "live": {
+          "type": "boolean",
+          "format": "boolean",
+          "description": "True if the node is alive. A node which is not alive should be restarted."
+        },
+        "ready": {
+          "type": "boolean",
+          "format": "boolean",
+          "description": "True if the node is alive and synchronized with the network."
+        },
+        "failures": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "description": "Failed checks."
+        },
+        "syncing": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "tail_height": {
+          "type": "string",
+          "format": "uint64"
+        },
+        "tail_age": {
+          "type": "string",
+          "format": "int64",
+          "description": "Seconds since the timestamp of the tail block."
+        },
+        "lib_height": {
+          "type": "string",
+          "format": "uint64"
+        },
+        "lib_lag": {
+          "type": "string",
+          "format": "uint64"
+        },
+        "peers": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "storage_writable": {
           "type": "boolean",
           "format": "boolean"
+        },
+        "dynasty_member": {
+          "type": "boolean",
+          "format": "boolean",
+          "description": "True if the miner of the node is a member of the dynasty."
+        },
+        "missed_slots": {
+          "type": "integer",
+          "format": "int64",
+          "description": "Number of missed slots among the recent slots of the miner."
+        }
+      }
+    },
+    "rpcpbIssuer": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "type": "string",
+          "description": "Hex string of the issuer's address."
+        },
+        "cert_type": {
+          "type": "string",
+          "description": "Certification type."
+        },
+        "schema_id": {
+          "type": "string",
+          "description": "Schema identifier of the certification type."
+        },
+        "registrar": {
+          "type": "string",
+          "description": "Hex string of the account which registered the issuer."
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "int64",
+          "description": "Timestamp of the registration."
+        },
+        "governed": {
+          "type": "boolean",
+          "format": "boolean",
+          "description": "If issuer is registered by governance rather than by itself, it returns true. otherwise, false."
+        }
+      }
+    },
+    "rpcpbListAccountCertificationsResponse": {
+      "type": "object",
+      "properties": {
+        "certifications": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/rpcpbCertification"
+          }
+        },
+        "total": {
+          "type": "string",
+          "format": "uint64",
+          "description": "Total number of certifications matching the filters."
+        }
+      }
+    },
+    "rpcpbListAccountRecordsResponse": {
+      "type": "object",
+      "properties": {
+        "records": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/rpcpbRecord"
+          }
+        },
+        "total": {
+          "type": "string",
+          "format": "uint64",
+          "description": "Total number of records of the account."
+        }
+      }
+    },
+    "rpcpbRecord": {
+      "type": "object",
+      "properties": {
+        "record_hash": {
+          "type": "string",
+          "description": "Hex string of the record hash."
+        },
+        "owner": {
+          "type": "string",
+          "description": "Hex string of the record owner's address."
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "int64",
+          "description": "Timestamp of the transaction which added the record."
+        },
+        "record_count": {
+          "type": "integer",
+          "format": "int64",
+          "description": "Number of record hashes if the record is an anchored batch."
+        },
+        "prev_hash": {
+          "type": "string",
+          "description": "Hex string of the record hash amended by this record."
+        },
+        "next_hash": {
+          "type": "string",
+          "description": "Hex string of the record hash amending this record."
         }
       }
     },
@@ -649,6 +1691,47 @@ const (
           "items": {
             "type": "string"
           }
+        },
+        "addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "description": "Hex strings of addresses. Only transactions sent from or to the addresses are streamed."
+        },
+        "tx_types": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "description": "Transaction types. Only transactions of the types are streamed."
+        },
+        "record_hashes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "description": "Hex strings of record hashes. Only transactions adding or amending the records are streamed."
+        },
+        "include_payload": {
+          "type": "boolean",
+          "format": "boolean",
+          "description": "If true, full block, transaction and receipt are included in the response."
+        },
+        "from_height": {
+          "type": "string",
+          "format": "uint64",
+          "description": "If set, blocks and transactions from the height are streamed before new events. The blocks from the height\nto the tail are limited to max_blocks_range of the rpc config."
+        },
+        "allow_drop": {
+          "type": "boolean",
+          "format": "boolean",
+          "description": "If true, events are dropped when the subscriber is too slow. Otherwise, the subscription is closed."
+        },
+        "from_seq": {
+          "type": "string",
+          "format": "uint64",
+          "description": "If set, stored events from the sequence number are streamed before new events. Event store should be enabled.\nThe stored events from the sequence number to the latest are limited to max_replay_events of the rpc config."
         }
       }
     },
@@ -660,6 +1743,105 @@ const (
         },
         "hash": {
           "type": "string"
+        },
+        "block": {
+          "$ref": "#/definitions/rpcpbGetBlockResponse",
+          "description": "Block of the event. It is set if include_payload is true."
+        },
+        "transaction": {
+          "$ref": "#/definitions/rpcpbGetTransactionResponse",
+          "description": "Transaction of the event. It is set if include_payload is true."
+        },
+        "receipt": {
+          "$ref": "#/definitions/rpcpbTransactionReceipt",
+          "description": "Receipt of the executed transaction. It is set if include_payload is true."
+        },
+        "dropped": {
+          "type": "string",
+          "format": "uint64",
+          "description": "Number of events dropped since the last response."
+        },
+        "replayed": {
+          "type": "boolean",
+          "format": "boolean",
+          "description": "If the event is streamed from the past blocks by from_height, it returns true."
+        },
+        "seq": {
+          "type": "string",
+          "format": "uint64",
+          "description": "Sequence number of the event in the event store. It is 0 if the event is not stored."
+        }
+      }
+    },
+    "rpcpbTransactionReceipt": {
+      "type": "object",
+      "properties": {
+        "hash": {
+          "type": "string",
+          "description": "Transaction hash."
+        },
+        "block_hash": {
+          "type": "string",
+          "description": "Hash of the block including the transaction."
+        },
+        "block_height": {
+          "type": "string",
+          "format": "uint64",
+          "description": "Height of the block including the transaction."
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "int64",
+          "description": "Timestamp of the block including the transaction."
+        }
+      }
+    },
+    "rpcpbVerifyRecordProofRequest": {
+      "type": "object",
+      "properties": {
+        "owner": {
+          "type": "string",
+          "description": "Hex string of the record owner's address."
+        },
+        "merkle_root": {
+          "type": "string",
+          "description": "Hex string of the anchored merkle root."
+        },
+        "record_hash": {
+          "type": "string",
+          "description": "Hex string of the record hash to verify."
+        },
+        "index": {
+          "type": "string",
+          "format": "uint64",
+          "description": "Leaf index of the record hash in the batch."
+        },
+        "path": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "description": "Hex strings of sibling hashes from the leaf up to the root."
+        }
+      }
+    },
+    "rpcpbVerifyRecordProofResponse": {
+      "type": "object",
+      "properties": {
+        "valid": {
+          "type": "boolean",
+          "format": "boolean",
+          "description": "If record hash is included in the anchored batch, it returns true. otherwise, false."
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "int64",
+          "description": "Timestamp of the anchoring transaction."
+        },
+        "record_count": {
+          "type": "integer",
+          "format": "int64",
+          "description": "Number of record hashes in the anchored batch."
         }
       }
     }
@@ -16,37 +16,115 @@
 package rpc
 
 import (
+	"errors"
 	"net"
+	"net/http"
 
 	"github.com/medibloc/go-medibloc/core"
 	"github.com/medibloc/go-medibloc/medlet/pb"
+	mednet "github.com/medibloc/go-medibloc/net"
 	"github.com/medibloc/go-medibloc/rpc/pb"
+	"github.com/medibloc/go-medibloc/storage"
 	"github.com/medibloc/go-medibloc/util/logging"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Errors of rpc server
+var (
+	ErrRPCListenRequired  = errors.New("rpc listen address is required")
+	ErrHTTPListenRequired = errors.New("http listen address is required")
 )
 
 // Server is rpc server.
 type Server struct {
+	cfg   *medletpb.RPCConfig
+	auth  *authorizer
+	limit *limiter
+	conns *connLimiter
+	api   *APIService
+
 	addrGrpc  string
 	addrHTTP  string
 	rpcServer *grpc.Server
+
+	addrAdminGrpc string
+	addrAdminHTTP string
+	adminServer   *grpc.Server
 }
 
 // New returns NewServer.
-func New(cfg *medletpb.Config) *Server {
-	rpc := grpc.NewServer()
-	return &Server{
+func New(cfg *medletpb.Config) (*Server, error) {
+	if len(cfg.Rpc.RpcListen) == 0 {
+		return nil, ErrRPCListenRequired
+	}
+	if len(cfg.Rpc.HttpListen) == 0 {
+		return nil, ErrHTTPListenRequired
+	}
+	if errs := APIKeyErrors(cfg.Rpc); len(errs) > 0 {
+		return nil, errs[0]
+	}
+	auth := newAuthorizer(cfg.Rpc)
+	limit := newLimiter(cfg.Rpc, auth)
+	opts, err := serverOptions(cfg.Rpc, auth, limit)
+	if err != nil {
+		return nil, err
+	}
+	rpc := grpc.NewServer(opts...)
+	s := &Server{
+		cfg:       cfg.Rpc,
+		auth:      auth,
+		limit:     limit,
+		conns:     newConnLimiter(cfg.Rpc.ConnectionLimits),
 		rpcServer: rpc,
 		addrGrpc:  cfg.Rpc.RpcListen[0],
 		addrHTTP:  cfg.Rpc.HttpListen[0],
 	}
+	if len(cfg.Rpc.AdminRpcListen) > 0 {
+		s.adminServer = grpc.NewServer(opts...)
+		s.addrAdminGrpc = cfg.Rpc.AdminRpcListen[0]
+		if len(cfg.Rpc.AdminHttpListen) > 0 {
+			s.addrAdminHTTP = cfg.Rpc.AdminHttpListen[0]
+		}
+	}
+	return s, nil
+}
+
+func serverOptions(cfg *medletpb.RPCConfig, auth *authorizer, limit *limiter) ([]grpc.ServerOption, error) {
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(chainUnaryInterceptors(limit.unaryInterceptor, auth.unaryInterceptor)),
+		grpc.StreamInterceptor(chainStreamInterceptors(limit.streamInterceptor, auth.streamInterceptor)),
+	}
+
+	tlsConfig, err := serverTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	return opts, nil
 }
 
 //Setup sets up server.
 func (s *Server) Setup(bm *core.BlockManager, tm *core.TransactionManager, ee *core.EventEmitter) {
-	api := newAPIService(bm, tm, ee)
-	rpcpb.RegisterApiServiceServer(s.rpcServer, api)
+	s.api = newAPIService(bm, tm, ee, s.cfg)
+	rpcpb.RegisterApiServiceServer(s.rpcServer, s.api)
+}
+
+// SetupHealth sets up health check. It must be called after Setup.
+func (s *Server) SetupHealth(ns mednet.Service, syncer Syncer, producer Producer, stor storage.Storage) {
+	s.api.health = newHealthChecker(s.api.bm, ns, syncer, producer, stor, s.cfg.Health)
+}
+
+// SetupAdmin sets up admin server. It does nothing if admin rpc is not configured.
+func (s *Server) SetupAdmin(bm *core.BlockManager, ns mednet.Service, miner Miner, syncer Syncer, version string) {
+	if s.adminServer == nil {
+		return
+	}
+	admin := newAdminService(bm, ns, miner, syncer, version)
+	rpcpb.RegisterAdminServiceServer(s.adminServer, admin)
 }
 
 // Start starts rpc server.
@@ -55,6 +133,7 @@ func (s *Server) Start() error {
 	if err != nil {
 		return err
 	}
+	lis = s.conns.grpcListener(lis)
 	go func() {
 		if err := s.rpcServer.Serve(lis); err != nil {
 			logging.Console().Error(err)
@@ -62,18 +141,69 @@ func (s *Server) Start() error {
 	}()
 	logging.Console().Info("GRPC Server is running...")
 
-	s.RunGateway()
+	if err := s.RunGateway(); err != nil {
+		return err
+	}
+
+	if s.adminServer == nil {
+		return nil
+	}
+	return s.startAdmin()
+}
+
+func (s *Server) startAdmin() error {
+	lis, err := net.Listen("tcp", s.addrAdminGrpc)
+	if err != nil {
+		return err
+	}
+	lis = s.conns.grpcListener(lis)
+	go func() {
+		if err := s.adminServer.Serve(lis); err != nil {
+			logging.Console().Error(err)
+		}
+	}()
+	logging.Console().Info("Admin GRPC Server is running...")
+
+	if s.addrAdminHTTP == "" {
+		return nil
+	}
+	httpServer, err := NewAdminHTTPServer(s.addrAdminHTTP, s.addrAdminGrpc, s.cfg, nil)
+	if err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to create Admin GRPC HTTP Gateway.")
+		return err
+	}
+	httpServer.conns = s.conns
+	httpServer.limit = s.limit
+	go func() {
+		if err := httpServer.Run(); err != nil {
+			logging.Console().WithFields(logrus.Fields{
+				"err": err,
+			}).Error("Failed to run Admin GRPC HTTP Gateway.")
+		}
+	}()
+	logging.Console().Info("Admin GRPC HTTP Gateway is running...")
 	return nil
 }
 
 // RunGateway runs rest gateway server.
 func (s *Server) RunGateway() error {
-	httpServer, err := NewHTTPServer(s.addrHTTP, s.addrGrpc)
+	routes := map[string]http.Handler{
+		WebSocketPath: newWebSocketHandler(s.api, s.auth, s.limit, s.cfg.CorsAllowedOrigins),
+		JSONRPCPath:   newJSONRPCHandler(s.api, s.auth, s.limit, s.cfg.CorsAllowedOrigins),
+		LivenessPath:  newHealthHandler(s.api, false),
+		ReadinessPath: newHealthHandler(s.api, true),
+	}
+	httpServer, err := NewHTTPServer(s.addrHTTP, s.addrGrpc, s.cfg, routes)
 	if err != nil {
 		logging.Console().WithFields(logrus.Fields{
 			"err": err,
 		}).Error("Failed to create GRPC HTTP Gateway.")
+		return err
 	}
+	httpServer.conns = s.conns
+	httpServer.limit = s.limit
 	go func() {
 		err = httpServer.Run()
 		if err != nil {
@@ -89,4 +219,7 @@ func (s *Server) RunGateway() error {
 // Stop stops server.
 func (s *Server) Stop() {
 	s.rpcServer.Stop()
+	if s.adminServer != nil {
+		s.adminServer.Stop()
+	}
 }
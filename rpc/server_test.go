@@ -0,0 +1,31 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/medibloc/go-medibloc/medlet/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewListenRequired(t *testing.T) {
+	_, err := New(&medletpb.Config{Rpc: &medletpb.RPCConfig{HttpListen: []string{"127.0.0.1:9921"}}})
+	assert.Equal(t, ErrRPCListenRequired, err)
+
+	_, err = New(&medletpb.Config{Rpc: &medletpb.RPCConfig{RpcListen: []string{"127.0.0.1:9920"}}})
+	assert.Equal(t, ErrHTTPListenRequired, err)
+}
@@ -0,0 +1,281 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package rpc
+
+import (
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/rpc/pb"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Subscription parameters
+const (
+	SubscribeBufferSize  = 1024
+	SubscribeSendTimeout = 10 * time.Second
+)
+
+// eventFilter filters transaction events. Block events are not filtered.
+type eventFilter struct {
+	addresses    map[common.Address]bool
+	txTypes      map[string]bool
+	recordHashes map[string]bool
+}
+
+func newEventFilter(req *rpcpb.SubscribeRequest) (*eventFilter, error) {
+	f := &eventFilter{
+		addresses:    make(map[common.Address]bool),
+		txTypes:      make(map[string]bool),
+		recordHashes: make(map[string]bool),
+	}
+	for _, addr := range req.Addresses {
+		if !common.IsHexAddress(addr) {
+			return nil, status.Error(codes.InvalidArgument, ErrMsgInvalidRequest)
+		}
+		f.addresses[common.HexToAddress(addr)] = true
+	}
+	for _, txType := range req.TxTypes {
+		f.txTypes[txType] = true
+	}
+	for _, h := range req.RecordHashes {
+		b, err := hex.DecodeString(strings.TrimPrefix(h, "0x"))
+		if err != nil || len(b) == 0 {
+			return nil, status.Error(codes.InvalidArgument, ErrMsgInvalidRequest)
+		}
+		f.recordHashes[byteutils.Bytes2Hex(b)] = true
+	}
+	return f, nil
+}
+
+func (f *eventFilter) matchEvent(event *core.Event) bool {
+	if event.Transaction == nil {
+		return true
+	}
+	return f.matchTx(event.Transaction)
+}
+
+func (f *eventFilter) matchTx(tx *core.Transaction) bool {
+	if len(f.txTypes) > 0 && !f.txTypes[tx.TxType()] {
+		return false
+	}
+	if len(f.addresses) > 0 && !f.matchAddress(tx) {
+		return false
+	}
+	if len(f.recordHashes) > 0 && !f.matchRecordHash(tx) {
+		return false
+	}
+	return true
+}
+
+func (f *eventFilter) matchAddress(tx *core.Transaction) bool {
+	if f.addresses[tx.From()] || f.addresses[tx.To()] {
+		return true
+	}
+	if tx.TxType() != core.TxOpBatchTransfer {
+		return false
+	}
+	payload := new(core.BatchTransferPayload)
+	if err := payload.FromBytes(tx.Payload()); err != nil {
+		return false
+	}
+	for _, output := range payload.Outputs {
+		if f.addresses[output.To] {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *eventFilter) matchRecordHash(tx *core.Transaction) bool {
+	for _, h := range txRecordHashes(tx) {
+		if f.recordHashes[byteutils.Bytes2Hex(h)] {
+			return true
+		}
+	}
+	return false
+}
+
+// txRecordHashes returns hashes of records added or amended by the transaction.
+func txRecordHashes(tx *core.Transaction) [][]byte {
+	switch tx.TxType() {
+	case core.TxOpAddRecord:
+		payload := new(core.AddRecordPayload)
+		if err := payload.FromBytes(tx.Payload()); err != nil {
+			return nil
+		}
+		return [][]byte{payload.RecordHash}
+	case core.TxOpAmendRecord:
+		payload := new(core.AmendRecordPayload)
+		if err := payload.FromBytes(tx.Payload()); err != nil {
+			return nil
+		}
+		return [][]byte{payload.PrevRecordHash, payload.RecordHash}
+	case core.TxOpAddRecordBatch:
+		payload := new(core.AddRecordBatchPayload)
+		if err := payload.FromBytes(tx.Payload()); err != nil {
+			return nil
+		}
+		return [][]byte{payload.MerkleRoot}
+	}
+	return nil
+}
+
+// coreEvent2rpcEvent converts event to subscribe response. Payloads are included if includePayload is true.
+func coreEvent2rpcEvent(event *core.Event, includePayload bool) (*rpcpb.SubscribeResponse, error) {
+	resp := &rpcpb.SubscribeResponse{
+		Topic: event.Topic,
+		Hash:  event.Data,
+		Seq:   event.Seq,
+	}
+	if !includePayload {
+		return resp, nil
+	}
+
+	if event.Transaction == nil && event.Block != nil {
+		block, err := coreBlock2rpcBlock(event.Block)
+		if err != nil {
+			return nil, status.Error(codes.Internal, ErrMsgConvertBlockFailed)
+		}
+		resp.Block = block
+	}
+	if event.Transaction != nil {
+		executed := event.Topic == core.TopicTransactionExecutionResult
+		tx, err := coreTx2rpcTx(event.Transaction, executed)
+		if err != nil {
+			return nil, status.Error(codes.Internal, ErrMsgConvertTxResponseFailed)
+		}
+		resp.Transaction = tx
+		if executed && event.Block != nil {
+			resp.Receipt = &rpcpb.TransactionReceipt{
+				Hash:        tx.Hash,
+				BlockHash:   byteutils.Bytes2Hex(event.Block.Hash()),
+				BlockHeight: event.Block.Height(),
+				Timestamp:   event.Block.Timestamp(),
+			}
+		}
+	}
+	return resp, nil
+}
+
+// replayEvents returns events of the block as if it is newly added to the chain.
+func replayEvents(block *core.Block, topics map[string]bool) []*core.Event {
+	var events []*core.Event
+	if topics[core.TopicTransactionExecutionResult] {
+		for _, tx := range block.Transactions() {
+			events = append(events, &core.Event{
+				Topic:       core.TopicTransactionExecutionResult,
+				Data:        byteutils.Bytes2Hex(tx.Hash()),
+				Block:       block,
+				Transaction: tx,
+			})
+		}
+	}
+	if topics[core.TopicNewTailBlock] {
+		events = append(events, &core.Event{
+			Topic: core.TopicNewTailBlock,
+			Data:  byteutils.Bytes2Hex(block.Hash()),
+			Block: block,
+		})
+	}
+	return events
+}
+
+// subscriptionWriter owns Send of a subscribe stream. Responses are queued by the handler and sent by a
+// single goroutine, so Send is never called concurrently or after the handler returns.
+type subscriptionWriter struct {
+	stream rpcpb.ApiService_SubscribeServer
+	ctx    context.Context
+	cancel context.CancelFunc
+	queue  chan *rpcpb.SubscribeResponse
+	doneCh chan struct{}
+	err    error
+}
+
+func newSubscriptionWriter(stream rpcpb.ApiService_SubscribeServer) *subscriptionWriter {
+	ctx, cancel := context.WithCancel(stream.Context())
+	w := &subscriptionWriter{
+		stream: stream,
+		ctx:    ctx,
+		cancel: cancel,
+		queue:  make(chan *rpcpb.SubscribeResponse, SubscribeBufferSize),
+		doneCh: make(chan struct{}),
+	}
+	go w.loop()
+	return w
+}
+
+func (w *subscriptionWriter) loop() {
+	defer close(w.doneCh)
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case resp := <-w.queue:
+			if w.ctx.Err() != nil {
+				return
+			}
+			if err := w.stream.Send(resp); err != nil {
+				w.err = err
+				w.cancel()
+				return
+			}
+		}
+	}
+}
+
+// send queues the response. It returns error if the writer has stopped, or the queue is not drained in time.
+func (w *subscriptionWriter) send(resp *rpcpb.SubscribeResponse) error {
+	if err := w.stopped(); err != nil {
+		return err
+	}
+	timer := time.NewTimer(SubscribeSendTimeout)
+	defer timer.Stop()
+	select {
+	case w.queue <- resp:
+		return nil
+	case <-w.doneCh:
+		return w.stopped()
+	case <-timer.C:
+		return status.Error(codes.ResourceExhausted, ErrMsgSubscriberTooSlow)
+	}
+}
+
+func (w *subscriptionWriter) stopped() error {
+	select {
+	case <-w.doneCh:
+		if w.err != nil {
+			return w.err
+		}
+		return w.ctx.Err()
+	default:
+		return nil
+	}
+}
+
+// close cancels the stream context and waits for the writer to exit. A Send in progress returns when the
+// subscriber receives it or the transport is closed. It returns the error of Send if it failed.
+func (w *subscriptionWriter) close() error {
+	w.cancel()
+	<-w.doneCh
+	return w.err
+}
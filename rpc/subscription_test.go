@@ -0,0 +1,128 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package rpc
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/medibloc/go-medibloc/rpc/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+type blockingStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	started chan struct{}
+	release chan struct{}
+	err     error
+
+	mu      sync.Mutex
+	sent    []uint64
+	sending int
+	overlap bool
+}
+
+func (s *blockingStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *blockingStream) Send(resp *rpcpb.SubscribeResponse) error {
+	s.mu.Lock()
+	s.sending++
+	if s.sending > 1 {
+		s.overlap = true
+	}
+	s.mu.Unlock()
+
+	if s.started != nil {
+		s.started <- struct{}{}
+	}
+	<-s.release
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sending--
+	s.sent = append(s.sent, resp.Seq)
+	return s.err
+}
+
+func (s *blockingStream) sentSeqs() []uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]uint64{}, s.sent...)
+}
+
+func TestSubscriptionWriter(t *testing.T) {
+	stream := &blockingStream{ctx: context.Background(), release: make(chan struct{})}
+	close(stream.release)
+	w := newSubscriptionWriter(stream)
+	for seq := uint64(1); seq <= 3; seq++ {
+		require.NoError(t, w.send(&rpcpb.SubscribeResponse{Seq: seq}))
+	}
+	for i := 0; i < 100 && len(stream.sentSeqs()) < 3; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.NoError(t, w.close())
+	assert.Equal(t, []uint64{1, 2, 3}, stream.sentSeqs())
+	assert.False(t, stream.overlap)
+}
+
+func TestSubscriptionWriterCloseWaitsSend(t *testing.T) {
+	stream := &blockingStream{
+		ctx:     context.Background(),
+		started: make(chan struct{}, 1),
+		release: make(chan struct{}),
+	}
+	w := newSubscriptionWriter(stream)
+	require.NoError(t, w.send(&rpcpb.SubscribeResponse{Seq: 1}))
+	<-stream.started
+	require.NoError(t, w.send(&rpcpb.SubscribeResponse{Seq: 2}))
+
+	closed := make(chan struct{})
+	go func() {
+		w.close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+		t.Fatal("close returned while Send is in progress")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(stream.release)
+	<-closed
+	// queued responses are not sent after the stream context is canceled.
+	assert.Equal(t, []uint64{1}, stream.sentSeqs())
+	assert.Equal(t, context.Canceled, w.send(&rpcpb.SubscribeResponse{Seq: 3}))
+}
+
+func TestSubscriptionWriterSendError(t *testing.T) {
+	sendErr := errors.New("send failed")
+	stream := &blockingStream{ctx: context.Background(), release: make(chan struct{}), err: sendErr}
+	close(stream.release)
+	w := newSubscriptionWriter(stream)
+	require.NoError(t, w.send(&rpcpb.SubscribeResponse{Seq: 1}))
+
+	<-w.doneCh
+	assert.Equal(t, sendErr, w.send(&rpcpb.SubscribeResponse{Seq: 2}))
+	assert.Equal(t, sendErr, w.close())
+}
@@ -33,7 +33,10 @@ var (
 func main() {
 	flag.Parse()
 	addr := "localhost:9920"
-	conn := rpc.Dial(addr)
+	conn, err := rpc.Dial(addr)
+	if err != nil {
+		log.Fatal(err)
+	}
 	defer conn.Close()
 
 	apiClient := rpcpb.NewApiServiceClient(conn)
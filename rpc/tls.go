@@ -0,0 +1,116 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package rpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+	"strings"
+
+	"github.com/medibloc/go-medibloc/medlet/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Errors
+var (
+	ErrTLSKeyRequired       = errors.New("tls key file is required with tls cert file")
+	ErrTLSCertRequired      = errors.New("tls cert file is required with tls client ca file")
+	ErrInvalidCACertificate = errors.New("failed to parse ca certificate")
+)
+
+func loadCertPool(file string) (*x509.CertPool, error) {
+	pem, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, ErrInvalidCACertificate
+	}
+	return pool, nil
+}
+
+// serverTLSConfig returns tls config of rpc servers. It returns nil if tls is not configured.
+func serverTLSConfig(cfg *medletpb.RPCConfig) (*tls.Config, error) {
+	if cfg.TlsCertFile == "" {
+		if cfg.TlsClientCaFile != "" {
+			return nil, ErrTLSCertRequired
+		}
+		return nil, nil
+	}
+	if cfg.TlsKeyFile == "" {
+		return nil, ErrTLSKeyRequired
+	}
+
+	cert, err := tls.LoadX509KeyPair(cfg.TlsCertFile, cfg.TlsKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if cfg.TlsClientCaFile != "" {
+		pool, err := loadCertPool(cfg.TlsClientCaFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tlsConfig, nil
+}
+
+// gatewayDialOption returns dial option for the gateway to connect to the local grpc server.
+// The server certificate is trusted as a root, and is also presented as the client certificate
+// if client certificates are required.
+func gatewayDialOption(cfg *medletpb.RPCConfig) (grpc.DialOption, error) {
+	if cfg.TlsCertFile == "" {
+		return grpc.WithInsecure(), nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(cfg.TlsCertFile, cfg.TlsKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return nil, err
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	pool.AddCert(leaf)
+
+	tlsConfig := &tls.Config{
+		RootCAs:    pool,
+		MinVersion: tls.VersionTLS12,
+	}
+	for _, name := range leaf.DNSNames {
+		if !strings.HasPrefix(name, "*") {
+			tlsConfig.ServerName = name
+			break
+		}
+	}
+	if cfg.TlsClientCaFile != "" {
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), nil
+}
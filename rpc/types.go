@@ -25,6 +25,53 @@ const (
 	TAIL = "tail"
 )
 
+// Certification status
+const (
+	CertStatusValid       = "valid"
+	CertStatusNotYetValid = "not_yet_valid"
+	CertStatusExpired     = "expired"
+	CertStatusRevoked     = "revoked"
+)
+
+// Certification direction
+const (
+	CertReceived = "received"
+	CertIssued   = "issued"
+)
+
+// Pagination limits of list APIs
+const (
+	DefaultListLimit = 100
+	MaxListLimit     = 1000
+)
+
+// Default range limits of APIService
+const (
+	DefaultMaxBlocksRange         = 1000
+	DefaultMaxAccountTransactions = 1000
+	DefaultMaxReplayEvents        = 10000
+)
+
+// Default thresholds of health check
+const (
+	DefaultHealthMaxTailDelay   = 10
+	DefaultHealthMaxLIBLag      = 100
+	DefaultHealthMaxMissedSlots = 2
+	HealthRecentSlots           = 5
+
+	healthCheckKey = "rpc_health_check"
+)
+
+// Failed checks of health check
+const (
+	HealthStorageNotWritable = "storage is not writable"
+	HealthSyncing            = "node is syncing"
+	HealthTailTooOld         = "tail block is too old"
+	HealthLIBLagTooLarge     = "lib lag is too large"
+	HealthNotEnoughPeers     = "not enough peers"
+	HealthMissingSlots       = "miner is missing its slots"
+)
+
 // Error response strings of APIService
 const (
 	ErrMsgBlockNotFound              = "block not found"
@@ -43,8 +90,28 @@ const (
 	ErrMsgInvalidTxValue             = "invalid transaction value"
 	ErrMsgInvalidTxDataPayload       = "invalid transaction data payload"
 	ErrMsgTransactionNotFound        = "transaction not found"
+	ErrMsgRecordNotFound             = "record not found"
+	ErrMsgIssuerNotFound             = "issuer not found"
+	ErrMsgCertificationNotFound      = "certification not found"
 	ErrMsgUnmarshalTransactionFailed = "cannot unmarshal transaction"
 	ErrMsgInvalidRequest             = "invalid request"
 	ErrMsgFailedToUpdateBandwidth    = "failed to update bandwidth"
 	ErrMsgFailedToUpdateUnstaking    = "failed to update Unstaking"
+	ErrMsgAPIKeyRequired             = "api key required"
+	ErrMsgInvalidAPIKey              = "invalid api key"
+	ErrMsgPermissionDenied           = "permission denied"
+	ErrMsgTooManyConnections         = "too many connections"
+	ErrMsgRateLimitExceeded          = "rate limit exceeded"
+	ErrMsgRangeTooLarge              = "requested range is too large"
+	ErrMsgSubscriberTooSlow          = "subscriber is too slow to receive events"
+	ErrMsgTooManySubscriptions       = "too many subscriptions"
+	ErrMsgSubscriptionNotFound       = "subscription not found"
+	ErrMsgUnknownMethod              = "unknown method"
+	ErrMsgParseError                 = "parse error"
+	ErrMsgInvalidParams              = "invalid params"
+	ErrMsgRequestTooLarge            = "request is too large"
+	ErrMsgBatchTooLarge              = "too many requests in batch"
+	ErrMsgWebSocketRequired          = "method is available only over websocket"
+	ErrMsgEventStoreDisabled         = "event store is disabled"
+	ErrMsgEventPruned                = "requested events are pruned"
 )
@@ -0,0 +1,358 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package rpc
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/medibloc/go-medibloc/rpc/pb"
+	"github.com/medibloc/go-medibloc/util/logging"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// WebSocket parameters
+const (
+	WebSocketPath             = "/v1/ws"
+	WebSocketMaxSubscriptions = 32
+	WebSocketMaxMessageSize   = 64 * 1024
+	WebSocketPingInterval     = 30 * time.Second
+	WebSocketPongTimeout      = 60 * time.Second
+	WebSocketWriteTimeout     = 10 * time.Second
+)
+
+// WebSocket methods
+const (
+	WebSocketSubscribe   = "subscribe"
+	WebSocketUnsubscribe = "unsubscribe"
+	WebSocketPing        = "ping"
+)
+
+const (
+	subscribeMethod = "/rpcpb.ApiService/Subscribe"
+	apiKeyQuery     = "api_key"
+)
+
+// wsRequest is a message from the client.
+type wsRequest struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+// wsUnsubscribeParams is params of unsubscribe request.
+type wsUnsubscribeParams struct {
+	Subscription string `json:"subscription"`
+}
+
+// wsMessage is a message to the client. It is a response if ID is set, otherwise an event of the subscription.
+type wsMessage struct {
+	ID           json.RawMessage `json:"id,omitempty"`
+	Subscription string          `json:"subscription,omitempty"`
+	Result       interface{}     `json:"result,omitempty"`
+	Event        json.RawMessage `json:"event,omitempty"`
+	Error        string          `json:"error,omitempty"`
+}
+
+// wsMessageHandler handles a message read from the websocket connection.
+type wsMessageHandler func(ctx context.Context, c *wsConn, data []byte)
+
+type wsHandler struct {
+	api       *APIService
+	auth      *authorizer
+	limit     *limiter
+	upgrader  *websocket.Upgrader
+	onMessage wsMessageHandler
+}
+
+func newWSHandler(api *APIService, auth *authorizer, limit *limiter, origins []string, onMessage wsMessageHandler) *wsHandler {
+	allowed := make(map[string]bool)
+	for _, origin := range origins {
+		allowed[origin] = true
+	}
+	return &wsHandler{
+		api:   api,
+		auth:  auth,
+		limit: limit,
+		upgrader: &websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				origin := r.Header.Get("Origin")
+				return origin == "" || allowed["*"] || allowed[origin] || sameOrigin(origin, r.Host)
+			},
+		},
+		onMessage: onMessage,
+	}
+}
+
+func sameOrigin(origin string, host string) bool {
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(u.Host, host)
+}
+
+func newWebSocketHandler(api *APIService, auth *authorizer, limit *limiter, origins []string) http.Handler {
+	return newWSHandler(api, auth, limit, origins, handleWSMessage)
+}
+
+// requestContext converts http request to grpc context for authorization and rate limit.
+func requestContext(r *http.Request) context.Context {
+	ctx := r.Context()
+	if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: addr})
+	}
+
+	md := metadata.MD{}
+	if v := r.Header.Get("Authorization"); v != "" {
+		md.Set(authorizationHeader, v)
+	}
+	if v := r.Header.Get("X-Api-Key"); v != "" {
+		md.Set(apiKeyHeader, v)
+	} else if v := r.URL.Query().Get(apiKeyQuery); v != "" {
+		md.Set(apiKeyHeader, v)
+	}
+	if v := r.Header.Get("X-Forwarded-For"); v != "" {
+		md.Set(forwardedForHeader, v)
+	}
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+func (h *wsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := requestContext(r)
+	if err := h.authorize(ctx); err != nil {
+		s, _ := status.FromError(err)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(runtime.HTTPStatusFromCode(s.Code()))
+		json.NewEncoder(w).Encode(errorBody{Error: s.Message()})
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		logging.WithFields(logrus.Fields{
+			"err": err,
+		}).Debug("Failed to upgrade websocket connection.")
+		return
+	}
+	newWSConn(conn, h).run(ctx)
+}
+
+func (h *wsHandler) authorize(ctx context.Context) error {
+	if err := h.limit.limitRate(ctx, subscribeMethod); err != nil {
+		return err
+	}
+	return h.auth.authorize(ctx, subscribeMethod)
+}
+
+// wsConn is a websocket connection multiplexing subscriptions.
+type wsConn struct {
+	conn    *websocket.Conn
+	handler *wsHandler
+
+	writeMu sync.Mutex
+
+	mu      sync.Mutex
+	nextID  uint64
+	subs    map[string]context.CancelFunc
+	wg      sync.WaitGroup
+	closeCh chan struct{}
+}
+
+func newWSConn(conn *websocket.Conn, handler *wsHandler) *wsConn {
+	return &wsConn{
+		conn:    conn,
+		handler: handler,
+		subs:    make(map[string]context.CancelFunc),
+		closeCh: make(chan struct{}),
+	}
+}
+
+func (c *wsConn) run(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer func() {
+		cancel()
+		close(c.closeCh)
+		c.wg.Wait()
+		c.conn.Close()
+	}()
+
+	c.conn.SetReadLimit(WebSocketMaxMessageSize)
+	c.conn.SetReadDeadline(time.Now().Add(WebSocketPongTimeout))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(WebSocketPongTimeout))
+	})
+
+	c.wg.Add(1)
+	go c.heartbeat()
+
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			if _, ok := err.(*websocket.CloseError); !ok {
+				logging.WithFields(logrus.Fields{
+					"err": err,
+				}).Debug("Failed to read websocket message.")
+			}
+			return
+		}
+		c.conn.SetReadDeadline(time.Now().Add(WebSocketPongTimeout))
+		c.handler.onMessage(ctx, c, data)
+	}
+}
+
+func (c *wsConn) heartbeat() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(WebSocketPingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.closeCh:
+			return
+		case <-ticker.C:
+			c.writeMu.Lock()
+			err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(WebSocketWriteTimeout))
+			c.writeMu.Unlock()
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+func handleWSMessage(ctx context.Context, c *wsConn, data []byte) {
+	req := new(wsRequest)
+	if err := json.Unmarshal(data, req); err != nil {
+		c.write(&wsMessage{Error: ErrMsgInvalidRequest})
+		return
+	}
+
+	switch req.Method {
+	case WebSocketPing:
+		c.write(&wsMessage{ID: req.ID, Result: "pong"})
+	case WebSocketSubscribe:
+		subReq := new(rpcpb.SubscribeRequest)
+		if len(req.Params) > 0 {
+			if err := (&runtime.JSONPb{OrigName: true}).Unmarshal(req.Params, subReq); err != nil {
+				c.write(&wsMessage{ID: req.ID, Error: ErrMsgInvalidRequest})
+				return
+			}
+		}
+		if err := c.handler.limit.limitRate(ctx, subscribeMethod); err != nil {
+			c.write(&wsMessage{ID: req.ID, Error: ErrMsgRateLimitExceeded})
+			return
+		}
+		ready := make(chan struct{})
+		id, err := c.subscribe(ctx, subReq, ready, func(id string, event json.RawMessage) interface{} {
+			return &wsMessage{Subscription: id, Event: event}
+		}, func(id string, err error) interface{} {
+			return &wsMessage{Subscription: id, Error: statusMessage(err)}
+		})
+		if err != nil {
+			c.write(&wsMessage{ID: req.ID, Error: ErrMsgTooManySubscriptions})
+			return
+		}
+		c.write(&wsMessage{ID: req.ID, Result: map[string]string{"subscription": id}})
+		close(ready)
+	case WebSocketUnsubscribe:
+		params := new(wsUnsubscribeParams)
+		if err := json.Unmarshal(req.Params, params); err != nil || !c.unsubscribe(params.Subscription) {
+			c.write(&wsMessage{ID: req.ID, Error: ErrMsgSubscriptionNotFound})
+			return
+		}
+		c.write(&wsMessage{ID: req.ID, Result: true})
+	default:
+		c.write(&wsMessage{ID: req.ID, Error: ErrMsgUnknownMethod})
+	}
+}
+
+// subscribe starts subscription after ready is closed, so that events are not sent before the response.
+// Events and the error closing the subscription are written as messages built by notify and fail.
+func (c *wsConn) subscribe(ctx context.Context, req *rpcpb.SubscribeRequest, ready <-chan struct{},
+	notify func(id string, event json.RawMessage) interface{}, fail func(id string, err error) interface{}) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.subs) >= WebSocketMaxSubscriptions {
+		return "", status.Error(codes.ResourceExhausted, ErrMsgTooManySubscriptions)
+	}
+	c.nextID++
+	id := strconv.FormatUint(c.nextID, 10)
+	subCtx, cancel := context.WithCancel(ctx)
+	c.subs[id] = cancel
+
+	marshaler := &runtime.JSONPb{OrigName: true, EmitDefaults: true}
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		<-ready
+		err := c.handler.api.subscribe(subCtx, req, func(resp *rpcpb.SubscribeResponse) error {
+			event, err := marshaler.Marshal(resp)
+			if err != nil {
+				return err
+			}
+			return c.write(notify(id, event))
+		})
+		if subCtx.Err() == nil {
+			c.write(fail(id, err))
+		}
+		c.unsubscribe(id)
+	}()
+	return id, nil
+}
+
+func (c *wsConn) unsubscribe(id string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cancel, ok := c.subs[id]
+	if !ok {
+		return false
+	}
+	cancel()
+	delete(c.subs, id)
+	return true
+}
+
+func (c *wsConn) write(msg interface{}) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	c.conn.SetWriteDeadline(time.Now().Add(WebSocketWriteTimeout))
+	return c.conn.WriteJSON(msg)
+}
+
+// statusMessage returns message of grpc status error.
+func statusMessage(err error) string {
+	if s, ok := status.FromError(err); ok {
+		return s.Message()
+	}
+	return err.Error()
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package rpc
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/medlet/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebSocket(t *testing.T) {
+	ee := core.NewEventEmitter(1024)
+	ee.Start()
+	defer ee.Stop()
+
+	cfg := &medletpb.RPCConfig{}
+	auth := newAuthorizer(cfg)
+	api := newAPIService(nil, nil, ee, cfg)
+	server := httptest.NewServer(newWebSocketHandler(api, auth, newLimiter(cfg, auth), nil))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	read := func() map[string]interface{} {
+		msg := make(map[string]interface{})
+		require.NoError(t, conn.ReadJSON(&msg))
+		return msg
+	}
+
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{"id": 1, "method": "ping"}))
+	msg := read()
+	assert.Equal(t, float64(1), msg["id"])
+	assert.Equal(t, "pong", msg["result"])
+
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{
+		"id":     2,
+		"method": "subscribe",
+		"params": map[string]interface{}{"topics": []string{core.TopicNewTailBlock}},
+	}))
+	msg = read()
+	assert.Equal(t, float64(2), msg["id"])
+	subID := msg["result"].(map[string]interface{})["subscription"].(string)
+
+	// wait for the subscription to be registered
+	time.Sleep(100 * time.Millisecond)
+	ee.Trigger(&core.Event{Topic: core.TopicNewTailBlock, Data: "0xabcd"})
+	msg = read()
+	assert.Equal(t, subID, msg["subscription"])
+	event := new(struct {
+		Topic string `json:"topic"`
+		Hash  string `json:"hash"`
+	})
+	b, err := json.Marshal(msg["event"])
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(b, event))
+	assert.Equal(t, core.TopicNewTailBlock, event.Topic)
+	assert.Equal(t, "0xabcd", event.Hash)
+
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{
+		"id":     3,
+		"method": "unsubscribe",
+		"params": map[string]string{"subscription": subID},
+	}))
+	msg = read()
+	assert.Equal(t, float64(3), msg["id"])
+	assert.Equal(t, true, msg["result"])
+
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{
+		"id":     4,
+		"method": "unsubscribe",
+		"params": map[string]string{"subscription": subID},
+	}))
+	msg = read()
+	assert.Equal(t, ErrMsgSubscriptionNotFound, msg["error"])
+
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{"id": 5, "method": "unknown"}))
+	msg = read()
+	assert.Equal(t, ErrMsgUnknownMethod, msg["error"])
+}
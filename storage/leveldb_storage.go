@@ -19,6 +19,7 @@ import (
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/filter"
 	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
 // LeveldbStorage storage which backend is leveldb
@@ -71,6 +72,34 @@ func (storage *LeveldbStorage) Put(key []byte, value []byte) error {
 	return storage.db.Put(key, value, nil)
 }
 
+// Seek returns the first entry whose key has the prefix and is not less than from.
+func (storage *LeveldbStorage) Seek(prefix []byte, from []byte) ([]byte, []byte, error) {
+	iter := storage.db.NewIterator(util.BytesPrefix(prefix), nil)
+	defer iter.Release()
+	if !iter.Seek(from) {
+		if err := iter.Error(); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, ErrKeyNotFound
+	}
+	key := append([]byte{}, iter.Key()...)
+	value := append([]byte{}, iter.Value()...)
+	return key, value, nil
+}
+
+// Write applies the write batch atomically.
+func (storage *LeveldbStorage) Write(batch *WriteBatch) error {
+	b := new(leveldb.Batch)
+	for _, opt := range batch.opts {
+		if opt.deleted {
+			b.Delete(opt.key)
+		} else {
+			b.Put(opt.key, opt.value)
+		}
+	}
+	return storage.db.Write(b, nil)
+}
+
 // EnableBatch enable batch write.
 func (storage *LeveldbStorage) EnableBatch() {
 }
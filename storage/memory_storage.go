@@ -16,6 +16,7 @@
 package storage
 
 import (
+	"bytes"
 	"encoding/hex"
 	"sync"
 )
@@ -64,6 +65,36 @@ func (s *MemoryStorage) Put(key []byte, value []byte) error {
 	return nil
 }
 
+// Seek returns the first entry whose key has the prefix and is not less than from. Every key is visited.
+func (s *MemoryStorage) Seek(prefix []byte, from []byte) ([]byte, []byte, error) {
+	key, value := seekMap(s.data, prefix, from)
+	if key == nil {
+		return nil, nil, ErrKeyNotFound
+	}
+	return key, value, nil
+}
+
+// seekMap returns the first entry of the map of hex encoded keys whose key has the prefix and is not less than
+// from. It returns nil if there is no such entry.
+func seekMap(m *sync.Map, prefix []byte, from []byte) (key []byte, value []byte) {
+	m.Range(func(k, v interface{}) bool {
+		b, err := hex.DecodeString(k.(string))
+		if err != nil || !bytes.HasPrefix(b, prefix) || bytes.Compare(b, from) < 0 {
+			return true
+		}
+		if key == nil || bytes.Compare(b, key) < 0 {
+			key, value = b, v.([]byte)
+		}
+		return true
+	})
+	return key, value
+}
+
+// Write applies writes of the batch in order.
+func (s *MemoryStorage) Write(batch *WriteBatch) error {
+	return writeEach(s, batch)
+}
+
 //Close closes memory storage
 func (s *MemoryStorage) Close() error {
 	return nil
@@ -0,0 +1,123 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package storage
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sync"
+)
+
+// OverlayStorage keeps writes in memory on top of a base storage, so the base storage is never changed. Values are
+// read from memory first and then from the base storage.
+type OverlayStorage struct {
+	base    Storage
+	data    *sync.Map
+	deleted *sync.Map
+}
+
+var _ Storage = &OverlayStorage{}
+
+// NewOverlayStorage returns an overlay storage on the base storage.
+func NewOverlayStorage(base Storage) *OverlayStorage {
+	return &OverlayStorage{
+		base:    base,
+		data:    new(sync.Map),
+		deleted: new(sync.Map),
+	}
+}
+
+// Delete delete the key entry in Storage.
+func (s *OverlayStorage) Delete(key []byte) error {
+	k := hex.EncodeToString(key)
+	s.data.Delete(k)
+	s.deleted.Store(k, true)
+	return nil
+}
+
+// Get return the value to the key in Storage.
+func (s *OverlayStorage) Get(key []byte) ([]byte, error) {
+	k := hex.EncodeToString(key)
+	if entry, ok := s.data.Load(k); ok {
+		return entry.([]byte), nil
+	}
+	if _, ok := s.deleted.Load(k); ok {
+		return nil, ErrKeyNotFound
+	}
+	return s.base.Get(key)
+}
+
+// Put put the key-value entry to Storage. The entry is not kept in memory if the base storage has the same one.
+func (s *OverlayStorage) Put(key []byte, value []byte) error {
+	k := hex.EncodeToString(key)
+	s.deleted.Delete(k)
+	if v, err := s.base.Get(key); err == nil && bytes.Equal(v, value) {
+		s.data.Delete(k)
+		return nil
+	}
+	s.data.Store(k, value)
+	return nil
+}
+
+// Seek returns the first entry whose key has the prefix and is not less than from. Entries in memory take
+// precedence over the base storage.
+func (s *OverlayStorage) Seek(prefix []byte, from []byte) ([]byte, []byte, error) {
+	key, value := seekMap(s.data, prefix, from)
+	for start := from; ; {
+		k, v, err := s.base.Seek(prefix, start)
+		if err == ErrKeyNotFound {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, ok := s.deleted.Load(hex.EncodeToString(k)); ok {
+			start = append(append([]byte{}, k...), 0)
+			continue
+		}
+		if key == nil || bytes.Compare(k, key) < 0 {
+			key, value = k, v
+		}
+		break
+	}
+	if key == nil {
+		return nil, nil, ErrKeyNotFound
+	}
+	return key, value, nil
+}
+
+// Write applies writes of the batch in order. The base storage is not changed.
+func (s *OverlayStorage) Write(batch *WriteBatch) error {
+	return writeEach(s, batch)
+}
+
+// Close closes overlay storage. The base storage is not closed.
+func (s *OverlayStorage) Close() error {
+	return nil
+}
+
+// EnableBatch enable batch write.
+func (s *OverlayStorage) EnableBatch() {
+}
+
+// DisableBatch disable batch write.
+func (s *OverlayStorage) DisableBatch() {
+}
+
+// Flush write and flush pending batch write.
+func (s *OverlayStorage) Flush() error {
+	return nil
+}
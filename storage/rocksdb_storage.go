@@ -16,6 +16,7 @@
 package storage
 
 import (
+	"bytes"
 	"sync"
 
 	"github.com/medibloc/go-medibloc/util/byteutils"
@@ -44,7 +45,15 @@ type RocksStorage struct {
 
 // NewRocksStorage init a storage
 func NewRocksStorage(path string) (*RocksStorage, error) {
+	return newRocksStorage(path, false)
+}
+
+// NewRocksStorageReadOnly opens an existing storage in read-only mode. Writes to the storage return an error.
+func NewRocksStorageReadOnly(path string) (*RocksStorage, error) {
+	return newRocksStorage(path, true)
+}
 
+func newRocksStorage(path string, readOnly bool) (*RocksStorage, error) {
 	filter := gorocksdb.NewBloomFilter(10)
 	bbto := gorocksdb.NewDefaultBlockBasedTableOptions()
 	bbto.SetFilterPolicy(filter)
@@ -58,7 +67,13 @@ func NewRocksStorage(path string) (*RocksStorage, error) {
 	opts.SetWriteBufferSize(64 * opt.MiB) //Default: 4MB
 	opts.IncreaseParallelism(4)           //flush and compaction thread
 
-	db, err := gorocksdb.OpenDb(opts, path)
+	var db *gorocksdb.DB
+	var err error
+	if readOnly {
+		db, err = gorocksdb.OpenDbForReadOnly(opts, path, false)
+	} else {
+		db, err = gorocksdb.OpenDb(opts, path)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -123,6 +138,40 @@ func (storage *RocksStorage) Put(key []byte, value []byte) error {
 	return storage.db.Put(storage.wo, key, value)
 }
 
+// Seek returns the first entry whose key has the prefix and is not less than from.
+func (storage *RocksStorage) Seek(prefix []byte, from []byte) ([]byte, []byte, error) {
+	iter := storage.db.NewIterator(storage.ro)
+	defer iter.Close()
+	if bytes.Compare(from, prefix) < 0 {
+		from = prefix
+	}
+	iter.Seek(from)
+	if !iter.ValidForPrefix(prefix) {
+		if err := iter.Err(); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, ErrKeyNotFound
+	}
+	k, v := iter.Key(), iter.Value()
+	defer k.Free()
+	defer v.Free()
+	return append([]byte{}, k.Data()...), append([]byte{}, v.Data()...), nil
+}
+
+// Write applies the write batch atomically. It is not affected by EnableBatch.
+func (storage *RocksStorage) Write(batch *WriteBatch) error {
+	wb := gorocksdb.NewWriteBatch()
+	defer wb.Destroy()
+	for _, opt := range batch.opts {
+		if opt.deleted {
+			wb.Delete(opt.key)
+		} else {
+			wb.Put(opt.key, opt.value)
+		}
+	}
+	return storage.db.Write(storage.wo, wb)
+}
+
 // Delete delete the key in Storage.
 func (storage *RocksStorage) Delete(key []byte) error {
 	if storage.enableBatch {
@@ -32,6 +32,13 @@ type Storage interface {
 	// Put put the key-value entry to Storage.
 	Put(key []byte, value []byte) error
 
+	// Write applies the write batch atomically.
+	Write(batch *WriteBatch) error
+
+	// Seek returns the first entry whose key has the prefix and is not less than from. It returns ErrKeyNotFound
+	// if there is no such entry.
+	Seek(prefix []byte, from []byte) (key []byte, value []byte, err error)
+
 	// EnableBatch enables batch write.
 	EnableBatch()
 
@@ -0,0 +1,58 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package storage
+
+// WriteBatch is a list of writes which is applied to a storage at once by Storage.Write. Writes are applied in
+// the order they are added.
+type WriteBatch struct {
+	opts []*batchOpt
+}
+
+// NewWriteBatch returns an empty write batch.
+func NewWriteBatch() *WriteBatch {
+	return &WriteBatch{}
+}
+
+// Put adds the key-value entry to the batch.
+func (b *WriteBatch) Put(key []byte, value []byte) {
+	b.opts = append(b.opts, &batchOpt{key: key, value: value})
+}
+
+// Delete adds deletion of the key to the batch.
+func (b *WriteBatch) Delete(key []byte) {
+	b.opts = append(b.opts, &batchOpt{key: key, deleted: true})
+}
+
+// Len returns the number of writes in the batch.
+func (b *WriteBatch) Len() int {
+	return len(b.opts)
+}
+
+// writeEach applies writes of the batch one by one to storages which have no atomic batch.
+func writeEach(s Storage, b *WriteBatch) error {
+	for _, opt := range b.opts {
+		var err error
+		if opt.deleted {
+			err = s.Delete(opt.key)
+		} else {
+			err = s.Put(opt.key, opt.value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -119,6 +119,11 @@ func (d *download) start(targetHeight uint64) {
 	d.activated = true
 	d.mu.Unlock()
 
+	metricsDownloadActive.Update(1)
+	metricsDownloadFrom.Update(int64(d.from))
+	metricsDownloadTarget.Update(int64(d.to))
+	metricsDownloadHeight.Update(int64(d.from))
+
 	go d.subscribeLoop()
 }
 
@@ -406,6 +411,7 @@ func (d *download) pushBlockDataChunk() error {
 			if err != nil {
 				return err
 			}
+			metricsDownloadPushedBlocks.Mark(1)
 		}
 
 		if err := d.bm.ForceLIB(d.bm.TailBlock()); err != nil {
@@ -415,6 +421,8 @@ func (d *download) pushBlockDataChunk() error {
 			return err
 		}
 
+		metricsDownloadHeight.Update(int64(d.bm.TailBlock().Height()))
+
 		logging.Console().WithFields(logrus.Fields{
 			"taskFrom": task.from,
 		}).Infof("Pushing blockChunk from %d is completed!!", task.from)
@@ -446,6 +454,8 @@ func (d *download) deregisterSubscriber() {
 	d.mu.Unlock()
 	d.flush()
 
+	metricsDownloadActive.Update(0)
+
 	d.netService.Deregister(net.NewSubscriber(d, d.messageCh, false, net.SyncMeta, net.MessageWeightZero))
 	d.netService.Deregister(net.NewSubscriber(d, d.messageCh, false, net.SyncBlockChunk, net.MessageWeightZero))
 }
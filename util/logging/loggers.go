@@ -16,6 +16,7 @@
 package logging
 
 import (
+	"errors"
 	"os"
 	"sync"
 
@@ -31,6 +32,9 @@ func (ew emptyWriter) Write(p []byte) (int, error) {
 	return 0, nil
 }
 
+// ErrLoggerNotInitialized is returned when the log level is changed before Init.
+var ErrLoggerNotInitialized = errors.New("logger is not initialized")
+
 var (
 	mu   sync.RWMutex
 	clog *logrus.Logger
@@ -106,6 +110,23 @@ func initLogger(path string, level string, age uint32) {
 	}).Info("Logger Configuration.")
 }
 
+// SetLevel changes log level of loggers at runtime.
+func SetLevel(level string) error {
+	levelNo, err := logrus.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if clog == nil || vlog == nil {
+		return ErrLoggerNotInitialized
+	}
+	clog.SetLevel(levelNo)
+	vlog.SetLevel(levelNo)
+	return nil
+}
+
 // SetNullLogger sets null logger.
 func SetNullLogger() {
 	mu.Lock()
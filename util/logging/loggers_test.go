@@ -47,3 +47,13 @@ func TestHook(t *testing.T) {
 	assert.Equal(t, 1, len(hook.Entries))
 	assert.Regexp(t, ".*Test Hook.*", hook.LastEntry().Message)
 }
+
+func TestSetLevel(t *testing.T) {
+	hook := log.SetTestHook()
+	assert.NoError(t, log.SetLevel("warn"))
+	log.Console().Info("Filtered")
+	log.Console().Warn("Not filtered")
+	assert.Equal(t, 1, len(hook.Entries))
+
+	assert.Error(t, log.SetLevel("unknown"))
+}
@@ -83,9 +83,15 @@ func (bb *BlockBuilder) Clone() *BlockBuilder {
 
 //Genesis create genesis block
 func (bb *BlockBuilder) Genesis() *BlockBuilder {
+	return bb.GenesisWith(func(conf *corepb.Genesis) {})
+}
+
+//GenesisWith create genesis block of the configuration modified by fn
+func (bb *BlockBuilder) GenesisWith(fn func(conf *corepb.Genesis)) *BlockBuilder {
 	n := bb.copy()
-	genesis, dynasties, tokenDist := testutil.NewTestGenesisBlock(bb.t, bb.dynastySize)
-	n.B = genesis
+	conf, dynasties, tokenDist := testutil.NewTestGenesisConf(bb.t, bb.dynastySize)
+	fn(conf)
+	n.B = testutil.NewTestGenesisBlockFromConf(bb.t, conf)
 	n.Dynasties = dynasties
 	n.TokenDist = tokenDist
 	n.KeyPairs = append(n.Dynasties, n.TokenDist...)
@@ -156,13 +156,17 @@ func NewTestGenesisConf(t *testing.T, dynastySize int) (conf *corepb.Genesis, dy
 // NewTestGenesisBlock returns a genesis block for tests.
 func NewTestGenesisBlock(t *testing.T, dynastySize int) (genesis *core.Block, dynasties AddrKeyPairs, distributed AddrKeyPairs) {
 	conf, dynasties, distributed := NewTestGenesisConf(t, dynastySize)
+	return NewTestGenesisBlockFromConf(t, conf), dynasties, distributed
+}
+
+// NewTestGenesisBlockFromConf returns a genesis block of the configuration for tests.
+func NewTestGenesisBlockFromConf(t *testing.T, conf *corepb.Genesis) *core.Block {
 	s, err := storage.NewMemoryStorage()
 	require.NoError(t, err)
-	d := dpos.New(dynastySize)
-	genesis, err = core.NewGenesisBlock(conf, d, s)
+	d := dpos.New(int(conf.Meta.DynastySize))
+	genesis, err := core.NewGenesisBlock(conf, d, s)
 	require.NoError(t, err)
-
-	return genesis, dynasties, distributed
+	return genesis
 }
 
 // GetStorage return storage
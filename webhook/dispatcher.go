@@ -0,0 +1,410 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/medlet/pb"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/logging"
+	"github.com/medibloc/go-medibloc/webhook/pb"
+	"github.com/sirupsen/logrus"
+)
+
+// endpoint is a webhook endpoint with its pending deliveries.
+type endpoint struct {
+	url    string
+	secret []byte
+	topics map[string]bool
+
+	mu       sync.Mutex
+	pending  []*webhookpb.Delivery
+	notifyCh chan struct{}
+}
+
+func (ep *endpoint) match(topic string) bool {
+	return len(ep.topics) == 0 || ep.topics[topic]
+}
+
+func (ep *endpoint) push(d *webhookpb.Delivery) {
+	ep.mu.Lock()
+	ep.pending = append(ep.pending, d)
+	ep.mu.Unlock()
+
+	select {
+	case ep.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+func (ep *endpoint) peek() *webhookpb.Delivery {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+	if len(ep.pending) == 0 {
+		return nil
+	}
+	return ep.pending[0]
+}
+
+func (ep *endpoint) pop() {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+	ep.pending[0] = nil
+	ep.pending = ep.pending[1:]
+}
+
+func (ep *endpoint) size() int {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+	return len(ep.pending)
+}
+
+// deliveryError is an error of a delivery. The delivery is retried if retry is true.
+type deliveryError struct {
+	err   error
+	retry bool
+}
+
+func (e *deliveryError) Error() string {
+	return e.err.Error()
+}
+
+// Dispatcher posts chain events to webhook endpoints. Events of an endpoint are delivered in order, and
+// a delivery is retried with backoff until it succeeds or reaches the maximum attempts.
+type Dispatcher struct {
+	queue     *queue
+	endpoints []*endpoint
+	client    *http.Client
+
+	maxAttempts   uint32
+	maxQueueSize  int
+	retryInterval time.Duration
+
+	emitter  *core.EventEmitter
+	eventSub *core.EventSubscriber
+
+	quitCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// New returns a webhook dispatcher which stores deliveries to stor.
+func New(cfg *medletpb.WebhookConfig, stor storage.Storage) (*Dispatcher, error) {
+	q, err := newQueue(stor)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Dispatcher{
+		queue:         q,
+		client:        &http.Client{Timeout: DefaultTimeout},
+		maxAttempts:   DefaultMaxAttempts,
+		maxQueueSize:  DefaultMaxQueueSize,
+		retryInterval: RetryBaseInterval,
+		quitCh:        make(chan struct{}),
+	}
+	if cfg.MaxAttempts > 0 {
+		d.maxAttempts = cfg.MaxAttempts
+	}
+	if cfg.MaxQueueSize > 0 {
+		d.maxQueueSize = int(cfg.MaxQueueSize)
+	}
+	if cfg.Timeout > 0 {
+		d.client.Timeout = time.Duration(cfg.Timeout) * time.Millisecond
+	}
+
+	list := topicList()
+	urls := make(map[string]bool)
+	for _, e := range cfg.Endpoints {
+		u, err := url.Parse(e.Url)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, ErrInvalidURL
+		}
+		if urls[e.Url] {
+			return nil, ErrDuplicatedURL
+		}
+		urls[e.Url] = true
+
+		ep := &endpoint{
+			url:      e.Url,
+			secret:   []byte(e.Secret),
+			topics:   make(map[string]bool),
+			notifyCh: make(chan struct{}, 1),
+		}
+		for _, topic := range e.Topics {
+			if !list[topic] {
+				return nil, ErrWrongTopic
+			}
+			ep.topics[topic] = true
+		}
+		d.endpoints = append(d.endpoints, ep)
+	}
+	return d, nil
+}
+
+// Setup registers the dispatcher to the event emitter.
+func (d *Dispatcher) Setup(emitter *core.EventEmitter) error {
+	topics := make(map[string]bool)
+	for _, ep := range d.endpoints {
+		if len(ep.topics) == 0 {
+			for topic := range topicList() {
+				topics[coreTopic(topic)] = true
+			}
+			continue
+		}
+		for topic := range ep.topics {
+			topics[coreTopic(topic)] = true
+		}
+	}
+	var list []string
+	for topic := range topics {
+		list = append(list, topic)
+	}
+
+	eventSub, err := core.NewEventSubscriber(eventBufferSize, list)
+	if err != nil {
+		return err
+	}
+	emitter.Register(eventSub)
+	d.emitter = emitter
+	d.eventSub = eventSub
+	return nil
+}
+
+// Start loads queued deliveries and starts delivering events.
+func (d *Dispatcher) Start() error {
+	deliveries, err := d.queue.load()
+	if err != nil {
+		return err
+	}
+	byURL := make(map[string]*endpoint)
+	for _, ep := range d.endpoints {
+		byURL[ep.url] = ep
+	}
+	for _, delivery := range deliveries {
+		ep, ok := byURL[delivery.Url]
+		if !ok {
+			// endpoint is removed from the configuration.
+			d.queue.remove(delivery.Id)
+			continue
+		}
+		ep.push(delivery)
+		metricsQueued.Inc(1)
+	}
+
+	for _, ep := range d.endpoints {
+		d.wg.Add(1)
+		go d.deliverLoop(ep)
+	}
+	if d.eventSub != nil {
+		d.wg.Add(1)
+		go d.loop()
+	}
+
+	logging.Console().WithFields(logrus.Fields{
+		"endpoints": len(d.endpoints),
+		"queued":    len(deliveries),
+	}).Info("Started webhook dispatcher.")
+	return nil
+}
+
+// Stop stops delivering events and closes the queue storage. Queued deliveries are delivered after restart.
+func (d *Dispatcher) Stop() {
+	if d.emitter != nil {
+		d.emitter.Deregister(d.eventSub)
+	}
+	close(d.quitCh)
+	d.wg.Wait()
+
+	if err := d.queue.storage.Close(); err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to close webhook queue storage.")
+	}
+	logging.Console().Info("Stopped webhook dispatcher.")
+}
+
+func (d *Dispatcher) loop() {
+	defer d.wg.Done()
+
+	for {
+		select {
+		case <-d.quitCh:
+			return
+		case event := <-d.eventSub.EventChan():
+			d.dispatch(event)
+		}
+	}
+}
+
+// dispatch queues deliveries of the event to the matching endpoints.
+func (d *Dispatcher) dispatch(event *core.Event) {
+	for _, payload := range eventPayloads(event) {
+		body, err := json.Marshal(payload)
+		if err != nil {
+			logging.WithFields(logrus.Fields{
+				"topic": payload.Topic,
+				"err":   err,
+			}).Error("Failed to marshal webhook payload.")
+			continue
+		}
+
+		for _, ep := range d.endpoints {
+			if !ep.match(payload.Topic) {
+				continue
+			}
+			if ep.size() >= d.maxQueueSize {
+				metricsDropped.Mark(1)
+				logging.WithFields(logrus.Fields{
+					"url":   ep.url,
+					"topic": payload.Topic,
+				}).Warn("Webhook delivery queue is full. Event is dropped.")
+				continue
+			}
+			delivery := &webhookpb.Delivery{
+				Url:     ep.url,
+				Topic:   payload.Topic,
+				EventId: payload.ID,
+				Body:    body,
+			}
+			if err := d.queue.push(delivery); err != nil {
+				logging.WithFields(logrus.Fields{
+					"url": ep.url,
+					"err": err,
+				}).Error("Failed to store webhook delivery.")
+				continue
+			}
+			ep.push(delivery)
+			metricsQueued.Inc(1)
+		}
+	}
+}
+
+func (d *Dispatcher) deliverLoop(ep *endpoint) {
+	defer d.wg.Done()
+
+	for {
+		delivery := ep.peek()
+		if delivery == nil {
+			select {
+			case <-d.quitCh:
+				return
+			case <-ep.notifyCh:
+				continue
+			}
+		}
+
+		err := d.post(ep, delivery)
+		if err == nil {
+			metricsDelivered.Mark(1)
+			d.complete(ep, delivery)
+			continue
+		}
+
+		delivery.Attempts++
+		if e, ok := err.(*deliveryError); (ok && !e.retry) || delivery.Attempts >= d.maxAttempts {
+			metricsFailed.Mark(1)
+			logging.WithFields(logrus.Fields{
+				"url":      ep.url,
+				"topic":    delivery.Topic,
+				"id":       delivery.EventId,
+				"attempts": delivery.Attempts,
+				"err":      err,
+			}).Warn("Failed to deliver webhook event.")
+			d.complete(ep, delivery)
+			continue
+		}
+
+		metricsRetried.Mark(1)
+		if err := d.queue.update(delivery); err != nil {
+			logging.WithFields(logrus.Fields{
+				"url": ep.url,
+				"err": err,
+			}).Error("Failed to update webhook delivery.")
+		}
+		timer := time.NewTimer(d.backoff(delivery.Attempts))
+		select {
+		case <-d.quitCh:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+func (d *Dispatcher) complete(ep *endpoint, delivery *webhookpb.Delivery) {
+	if err := d.queue.remove(delivery.Id); err != nil {
+		logging.WithFields(logrus.Fields{
+			"url": ep.url,
+			"err": err,
+		}).Error("Failed to remove webhook delivery.")
+	}
+	ep.pop()
+	metricsQueued.Dec(1)
+}
+
+// backoff returns the interval before the next attempt. It doubles every attempt up to RetryMaxInterval.
+func (d *Dispatcher) backoff(attempts uint32) time.Duration {
+	interval := d.retryInterval
+	for i := uint32(1); i < attempts && interval < RetryMaxInterval; i++ {
+		interval *= 2
+	}
+	if interval > RetryMaxInterval {
+		interval = RetryMaxInterval
+	}
+	return interval
+}
+
+func (d *Dispatcher) post(ep *endpoint, delivery *webhookpb.Delivery) error {
+	req, err := http.NewRequest(http.MethodPost, ep.url, bytes.NewReader(delivery.Body))
+	if err != nil {
+		return &deliveryError{err: err, retry: false}
+	}
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, delivery.Topic)
+	req.Header.Set(HeaderDelivery, delivery.EventId)
+	req.Header.Set(HeaderTimestamp, ts)
+	if len(ep.secret) > 0 {
+		req.Header.Set(HeaderSignature, Sign(ep.secret, ts, delivery.Body))
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return &deliveryError{err: err, retry: true}
+	}
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, maxResponseBodyBytes))
+	resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode >= 500, resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode == http.StatusRequestTimeout:
+		return &deliveryError{err: ErrDeliveryFailed, retry: true}
+	default:
+		return &deliveryError{err: ErrDeliveryFailed, retry: false}
+	}
+}
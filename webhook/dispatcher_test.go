@@ -0,0 +1,246 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package webhook
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/medlet/pb"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type request struct {
+	header http.Header
+	body   []byte
+}
+
+func newServer(t *testing.T, status func(n int32) int) (*httptest.Server, chan *request) {
+	reqCh := make(chan *request, 16)
+	var n int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		reqCh <- &request{header: r.Header, body: body}
+		w.WriteHeader(status(atomic.AddInt32(&n, 1)))
+	}))
+	return server, reqCh
+}
+
+func ok(int32) int {
+	return http.StatusOK
+}
+
+func receive(t *testing.T, reqCh chan *request) *request {
+	select {
+	case req := <-reqCh:
+		return req
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "webhook request is not received")
+	}
+	return nil
+}
+
+func newDispatcher(t *testing.T, stor storage.Storage, endpoints ...*medletpb.WebhookEndpoint) *Dispatcher {
+	d, err := New(&medletpb.WebhookConfig{Endpoints: endpoints}, stor)
+	require.NoError(t, err)
+	d.retryInterval = 10 * time.Millisecond
+	return d
+}
+
+func TestNew(t *testing.T) {
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+
+	tests := []struct {
+		endpoints []*medletpb.WebhookEndpoint
+		err       error
+	}{
+		{[]*medletpb.WebhookEndpoint{{Url: "ftp://localhost"}}, ErrInvalidURL},
+		{[]*medletpb.WebhookEndpoint{{Url: "http://"}}, ErrInvalidURL},
+		{[]*medletpb.WebhookEndpoint{{Url: "http://localhost"}, {Url: "http://localhost"}}, ErrDuplicatedURL},
+		{[]*medletpb.WebhookEndpoint{{Url: "http://localhost", Topics: []string{core.TopicPendingTransaction}}}, ErrWrongTopic},
+		{[]*medletpb.WebhookEndpoint{{Url: "https://localhost", Topics: []string{TopicRecordAdded}}}, nil},
+	}
+	for _, test := range tests {
+		_, err := New(&medletpb.WebhookConfig{Endpoints: test.endpoints}, stor)
+		assert.Equal(t, test.err, err)
+	}
+}
+
+func TestDeliver(t *testing.T) {
+	server, reqCh := newServer(t, ok)
+	defer server.Close()
+
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	secret := "secret"
+	d := newDispatcher(t, stor, &medletpb.WebhookEndpoint{Url: server.URL, Secret: secret})
+	require.NoError(t, d.Start())
+	defer d.Stop()
+
+	d.dispatch(&core.Event{Topic: core.TopicLibBlock, Data: "0x01", Seq: 3})
+
+	req := receive(t, reqCh)
+	assert.Equal(t, core.TopicLibBlock, req.header.Get(HeaderEvent))
+	ts := req.header.Get(HeaderTimestamp)
+	assert.True(t, Verify([]byte(secret), ts, req.body, req.header.Get(HeaderSignature)))
+	assert.False(t, Verify([]byte("wrong"), ts, req.body, req.header.Get(HeaderSignature)))
+
+	payload := new(Payload)
+	require.NoError(t, json.Unmarshal(req.body, payload))
+	assert.Equal(t, core.TopicLibBlock, payload.Topic)
+	assert.Equal(t, "0x01", payload.Hash)
+	assert.Equal(t, uint64(3), payload.Seq)
+	assert.Equal(t, payload.ID, req.header.Get(HeaderDelivery))
+}
+
+func TestTopicFilter(t *testing.T) {
+	libServer, libCh := newServer(t, ok)
+	defer libServer.Close()
+	tailServer, tailCh := newServer(t, ok)
+	defer tailServer.Close()
+
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	d := newDispatcher(t, stor,
+		&medletpb.WebhookEndpoint{Url: libServer.URL, Topics: []string{core.TopicLibBlock}},
+		&medletpb.WebhookEndpoint{Url: tailServer.URL, Topics: []string{core.TopicNewTailBlock}},
+	)
+	require.NoError(t, d.Start())
+	defer d.Stop()
+
+	d.dispatch(&core.Event{Topic: core.TopicNewTailBlock, Data: "0x01"})
+	d.dispatch(&core.Event{Topic: core.TopicLibBlock, Data: "0x02"})
+
+	assert.Equal(t, core.TopicLibBlock, receive(t, libCh).header.Get(HeaderEvent))
+	assert.Equal(t, core.TopicNewTailBlock, receive(t, tailCh).header.Get(HeaderEvent))
+	select {
+	case <-libCh:
+		assert.Fail(t, "unexpected webhook request")
+	case <-tailCh:
+		assert.Fail(t, "unexpected webhook request")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestRetry(t *testing.T) {
+	server, reqCh := newServer(t, func(n int32) int {
+		switch n {
+		case 1:
+			return http.StatusInternalServerError
+		case 2:
+			return http.StatusTooManyRequests
+		case 3:
+			return http.StatusOK
+		}
+		return http.StatusBadRequest
+	})
+	defer server.Close()
+
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	d := newDispatcher(t, stor, &medletpb.WebhookEndpoint{Url: server.URL})
+	require.NoError(t, d.Start())
+	defer d.Stop()
+
+	d.dispatch(&core.Event{Topic: core.TopicLibBlock, Data: "0x01"})
+	d.dispatch(&core.Event{Topic: core.TopicLibBlock, Data: "0x02"})
+
+	first := receive(t, reqCh)
+	assert.Equal(t, first.body, receive(t, reqCh).body)
+	assert.Equal(t, first.body, receive(t, reqCh).body)
+
+	// 4xx is not retried.
+	second := receive(t, reqCh)
+	assert.NotEqual(t, first.body, second.body)
+	select {
+	case <-reqCh:
+		assert.Fail(t, "unexpected webhook request")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	deliveries, err := d.queue.load()
+	require.NoError(t, err)
+	assert.Len(t, deliveries, 0)
+}
+
+func TestMaxAttempts(t *testing.T) {
+	server, reqCh := newServer(t, func(int32) int {
+		return http.StatusServiceUnavailable
+	})
+	defer server.Close()
+
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	d := newDispatcher(t, stor, &medletpb.WebhookEndpoint{Url: server.URL})
+	d.maxAttempts = 2
+	require.NoError(t, d.Start())
+	defer d.Stop()
+
+	d.dispatch(&core.Event{Topic: core.TopicLibBlock, Data: "0x01"})
+	receive(t, reqCh)
+	receive(t, reqCh)
+	select {
+	case <-reqCh:
+		assert.Fail(t, "unexpected webhook request")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestQueuePersistence(t *testing.T) {
+	server, reqCh := newServer(t, ok)
+	defer server.Close()
+
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	endpoint := &medletpb.WebhookEndpoint{Url: server.URL}
+
+	// deliveries are queued but not delivered before start.
+	d := newDispatcher(t, stor, endpoint, &medletpb.WebhookEndpoint{Url: "http://removed.localhost"})
+	d.dispatch(&core.Event{Topic: core.TopicLibBlock, Data: "0x01"})
+	d.dispatch(&core.Event{Topic: core.TopicLibBlock, Data: "0x02"})
+
+	d = newDispatcher(t, stor, endpoint)
+	require.NoError(t, d.Start())
+
+	for _, data := range []string{"0x01", "0x02"} {
+		payload := new(Payload)
+		require.NoError(t, json.Unmarshal(receive(t, reqCh).body, payload))
+		assert.Equal(t, data, payload.Hash)
+	}
+	d.Stop()
+
+	d = newDispatcher(t, stor, endpoint)
+	deliveries, err := d.queue.load()
+	require.NoError(t, err)
+	assert.Len(t, deliveries, 0)
+}
+
+func TestBackoff(t *testing.T) {
+	d := &Dispatcher{retryInterval: RetryBaseInterval}
+	assert.Equal(t, RetryBaseInterval, d.backoff(1))
+	assert.Equal(t, 4*RetryBaseInterval, d.backoff(3))
+	assert.Equal(t, RetryMaxInterval, d.backoff(100))
+}
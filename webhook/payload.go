@@ -0,0 +1,147 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/crypto/hash"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+)
+
+// Payload is the JSON body posted to webhook endpoints.
+type Payload struct {
+	// ID identifies the event. Retried deliveries of the same event have the same ID.
+	ID          string       `json:"id"`
+	Topic       string       `json:"topic"`
+	Hash        string       `json:"hash"`
+	Seq         uint64       `json:"seq,omitempty"`
+	BlockHash   string       `json:"block_hash,omitempty"`
+	BlockHeight uint64       `json:"block_height,omitempty"`
+	Timestamp   int64        `json:"timestamp,omitempty"`
+	Transaction *Transaction `json:"transaction,omitempty"`
+
+	RecordHash      string `json:"record_hash,omitempty"`
+	RecordCount     uint32 `json:"record_count,omitempty"`
+	CertificateHash string `json:"certificate_hash,omitempty"`
+	CertType        string `json:"cert_type,omitempty"`
+}
+
+// Transaction is the transaction of the event.
+type Transaction struct {
+	Hash      string `json:"hash"`
+	From      string `json:"from"`
+	To        string `json:"to"`
+	TxType    string `json:"tx_type"`
+	Value     string `json:"value"`
+	Nonce     uint64 `json:"nonce"`
+	Timestamp int64  `json:"timestamp"`
+	Payload   string `json:"payload,omitempty"`
+}
+
+// eventPayloads returns payloads of the event. Payloads of derived topics follow the payload of the event.
+func eventPayloads(event *core.Event) []*Payload {
+	p := &Payload{
+		Topic: event.Topic,
+		Hash:  event.Data,
+		Seq:   event.Seq,
+	}
+	if event.Block != nil {
+		p.BlockHash = byteutils.Bytes2Hex(event.Block.Hash())
+		p.BlockHeight = event.Block.Height()
+		p.Timestamp = event.Block.Timestamp()
+	}
+	p.ID = eventID(p.Topic, p.Hash, p.BlockHash)
+	payloads := []*Payload{p}
+
+	tx := event.Transaction
+	if tx == nil || event.Topic != core.TopicTransactionExecutionResult {
+		return payloads
+	}
+	p.Transaction = &Transaction{
+		Hash:      byteutils.Bytes2Hex(tx.Hash()),
+		From:      tx.From().Hex(),
+		To:        tx.To().Hex(),
+		TxType:    tx.TxType(),
+		Value:     tx.Value().String(),
+		Nonce:     tx.Nonce(),
+		Timestamp: tx.Timestamp(),
+		Payload:   byteutils.Bytes2Hex(tx.Payload()),
+	}
+
+	if derived := derivedPayload(p, tx); derived != nil {
+		payloads = append(payloads, derived)
+	}
+	return payloads
+}
+
+// derivedPayload returns the payload of record and certification topics. It returns nil if the transaction
+// does not add a record or a certification.
+func derivedPayload(p *Payload, tx *core.Transaction) *Payload {
+	derived := *p
+	switch tx.TxType() {
+	case core.TxOpAddRecord:
+		payload := new(core.AddRecordPayload)
+		if err := payload.FromBytes(tx.Payload()); err != nil {
+			return nil
+		}
+		derived.Topic = TopicRecordAdded
+		derived.RecordHash = byteutils.Bytes2Hex(payload.RecordHash)
+		derived.RecordCount = 1
+	case core.TxOpAddRecordBatch:
+		payload := new(core.AddRecordBatchPayload)
+		if err := payload.FromBytes(tx.Payload()); err != nil {
+			return nil
+		}
+		derived.Topic = TopicRecordAdded
+		derived.RecordHash = byteutils.Bytes2Hex(payload.MerkleRoot)
+		derived.RecordCount = payload.RecordCount
+	case core.TxOpAddCertification:
+		payload := new(core.AddCertificationPayload)
+		if err := payload.FromBytes(tx.Payload()); err != nil {
+			return nil
+		}
+		derived.Topic = TopicCertificationIssued
+		derived.CertificateHash = byteutils.Bytes2Hex(payload.CertificateHash)
+		derived.CertType = payload.CertType
+	default:
+		return nil
+	}
+	derived.ID = eventID(derived.Topic, derived.Hash, derived.BlockHash)
+	return &derived
+}
+
+func eventID(topic, data, blockHash string) string {
+	return byteutils.Bytes2Hex(hash.Sha3256([]byte(topic), []byte(data), []byte(blockHash)))
+}
+
+// Sign returns HMAC-SHA256 signature of the request. The signature is computed over the timestamp header,
+// a dot and the body.
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify returns true if the signature of the request is valid.
+func Verify(secret []byte, timestamp string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
@@ -0,0 +1,106 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: webhook.proto
+
+/*
+Package webhookpb is a generated protocol buffer package.
+
+It is generated from these files:
+	webhook.proto
+
+It has these top-level messages:
+	Delivery
+*/
+package webhookpb
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Delivery is a queued request to a webhook endpoint.
+type Delivery struct {
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url      string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Topic    string `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+	EventId  string `protobuf:"bytes,4,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Body     []byte `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Attempts uint32 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+}
+
+func (m *Delivery) Reset()                    { *m = Delivery{} }
+func (m *Delivery) String() string            { return proto.CompactTextString(m) }
+func (*Delivery) ProtoMessage()               {}
+func (*Delivery) Descriptor() ([]byte, []int) { return fileDescriptorWebhook, []int{0} }
+
+func (m *Delivery) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Delivery) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Delivery) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *Delivery) GetEventId() string {
+	if m != nil {
+		return m.EventId
+	}
+	return ""
+}
+
+func (m *Delivery) GetBody() []byte {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *Delivery) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Delivery)(nil), "webhookpb.Delivery")
+}
+
+func init() { proto.RegisterFile("webhook.proto", fileDescriptorWebhook) }
+
+var fileDescriptorWebhook = []byte{
+	// 164 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0xce, 0xbf, 0x0a, 0xc2, 0x30,
+	0x10, 0xc7, 0x71, 0xd2, 0x7f, 0xb6, 0x87, 0x15, 0x39, 0x1c, 0xa2, 0x53, 0x70, 0xca, 0xe4, 0xe2,
+	0x2b, 0xb8, 0xb8, 0xe6, 0x05, 0xc4, 0x98, 0x80, 0xc1, 0xea, 0x85, 0x7a, 0x56, 0xfa, 0x10, 0xbe,
+	0xb3, 0x18, 0xc5, 0xed, 0xfb, 0xb9, 0x5b, 0x7e, 0xd0, 0x3e, 0xbd, 0x3d, 0x13, 0x5d, 0x36, 0xb1,
+	0x27, 0x26, 0x6c, 0x7e, 0x8c, 0x76, 0xfd, 0x12, 0x50, 0xef, 0x7c, 0x17, 0x06, 0xdf, 0x8f, 0x38,
+	0x83, 0x2c, 0x38, 0x29, 0x94, 0xd0, 0x85, 0xc9, 0x82, 0xc3, 0x39, 0xe4, 0x8f, 0xbe, 0x93, 0x99,
+	0x12, 0xba, 0x31, 0x9f, 0xc4, 0x05, 0x94, 0x4c, 0x31, 0x9c, 0x64, 0x9e, 0x6e, 0x5f, 0xe0, 0x12,
+	0x6a, 0x3f, 0xf8, 0x1b, 0x1f, 0x82, 0x93, 0x45, 0x7a, 0x4c, 0x92, 0xf7, 0x0e, 0x11, 0x0a, 0x4b,
+	0x6e, 0x94, 0xa5, 0x12, 0x7a, 0x6a, 0x52, 0xe3, 0x0a, 0xea, 0x23, 0xb3, 0xbf, 0x46, 0xbe, 0xcb,
+	0x4a, 0x09, 0xdd, 0x9a, 0xbf, 0x6d, 0x95, 0x16, 0x6e, 0xdf, 0x03, 0x00, 0x97, 0xfc, 0x91, 0x72,
+	0xb2, 0x00, 0x00, 0x00,
+}
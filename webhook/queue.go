@@ -0,0 +1,133 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package webhook
+
+import (
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/medibloc/go-medibloc/webhook/pb"
+)
+
+const (
+	deliveryKeyPrefix = "webhook_delivery_"
+	firstIDKey        = "webhook_first_id"
+	lastIDKey         = "webhook_last_id"
+)
+
+// queue persists deliveries until they are completed.
+type queue struct {
+	mu      sync.Mutex
+	storage storage.Storage
+	firstID uint64
+	lastID  uint64
+}
+
+func newQueue(stor storage.Storage) (*queue, error) {
+	q := &queue{storage: stor}
+	var err error
+	if q.firstID, err = q.getUint64(firstIDKey); err != nil {
+		return nil, err
+	}
+	if q.lastID, err = q.getUint64(lastIDKey); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+func (q *queue) getUint64(key string) (uint64, error) {
+	v, err := q.storage.Get([]byte(key))
+	if err == storage.ErrKeyNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return byteutils.Uint64(v), nil
+}
+
+func deliveryKey(id uint64) []byte {
+	return append([]byte(deliveryKeyPrefix), byteutils.FromUint64(id)...)
+}
+
+// push stores the delivery with a new id.
+func (q *queue) push(d *webhookpb.Delivery) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	d.Id = q.lastID + 1
+	if err := q.put(d); err != nil {
+		return err
+	}
+	if err := q.storage.Put([]byte(lastIDKey), byteutils.FromUint64(d.Id)); err != nil {
+		return err
+	}
+	q.lastID = d.Id
+	return nil
+}
+
+// update stores the delivery retried.
+func (q *queue) update(d *webhookpb.Delivery) error {
+	return q.put(d)
+}
+
+func (q *queue) put(d *webhookpb.Delivery) error {
+	value, err := proto.Marshal(d)
+	if err != nil {
+		return err
+	}
+	return q.storage.Put(deliveryKey(d.Id), value)
+}
+
+// remove deletes the completed delivery.
+func (q *queue) remove(id uint64) error {
+	return q.storage.Delete(deliveryKey(id))
+}
+
+// load returns deliveries which are not completed in order of id.
+func (q *queue) load() ([]*webhookpb.Delivery, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var deliveries []*webhookpb.Delivery
+	firstID := q.lastID + 1
+	for id := q.firstID; id <= q.lastID; id++ {
+		value, err := q.storage.Get(deliveryKey(id))
+		if err == storage.ErrKeyNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		d := new(webhookpb.Delivery)
+		if err := proto.Unmarshal(value, d); err != nil {
+			return nil, err
+		}
+		if id < firstID {
+			firstID = id
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	// deliveries before the first one are completed, so they are not scanned again.
+	if err := q.storage.Put([]byte(firstIDKey), byteutils.FromUint64(firstID)); err != nil {
+		return nil, err
+	}
+	q.firstID = firstID
+	return deliveries, nil
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package webhook
+
+import (
+	"errors"
+	"time"
+
+	"github.com/medibloc/go-medibloc/core"
+)
+
+// Webhook topics derived from transaction execution results
+const (
+	// TopicRecordAdded a record is added by add_record or add_record_batch transaction.
+	TopicRecordAdded = "record.added"
+
+	// TopicCertificationIssued a certification is issued by add_certification transaction.
+	TopicCertificationIssued = "certification.issued"
+)
+
+// HTTP headers of webhook requests
+const (
+	HeaderEvent     = "X-Medibloc-Event"
+	HeaderDelivery  = "X-Medibloc-Delivery"
+	HeaderTimestamp = "X-Medibloc-Timestamp"
+	HeaderSignature = "X-Medibloc-Signature"
+)
+
+// Default parameters
+const (
+	DefaultMaxAttempts  = 10
+	DefaultTimeout      = 10 * time.Second
+	DefaultMaxQueueSize = 10000
+	RetryBaseInterval   = time.Second
+	RetryMaxInterval    = 5 * time.Minute
+)
+
+const (
+	eventBufferSize      = 1024
+	maxResponseBodyBytes = 4096
+	signaturePrefix      = "sha256="
+)
+
+// Error types
+var (
+	ErrWrongTopic     = errors.New("webhook topic doesn't exist in topic list")
+	ErrInvalidURL     = errors.New("invalid webhook url")
+	ErrDuplicatedURL  = errors.New("webhook url is duplicated")
+	ErrDeliveryFailed = errors.New("webhook endpoint rejected the delivery")
+)
+
+func topicList() map[string]bool {
+	return map[string]bool{
+		core.TopicNewTailBlock:               true,
+		core.TopicLibBlock:                   true,
+		core.TopicRevertBlock:                true,
+		core.TopicTransactionExecutionResult: true,
+		TopicRecordAdded:                     true,
+		TopicCertificationIssued:             true,
+	}
+}
+
+// IsValidTopic returns true if events of the topic can be posted to webhook endpoints.
+func IsValidTopic(topic string) bool {
+	return topicList()[topic]
+}
+
+// coreTopic returns the topic of core events which the webhook topic is derived from.
+func coreTopic(topic string) string {
+	switch topic {
+	case TopicRecordAdded, TopicCertificationIssued:
+		return core.TopicTransactionExecutionResult
+	}
+	return topic
+}